@@ -105,23 +105,49 @@ const calculateNextRulePriority = async function (listenerArn) {
     return nextRulePriority;
 };
 
+/**
+ * Reserves consecutive rule priorities for each of the service's listener rules.
+ * The first priority is returned as "Priority", and the following ones as "Priority1", "Priority2", etc.
+ *
+ * @param {string} listenerArn the ARN of the ALB listener.
+ * @param {number} ruleCount the number of listener rules that need a priority.
+ * @returns {object} The response data with the listener rule priorities.
+ */
+const rulePriorities = async function (listenerArn, ruleCount) {
+    const rulePriority = await calculateNextRulePriority(listenerArn);
+    const priorities = {
+        Priority: rulePriority
+    };
+    for (let i = 1; i < ruleCount; i++) {
+        priorities[`Priority${i}`] = rulePriority + i;
+    }
+    return priorities;
+};
+
 /**
  * Next Available ALB Rule Priority handler, invoked by Lambda
  */
 exports.nextAvailableRulePriorityHandler = async function(event, context) {
     var responseData = {};
     var physicalResourceId;
-    var rulePriority;
 
     try {
       switch (event.RequestType) {
         case 'Create':
-          rulePriority = await calculateNextRulePriority(event.ResourceProperties.ListenerArn);
-          responseData.Priority = rulePriority;
+          responseData = await rulePriorities(event.ResourceProperties.ListenerArn, parseInt(event.ResourceProperties.RuleCount || 1));
           physicalResourceId = `alb-rule-priority-${event.LogicalResourceId}`
           break;
-        // Do nothing on update and delete, since this isn't a "real" resource.
         case 'Update':
+          physicalResourceId = event.PhysicalResourceId
+          // Reserve new priorities if the service's number of rules changed.
+          // The existing rules keep their priorities until they're updated, so the new ones can't conflict.
+          const ruleCount = (event.ResourceProperties || {}).RuleCount || 1;
+          const oldRuleCount = (event.OldResourceProperties || {}).RuleCount || 1;
+          if (`${ruleCount}` !== `${oldRuleCount}`) {
+            responseData = await rulePriorities(event.ResourceProperties.ListenerArn, parseInt(ruleCount));
+          }
+          break;
+        // Do nothing on delete, since this isn't a "real" resource.
         case 'Delete':
           physicalResourceId = event.PhysicalResourceId
           break;
@@ -0,0 +1,216 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+'use strict';
+
+const aws = require('aws-sdk');
+
+// These are used for test purposes only
+let defaultResponseURL;
+let waiter;
+
+/**
+ * Upload a CloudFormation response object to S3.
+ *
+ * @param {object} event the Lambda event payload received by the handler function
+ * @param {object} context the Lambda context received by the handler function
+ * @param {string} responseStatus the response status, either 'SUCCESS' or 'FAILED'
+ * @param {string} physicalResourceId CloudFormation physical resource ID
+ * @param {object} [responseData] arbitrary response data object
+ * @param {string} [reason] reason for failure, if any, to convey to the user
+ * @returns {Promise} Promise that is resolved on success, or rejected on connection error or HTTP error response
+ */
+let report = function (event, context, responseStatus, physicalResourceId, responseData, reason) {
+    return new Promise((resolve, reject) => {
+        const https = require('https');
+        const {
+            URL
+        } = require('url');
+
+        var responseBody = JSON.stringify({
+            Status: responseStatus,
+            Reason: reason,
+            PhysicalResourceId: physicalResourceId || context.logStreamName,
+            StackId: event.StackId,
+            RequestId: event.RequestId,
+            LogicalResourceId: event.LogicalResourceId,
+            Data: responseData
+        });
+
+        const parsedUrl = new URL(event.ResponseURL || defaultResponseURL);
+        const options = {
+            hostname: parsedUrl.hostname,
+            port: 443,
+            path: parsedUrl.pathname + parsedUrl.search,
+            method: 'PUT',
+            headers: {
+                'Content-Type': '',
+                'Content-Length': responseBody.length
+            }
+        };
+
+        https.request(options)
+            .on('error', reject)
+            .on('response', res => {
+                res.resume();
+                if (res.statusCode >= 400) {
+                    reject(new Error(`Server returned error ${res.statusCode}: ${res.statusMessage}`));
+                } else {
+                    resolve();
+                }
+            })
+            .end(responseBody, 'utf8');
+    });
+};
+
+/**
+ * Upserts or deletes "A" records that alias the load balancer for each domain name.
+ * Aliases in the environment's domain are written to the environment's hosted zone, other aliases
+ * are written to the application's hosted zone by assuming the application's DNS delegation role.
+ *
+ * @param {string} action either 'UPSERT' or 'DELETE'
+ * @param {string[]} aliases the domain names to route to the load balancer
+ * @param {object} props the properties of the custom resource
+ */
+const changeAliases = async function (action, aliases, props) {
+    for (const alias of aliases) {
+        const [route53, hostedZoneId] = await hostedZoneFor(alias, props);
+        console.log(`${action} alias record ${alias} in hosted zone ${hostedZoneId}`);
+        let changeBatch;
+        try {
+            changeBatch = await route53.changeResourceRecordSets({
+                ChangeBatch: {
+                    Changes: [{
+                        Action: action,
+                        ResourceRecordSet: {
+                            Name: alias,
+                            Type: 'A',
+                            AliasTarget: {
+                                HostedZoneId: props.LoadBalancerHostedZoneID,
+                                DNSName: props.LoadBalancerDNS,
+                                EvaluateTargetHealth: true
+                            }
+                        }
+                    }]
+                },
+                HostedZoneId: hostedZoneId
+            }).promise();
+        } catch (err) {
+            // If the record was already deleted, there is nothing left to do.
+            if (action === 'DELETE' && err.code === 'InvalidChangeBatch' && err.message.includes('not found')) {
+                continue;
+            }
+            throw err;
+        }
+        await waitForRecordSetChange(route53, changeBatch.ChangeInfo.Id);
+    }
+};
+
+/**
+ * Returns the Route53 client and hosted zone ID authoritative for a domain name.
+ *
+ * @param {string} domainName the domain name of the record
+ * @param {object} props the properties of the custom resource
+ * @returns {Array} the Route53 client and the hosted zone ID to write the record into
+ */
+const hostedZoneFor = async function (domainName, props) {
+    if (isSubdomainOf(domainName, props.EnvDomainName)) {
+        return [client(), props.HostedZoneId];
+    }
+    if (!isSubdomainOf(domainName, props.AppDomainName)) {
+        throw new Error(`Alias ${domainName} is not a subdomain of the application's domain ${props.AppDomainName}`);
+    }
+    const route53 = client(new aws.ChainableTemporaryCredentials(
+        {
+            params: {RoleArn: props.RootDNSRole},
+            masterCredentials: (new aws.EnvironmentCredentials('AWS'))
+        }));
+    const hostedZones = await route53.listHostedZonesByName({
+        DNSName: props.AppDomainName,
+        MaxItems: '1'
+    }).promise();
+    if (!hostedZones.HostedZones || hostedZones.HostedZones.length === 0 || hostedZones.HostedZones[0].Name !== `${props.AppDomainName}.`) {
+        throw new Error(`Couldn't find any hosted zone with DNS name ${props.AppDomainName}`);
+    }
+    // HostedZoneIDs are of the form /hostedzone/1234455, but the actual
+    // ID is after the last slash.
+    return [route53, hostedZones.HostedZones[0].Id.split('/').pop()];
+};
+
+const isSubdomainOf = function (name, domain) {
+    return !!domain && (name === domain || name.endsWith(`.${domain}`));
+};
+
+const client = function (credentials) {
+    const route53 = credentials ? new aws.Route53({ credentials }) : new aws.Route53();
+    if (waiter) {
+        // Used by the test suite, since waiters aren't mockable yet
+        route53.waitFor = waiter;
+    }
+    return route53;
+};
+
+const waitForRecordSetChange = function (route53, changeId) {
+    return route53.waitFor('resourceRecordSetsChanged', {
+        // Wait up to 5 minutes
+        $waiter: {
+            delay: 30,
+            maxAttempts: 10
+        },
+        Id: changeId
+    }).promise();
+};
+
+/**
+ * Custom domain handler, invoked by Lambda.
+ * The physical ID stays the same across updates so that CloudFormation never deletes the records of the new resource.
+ */
+exports.customDomainHandler = async function (event, context) {
+    var responseData = {};
+    var physicalResourceId = event.PhysicalResourceId || `${event.LogicalResourceId}-${event.RequestId}`;
+    const props = event.ResourceProperties || {};
+    const aliases = props.Aliases || [];
+    try {
+        switch (event.RequestType) {
+            case 'Create':
+                await changeAliases('UPSERT', aliases, props);
+                break;
+            case 'Update':
+                await changeAliases('UPSERT', aliases, props);
+                const oldProps = event.OldResourceProperties || {};
+                const removed = (oldProps.Aliases || []).filter(alias => !aliases.includes(alias));
+                await changeAliases('DELETE', removed, oldProps);
+                break;
+            case 'Delete':
+                await changeAliases('DELETE', aliases, props);
+                break;
+            default:
+                throw new Error(`Unsupported request type ${event.RequestType}`);
+        }
+
+        await report(event, context, 'SUCCESS', physicalResourceId, responseData);
+    } catch (err) {
+        console.log(`Caught error ${err}.`);
+        await report(event, context, 'FAILED', physicalResourceId, null, err.message);
+    }
+};
+
+/**
+ * @private
+ */
+exports.withDefaultResponseURL = function (url) {
+    defaultResponseURL = url;
+};
+
+/**
+ * @private
+ */
+exports.withWaiter = function (w) {
+    waiter = w;
+};
+
+/**
+ * @private
+ */
+exports.reset = function () {
+    waiter = undefined;
+};
@@ -75,13 +75,16 @@ let report = function (event, context, responseStatus, physicalResourceId, respo
  * for the suffix of the certificate's Common Name (CN).  For example, if the CN is
  * `*.example.com`, the hosted zone ID must point to a Route 53 zone authoritative
  * for `example.com`.
+ * If the application's domain name and DNS delegation role are provided, validation records for names
+ * outside of the environment's domain are written into the application's hosted zone instead.
  *
  * @param {string} requestId the CloudFormation request ID
  * @param {string} domainName the Common Name (CN) field for the requested certificate
- * @param {string} hostedZoneId the Route53 Hosted Zone ID
+ * @param {string[]} subjectAlternativeNames additional names protected by the certificate
+ * @param {object} zones the hosted zones to write validation records into
  * @returns {string} Validated certificate ARN
  */
-const requestCertificate = async function (requestId, domainName, subjectAlternativeNames, hostedZoneId, region) {
+const requestCertificate = async function (requestId, domainName, subjectAlternativeNames, zones, region) {
     const crypto = require('crypto');
     const [acm, route53] = clients(region)
     const reqCertResponse = await acm.requestCertificate({
@@ -91,8 +94,8 @@ const requestCertificate = async function (requestId, domainName, subjectAlterna
         ValidationMethod: 'DNS'
     }).promise();
 
-    let record;
-    for (let attempt = 0; attempt < maxAttempts && !record; attempt++) {
+    let records;
+    for (let attempt = 0; attempt < maxAttempts && !records; attempt++) {
         const {
             Certificate
         } = await acm.describeCertificate({
@@ -100,8 +103,9 @@ const requestCertificate = async function (requestId, domainName, subjectAlterna
         }).promise();
         const options = Certificate.DomainValidationOptions || [];
 
-        if (options.length > 0 && options[0].ResourceRecord) {
-            record = options[0].ResourceRecord;
+        // Each name in the certificate gets its own validation record once ACM generates it.
+        if (options.length > 0 && options.every(option => option.ResourceRecord)) {
+            records = uniqueRecords(options);
         } else {
             // Exponential backoff with jitter based on 200ms base
             // component of backoff fixed to ensure minimum total wait time on
@@ -110,13 +114,16 @@ const requestCertificate = async function (requestId, domainName, subjectAlterna
             await sleep(random() * base * 50 + base * 150);
         }
     }
-    if (!record) {
+    if (!records) {
         throw new Error(`DescribeCertificate did not contain DomainValidationOptions after ${maxAttempts} tries.`)
     }
 
-    console.log(`Creating DNS record into zone ${hostedZoneId}: ${record.Name} ${record.Type} ${record.Value}`);
-    const changeBatch = await updateRecords(route53, hostedZoneId, 'UPSERT', record.Name, record.Type, record.Value);
-    await waitForRecordChange(route53, changeBatch.ChangeInfo.Id)
+    for (const record of records) {
+        const [client, hostedZoneId] = await hostedZoneFor(route53, record.Name, zones);
+        console.log(`Creating DNS record into zone ${hostedZoneId}: ${record.Name} ${record.Type} ${record.Value}`);
+        const changeBatch = await updateRecords(client, hostedZoneId, 'UPSERT', record.Name, record.Type, record.Value);
+        await waitForRecordChange(client, changeBatch.ChangeInfo.Id)
+    }
 
     await acm.waitFor('certificateValidated', {
         // Wait up to 9 minutes and 30 seconds
@@ -136,7 +143,7 @@ const requestCertificate = async function (requestId, domainName, subjectAlterna
  *
  * @param {string} arn The certificate ARN
  */
-const deleteCertificate = async function (arn, region, hostedZoneId) {
+const deleteCertificate = async function (arn, region, zones) {
     const [acm, route53] = clients(region)
     try {
         console.log(`Waiting for certificate ${arn} to become unused`);
@@ -165,13 +172,11 @@ const deleteCertificate = async function (arn, region, hostedZoneId) {
             throw new Error(`Certificate still in use after checking for ${maxAttempts} attempts.`)
         }
 
-        // Fetch the DNS Validation Record and delete it
-        if (dnsValidationRecord.length > 0 && dnsValidationRecord[0].ResourceRecord) {
-            const record = dnsValidationRecord[0].ResourceRecord;
-
-            // Delete this record now that it's not needed
-            const changeBatch = await updateRecords(route53, hostedZoneId, 'DELETE', record.Name, record.Type, record.Value);
-            await waitForRecordChange(route53, changeBatch.ChangeInfo.Id)
+        // Fetch the DNS Validation Records and delete them now that they're not needed
+        for (const record of uniqueRecords(dnsValidationRecord.filter(option => option.ResourceRecord))) {
+            const [client, hostedZoneId] = await hostedZoneFor(route53, record.Name, zones);
+            const changeBatch = await updateRecords(client, hostedZoneId, 'DELETE', record.Name, record.Type, record.Value);
+            await waitForRecordChange(client, changeBatch.ChangeInfo.Id)
         }
 
         await acm.deleteCertificate({
@@ -215,6 +220,78 @@ const updateRecords = function(route53, hostedZone, action, recordName, recordTy
     }).promise();
 }
 
+/**
+ * Returns the validation records of a certificate without duplicates.
+ * A wildcard name and its parent domain share the same validation record.
+ *
+ * @param {object[]} options the DomainValidationOptions of the certificate
+ * @returns {object[]} the resource records to create
+ */
+const uniqueRecords = function(options) {
+    const records = new Map();
+    for (const option of options) {
+        records.set(option.ResourceRecord.Name, option.ResourceRecord);
+    }
+    return Array.from(records.values());
+}
+
+/**
+ * Returns the Route53 client and hosted zone ID authoritative for a record name.
+ * Records outside of the environment's domain are written into the application's hosted zone
+ * by assuming the application's DNS delegation role.
+ *
+ * @param {object} route53 the Route53 client of the environment account
+ * @param {string} recordName the name of the record
+ * @param {object} zones the environment's hosted zone and domain, and the application's domain and DNS delegation role
+ * @returns {Array} the Route53 client and the hosted zone ID to write the record into
+ */
+const hostedZoneFor = async function(route53, recordName, zones) {
+    const name = recordName.replace(/\.$/, '');
+    if (!zones.appDomainName || isSubdomainOf(name, zones.envDomainName)) {
+        return [route53, zones.hostedZoneId];
+    }
+    if (!isSubdomainOf(name, zones.appDomainName)) {
+        throw new Error(`Domain ${name} is not a subdomain of the application's domain ${zones.appDomainName}`);
+    }
+    const appRoute53 = new aws.Route53({
+        credentials: new aws.ChainableTemporaryCredentials(
+            {
+                params: {RoleArn: zones.rootDNSRole},
+                masterCredentials: (new aws.EnvironmentCredentials('AWS'))
+            })
+        });
+    if (waiter) {
+        // Used by the test suite, since waiters aren't mockable yet
+        appRoute53.waitFor = waiter;
+    }
+    const hostedZones = await appRoute53.listHostedZonesByName({
+        DNSName: zones.appDomainName,
+        MaxItems: '1'
+    }).promise();
+    if (!hostedZones.HostedZones || hostedZones.HostedZones.length === 0 || hostedZones.HostedZones[0].Name !== `${zones.appDomainName}.`) {
+        throw new Error(`Couldn't find any hosted zone with DNS name ${zones.appDomainName}`);
+    }
+    // HostedZoneIDs are of the form /hostedzone/1234455, but the actual
+    // ID is after the last slash.
+    return [appRoute53, hostedZones.HostedZones[0].Id.split('/').pop()];
+}
+
+const isSubdomainOf = function(name, domain) {
+    return !!domain && (name === domain || name.endsWith(`.${domain}`));
+}
+
+/**
+ * Returns the hosted zones configuration from the custom resource properties.
+ */
+const hostedZones = function(props) {
+    return {
+        hostedZoneId: props.HostedZoneId,
+        envDomainName: props.EnvDomainName,
+        appDomainName: props.AppDomainName,
+        rootDNSRole: props.RootDNSRole,
+    };
+}
+
 const clients = function(region) {
     const acm = new aws.ACM({
         region
@@ -243,7 +320,7 @@ exports.certificateRequestHandler = async function(event, context) {
             event.RequestId,
             event.ResourceProperties.DomainName,
             event.ResourceProperties.SubjectAlternativeNames,
-            event.ResourceProperties.HostedZoneId,
+            hostedZones(event.ResourceProperties),
             event.ResourceProperties.Region,
           );
           responseData.Arn = physicalResourceId = certificateArn;
@@ -253,7 +330,7 @@ exports.certificateRequestHandler = async function(event, context) {
           // If the resource didn't create correctly, the physical resource ID won't be the
           // certificate ARN, so don't try to delete it in that case.
           if (physicalResourceId.startsWith('arn:')) {
-            await deleteCertificate(physicalResourceId, event.ResourceProperties.Region, event.ResourceProperties.HostedZoneId);
+            await deleteCertificate(physicalResourceId, event.ResourceProperties.Region, hostedZones(event.ResourceProperties));
           }
           break;
         default:
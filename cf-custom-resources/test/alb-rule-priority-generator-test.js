@@ -241,6 +241,90 @@ describe('ALB Rule Priority Generator', () => {
       });
   });
 
+  test('Create operation returns consecutive rule priorities for each rule', () => {
+    const describeRulesFake = sinon.fake.resolves(
+        {
+            "Rules": [
+                {
+                    "Priority": "4",
+                    "Conditions": [],
+                    "RuleArn": "arn:aws:elasticloadbalancing:us-west-2:000000000:listener-rule/app/rule",
+                    "IsDefault": false,
+                    "Actions": [
+                        {
+                            "TargetGroupArn": "arn:aws:elasticloadbalancing:us-west-2:000000000:targetgroup/tg",
+                            "Type": "forward"
+                        }
+                    ]
+                },
+            ]
+    });
+
+    AWS.mock('ELBv2', 'describeRules', describeRulesFake);
+    const request = nock(ResponseURL).put('/', body => {
+      return body.Status === 'SUCCESS' && body.Data.Priority == 5 && body.Data.Priority1 == 6 && body.Data.Priority2 == 7;
+    }).reply(200);
+
+    return LambdaTester(albRulePriorityHandler.nextAvailableRulePriorityHandler)
+      .event({
+        RequestType: 'Create',
+        RequestId: testRequestId,
+        ResourceProperties: {
+          ListenerArn: testALBListenerArn,
+          RuleCount: '3'
+        }
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test('Update operation returns new rule priorities when the number of rules changes', () => {
+    const describeRulesFake = sinon.fake.resolves(
+        {
+            "Rules": [
+                {
+                    "Priority": "5",
+                    "Conditions": [],
+                    "RuleArn": "arn:aws:elasticloadbalancing:us-west-2:000000000:listener-rule/app/rule",
+                    "IsDefault": false,
+                    "Actions": [
+                        {
+                            "TargetGroupArn": "arn:aws:elasticloadbalancing:us-west-2:000000000:targetgroup/tg",
+                            "Type": "forward"
+                        }
+                    ]
+                },
+            ]
+    });
+
+    AWS.mock('ELBv2', 'describeRules', describeRulesFake);
+    const request = nock(ResponseURL).put('/', body => {
+      return body.Status === 'SUCCESS' && body.PhysicalResourceId === 'alb-rule-priority-HTTPRulePriorityAction' &&
+        body.Data.Priority == 6 && body.Data.Priority1 == 7;
+    }).reply(200);
+
+    return LambdaTester(albRulePriorityHandler.nextAvailableRulePriorityHandler)
+      .event({
+        RequestType: 'Update',
+        RequestId: testRequestId,
+        PhysicalResourceId: 'alb-rule-priority-HTTPRulePriorityAction',
+        ResourceProperties: {
+          ListenerArn: testALBListenerArn,
+          RuleCount: '2'
+        },
+        OldResourceProperties: {
+          ListenerArn: testALBListenerArn
+        }
+      })
+      .expectResolve(() => {
+        sinon.assert.calledWith(describeRulesFake, sinon.match({
+            ListenerArn: testALBListenerArn,
+        }));
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
   test('Create operation returns rule priority max + 1 for paginated response', () => {
     // This set of rules has the default, 3 and 5 rule priorities. We don't try to fill
     // in the gaps, we just create one that is 1 + the max. In this case, 6.
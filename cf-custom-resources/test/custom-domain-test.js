@@ -0,0 +1,196 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+'use strict';
+
+describe('Custom Domain Handler', () => {
+  const AWS = require('aws-sdk-mock');
+  const LambdaTester = require('lambda-tester').noVersionCheck();
+  const sinon = require('sinon');
+  const handler = require('../lib/custom-domain');
+  const nock = require('nock');
+  const ResponseURL = 'https://cloudwatch-response-mock.example.com/';
+
+  let origLog = console.log;
+  const testRequestId = 'f4ef1b10-c39a-44e3-99c0-fbf7e53c3943';
+  const testEnvHostedZoneId = 'Z3P5QSUBK4POTI';
+  const testAppHostedZoneId = 'Z1R8UBAEXAMPLE';
+  const testProps = {
+    HostedZoneId: testEnvHostedZoneId,
+    EnvDomainName: 'test.phonetool.example.com',
+    AppDomainName: 'phonetool.example.com',
+    RootDNSRole: 'arn:aws:iam::123456789012:role/phonetool-DNSDelegationRole',
+    LoadBalancerDNS: 'lb-1234.us-west-2.elb.amazonaws.com',
+    LoadBalancerHostedZoneID: 'Z1H1FL5HABSF5',
+  };
+
+  const aliasChange = function (action, alias, hostedZoneId) {
+    return sinon.match({
+      ChangeBatch: {
+        Changes: [{
+          Action: action,
+          ResourceRecordSet: {
+            Name: alias,
+            Type: 'A',
+            AliasTarget: {
+              HostedZoneId: testProps.LoadBalancerHostedZoneID,
+              DNSName: testProps.LoadBalancerDNS,
+              EvaluateTargetHealth: true
+            }
+          }
+        }]
+      },
+      HostedZoneId: hostedZoneId
+    });
+  };
+
+  beforeEach(() => {
+    handler.withDefaultResponseURL(ResponseURL);
+    handler.withWaiter(function () {
+      // Mock waiter is merely a self-fulfilling promise
+      return {
+        promise: () => {
+          return new Promise((resolve) => {
+            resolve();
+          });
+        }
+      };
+    });
+    console.log = function () { };
+  });
+  afterEach(() => {
+    handler.reset();
+    AWS.restore();
+    console.log = origLog;
+  });
+
+  test('Bogus operation fails', () => {
+    const bogusType = 'bogus';
+    const request = nock(ResponseURL).put('/', body => {
+      return body.Status === 'FAILED' && body.Reason === 'Unsupported request type ' + bogusType;
+    }).reply(200);
+    return LambdaTester(handler.customDomainHandler)
+      .event({
+        RequestType: bogusType
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test('Create operation upserts aliases in the environment and application hosted zones', () => {
+    const changeResourceRecordSetsFake = sinon.fake.resolves({
+      ChangeInfo: {
+        Id: 'bogus'
+      }
+    });
+    const listHostedZonesByNameFake = sinon.fake.resolves({
+      HostedZones: [{
+        Id: `/hostedzone/${testAppHostedZoneId}`,
+        Name: 'phonetool.example.com.'
+      }]
+    });
+    AWS.mock('Route53', 'changeResourceRecordSets', changeResourceRecordSetsFake);
+    AWS.mock('Route53', 'listHostedZonesByName', listHostedZonesByNameFake);
+
+    const request = nock(ResponseURL).put('/', body => {
+      return body.Status === 'SUCCESS';
+    }).reply(200);
+
+    return LambdaTester(handler.customDomainHandler)
+      .event({
+        RequestType: 'Create',
+        RequestId: testRequestId,
+        ResourceProperties: {
+          ...testProps,
+          Aliases: ['api.test.phonetool.example.com', 'www.phonetool.example.com'],
+        }
+      })
+      .expectResolve(() => {
+        sinon.assert.calledWith(changeResourceRecordSetsFake, aliasChange('UPSERT', 'api.test.phonetool.example.com', testEnvHostedZoneId));
+        sinon.assert.calledWith(changeResourceRecordSetsFake, aliasChange('UPSERT', 'www.phonetool.example.com', testAppHostedZoneId));
+        sinon.assert.calledWith(listHostedZonesByNameFake, sinon.match({
+          DNSName: 'phonetool.example.com'
+        }));
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test('Create operation fails for aliases outside of the application domain', () => {
+    const request = nock(ResponseURL).put('/', body => {
+      return body.Status === 'FAILED' &&
+        body.Reason === "Alias www.example.com is not a subdomain of the application's domain phonetool.example.com";
+    }).reply(200);
+
+    return LambdaTester(handler.customDomainHandler)
+      .event({
+        RequestType: 'Create',
+        RequestId: testRequestId,
+        ResourceProperties: {
+          ...testProps,
+          Aliases: ['www.example.com'],
+        }
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test('Update operation deletes aliases that were removed', () => {
+    const changeResourceRecordSetsFake = sinon.fake.resolves({
+      ChangeInfo: {
+        Id: 'bogus'
+      }
+    });
+    AWS.mock('Route53', 'changeResourceRecordSets', changeResourceRecordSetsFake);
+
+    const request = nock(ResponseURL).put('/', body => {
+      return body.Status === 'SUCCESS' && body.PhysicalResourceId === 'mockID';
+    }).reply(200);
+
+    return LambdaTester(handler.customDomainHandler)
+      .event({
+        RequestType: 'Update',
+        RequestId: testRequestId,
+        PhysicalResourceId: 'mockID',
+        ResourceProperties: {
+          ...testProps,
+          Aliases: ['v2.test.phonetool.example.com'],
+        },
+        OldResourceProperties: {
+          ...testProps,
+          Aliases: ['v1.test.phonetool.example.com'],
+        }
+      })
+      .expectResolve(() => {
+        sinon.assert.calledWith(changeResourceRecordSetsFake, aliasChange('UPSERT', 'v2.test.phonetool.example.com', testEnvHostedZoneId));
+        sinon.assert.calledWith(changeResourceRecordSetsFake, aliasChange('DELETE', 'v1.test.phonetool.example.com', testEnvHostedZoneId));
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test('Delete operation is idempotent', () => {
+    const error = new Error('Tried to delete resource record set [name=\'api.test.phonetool.example.com.\', type=\'A\'] but it was not found');
+    error.code = 'InvalidChangeBatch';
+    const changeResourceRecordSetsFake = sinon.fake.rejects(error);
+    AWS.mock('Route53', 'changeResourceRecordSets', changeResourceRecordSetsFake);
+
+    const request = nock(ResponseURL).put('/', body => {
+      return body.Status === 'SUCCESS';
+    }).reply(200);
+
+    return LambdaTester(handler.customDomainHandler)
+      .event({
+        RequestType: 'Delete',
+        RequestId: testRequestId,
+        PhysicalResourceId: 'mockID',
+        ResourceProperties: {
+          ...testProps,
+          Aliases: ['api.test.phonetool.example.com'],
+        }
+      })
+      .expectResolve(() => {
+        sinon.assert.calledWith(changeResourceRecordSetsFake, aliasChange('DELETE', 'api.test.phonetool.example.com', testEnvHostedZoneId));
+        expect(request.isDone()).toBe(true);
+      });
+  });
+});
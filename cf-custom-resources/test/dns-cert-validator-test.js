@@ -144,6 +144,94 @@ describe('DNS Validated Certificate Handler', () => {
 
   });
 
+  test('Create operation validates names in the environment and application hosted zones', () => {
+    const envRRName = '_3639ac514e785e898d2646601fa951d5.api.test.phonetool.example.com.';
+    const appRRName = '_4749bd625f896f9a9e3757712fb062e6.www.phonetool.example.com.';
+    const requestCertificateFake = sinon.fake.resolves({
+      CertificateArn: testCertificateArn,
+    });
+    const describeCertificateFake = sinon.fake.resolves({
+      Certificate: {
+        CertificateArn: testCertificateArn,
+        DomainValidationOptions: [{
+          ValidationStatus: 'SUCCESS',
+          ResourceRecord: {
+            Name: envRRName,
+            Type: 'CNAME',
+            Value: testRRValue
+          }
+        }, {
+          ValidationStatus: 'SUCCESS',
+          ResourceRecord: {
+            Name: appRRName,
+            Type: 'CNAME',
+            Value: testRRValue
+          }
+        }]
+      }
+    });
+    const changeResourceRecordSetsFake = sinon.fake.resolves({
+      ChangeInfo: {
+        Id: 'bogus'
+      }
+    });
+    const listHostedZonesByNameFake = sinon.fake.resolves({
+      HostedZones: [{
+        Id: '/hostedzone/Z1R8UBAEXAMPLE',
+        Name: 'phonetool.example.com.'
+      }]
+    });
+
+    AWS.mock('ACM', 'requestCertificate', requestCertificateFake);
+    AWS.mock('ACM', 'describeCertificate', describeCertificateFake);
+    AWS.mock('Route53', 'changeResourceRecordSets', changeResourceRecordSetsFake);
+    AWS.mock('Route53', 'listHostedZonesByName', listHostedZonesByNameFake);
+
+    const request = nock(ResponseURL).put('/', body => {
+      return body.Status === 'SUCCESS';
+    }).reply(200);
+
+    return LambdaTester(handler.certificateRequestHandler)
+      .event({
+        RequestType: 'Create',
+        RequestId: testRequestId,
+        ResourceProperties: {
+          DomainName: 'api.test.phonetool.example.com',
+          SubjectAlternativeNames: ['www.phonetool.example.com'],
+          HostedZoneId: testHostedZoneId,
+          EnvDomainName: 'test.phonetool.example.com',
+          AppDomainName: 'phonetool.example.com',
+          RootDNSRole: 'arn:aws:iam::123456789012:role/phonetool-DNSDelegationRole',
+          Region: 'us-east-1',
+        }
+      })
+      .expectResolve(() => {
+        sinon.assert.calledWith(changeResourceRecordSetsFake, sinon.match({
+          ChangeBatch: {
+            Changes: [sinon.match({
+              Action: 'UPSERT',
+              ResourceRecordSet: sinon.match({
+                Name: envRRName,
+              })
+            })]
+          },
+          HostedZoneId: testHostedZoneId
+        }));
+        sinon.assert.calledWith(changeResourceRecordSetsFake, sinon.match({
+          ChangeBatch: {
+            Changes: [sinon.match({
+              Action: 'UPSERT',
+              ResourceRecordSet: sinon.match({
+                Name: appRRName,
+              })
+            })]
+          },
+          HostedZoneId: 'Z1R8UBAEXAMPLE'
+        }));
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
   test('Create operation fails after more than 60s if certificate has no DomainValidationOptions', () => {
     handler.withRandom(() => 0);
     const requestCertificateFake = sinon.fake.resolves({
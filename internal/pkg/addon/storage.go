@@ -15,6 +15,7 @@ import (
 const (
 	dynamoDbAddonPath = "addons/ddb/cf.yml"
 	s3AddonPath       = "addons/s3/cf.yml"
+	efsAddonPath      = "addons/efs/cf.yml"
 )
 
 var regexpMatchAttribute = regexp.MustCompile("^(\\S+):([sbnSBN])")
@@ -40,7 +41,15 @@ type S3 struct {
 	parser template.Parser
 }
 
-// StorageProps holds basic input properties for addon.NewDynamoDB() or addon.NewS3().
+// EFS contains configuration options which fully describe an EFS file system.
+// Implements the encoding.BinaryMarshaler interface.
+type EFS struct {
+	EFSProps
+
+	parser template.Parser
+}
+
+// StorageProps holds basic input properties for addon.NewDynamoDB(), addon.NewS3() or addon.NewEFS().
 type StorageProps struct {
 	Name string
 }
@@ -50,6 +59,11 @@ type S3Props struct {
 	*StorageProps
 }
 
+// EFSProps contains EFS-specific properties for addon.NewEFS().
+type EFSProps struct {
+	*StorageProps
+}
+
 // DynamoDBProps contains DynamoDB-specific properties for addon.NewDynamoDB().
 type DynamoDBProps struct {
 	*StorageProps
@@ -112,6 +126,25 @@ func NewS3(input *S3Props) *S3 {
 	}
 }
 
+// MarshalBinary serializes the EFS object into a binary YAML CF template.
+// Implements the encoding.BinaryMarshaler interface.
+func (e *EFS) MarshalBinary() ([]byte, error) {
+	content, err := e.parser.Parse(efsAddonPath, *e, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// NewEFS creates a new EFS marshaler which can be used to write CF via addonWriter.
+func NewEFS(input *EFSProps) *EFS {
+	return &EFS{
+		EFSProps: *input,
+
+		parser: template.New(),
+	}
+}
+
 // BuildPartitionKey generates the properties required to specify the partition key
 // based on customer inputs.
 func (p *DynamoDBProps) BuildPartitionKey(partitionKey string) error {
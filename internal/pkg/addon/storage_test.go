@@ -108,6 +108,52 @@ func TestS3_MarshalBinary(t *testing.T) {
 	}
 }
 
+func TestEFS_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(ctrl *gomock.Controller, efs *EFS)
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error parsing template": {
+			mockDependencies: func(ctrl *gomock.Controller, efs *EFS) {
+				m := mocks.NewMockParser(ctrl)
+				efs.parser = m
+				m.EXPECT().Parse(efsAddonPath, *efs, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns rendered content": {
+			mockDependencies: func(ctrl *gomock.Controller, efs *EFS) {
+				m := mocks.NewMockParser(ctrl)
+				efs.parser = m
+				m.EXPECT().Parse(efsAddonPath, *efs, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("hello")}, nil)
+
+			},
+
+			wantedBinary: []byte("hello"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addon := &EFS{}
+			tc.mockDependencies(ctrl, addon)
+
+			// WHEN
+			b, err := addon.MarshalBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedBinary, b)
+		})
+	}
+}
+
 func TestDDBAttributeFromKey(t *testing.T) {
 	testCases := map[string]struct {
 		input     string
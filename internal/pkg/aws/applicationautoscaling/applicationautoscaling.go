@@ -0,0 +1,64 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package applicationautoscaling provides a client to make API requests to Application Auto Scaling.
+package applicationautoscaling
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	aas "github.com/aws/aws-sdk-go/service/applicationautoscaling"
+)
+
+const (
+	// ECSServiceNamespace is the namespace of scalable targets that are ECS services.
+	ECSServiceNamespace = "ecs"
+
+	fmtECSServiceResourceID = "service/%s/%s"
+)
+
+type api interface {
+	DescribeScalableTargets(input *aas.DescribeScalableTargetsInput) (*aas.DescribeScalableTargetsOutput, error)
+}
+
+// ApplicationAutoscaling wraps an AWS Application Auto Scaling client.
+type ApplicationAutoscaling struct {
+	client api
+}
+
+// ScalableTarget holds the capacity boundaries of a scalable resource.
+type ScalableTarget struct {
+	MinCapacity int64 `json:"minCapacity"`
+	MaxCapacity int64 `json:"maxCapacity"`
+}
+
+// New returns an ApplicationAutoscaling struct configured against the input session.
+func New(s *session.Session) *ApplicationAutoscaling {
+	return &ApplicationAutoscaling{
+		client: aas.New(s),
+	}
+}
+
+// ECSServiceScalableTarget returns the scalable target of an ECS service.
+// If the service isn't registered as a scalable target, then returns nil.
+func (a *ApplicationAutoscaling) ECSServiceScalableTarget(clusterName, serviceName string) (*ScalableTarget, error) {
+	resourceID := fmt.Sprintf(fmtECSServiceResourceID, clusterName, serviceName)
+	out, err := a.client.DescribeScalableTargets(&aas.DescribeScalableTargetsInput{
+		ServiceNamespace:  aws.String(ECSServiceNamespace),
+		ResourceIds:       aws.StringSlice([]string{resourceID}),
+		ScalableDimension: aws.String(aas.ScalableDimensionEcsServiceDesiredCount),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe scalable targets for resource %s: %w", resourceID, err)
+	}
+	if len(out.ScalableTargets) == 0 {
+		return nil, nil
+	}
+	target := out.ScalableTargets[0]
+	return &ScalableTarget{
+		MinCapacity: aws.Int64Value(target.MinCapacity),
+		MaxCapacity: aws.Int64Value(target.MaxCapacity),
+	}, nil
+}
@@ -0,0 +1,82 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package applicationautoscaling
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	aas "github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/copilot-cli/internal/pkg/aws/applicationautoscaling/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationAutoscaling_ECSServiceScalableTarget(t *testing.T) {
+	mockInput := &aas.DescribeScalableTargetsInput{
+		ServiceNamespace:  aws.String("ecs"),
+		ResourceIds:       aws.StringSlice([]string{"service/mockCluster/mockService"}),
+		ScalableDimension: aws.String("ecs:service:DesiredCount"),
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted    *ScalableTarget
+		wantedErr error
+	}{
+		"errors if failed to describe scalable targets": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeScalableTargets(mockInput).Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("describe scalable targets for resource service/mockCluster/mockService: some error"),
+		},
+		"returns nil if the service isn't a scalable target": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeScalableTargets(mockInput).Return(&aas.DescribeScalableTargetsOutput{}, nil)
+			},
+		},
+		"success": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeScalableTargets(mockInput).Return(&aas.DescribeScalableTargetsOutput{
+					ScalableTargets: []*aas.ScalableTarget{
+						{
+							MinCapacity: aws.Int64(1),
+							MaxCapacity: aws.Int64(10),
+						},
+					},
+				}, nil)
+			},
+			wanted: &ScalableTarget{
+				MinCapacity: 1,
+				MaxCapacity: 10,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockClient)
+			client := ApplicationAutoscaling{
+				client: mockClient,
+			}
+
+			// WHEN
+			got, err := client.ECSServiceScalableTarget("mockCluster", "mockService")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -60,6 +60,7 @@ type api interface {
 	DescribeSubnets(*ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error)
 	DescribeSecurityGroups(*ec2.DescribeSecurityGroupsInput) (*ec2.DescribeSecurityGroupsOutput, error)
 	DescribeVpcs(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error)
+	AuthorizeSecurityGroupIngress(input *ec2.AuthorizeSecurityGroupIngressInput) (*ec2.AuthorizeSecurityGroupIngressOutput, error)
 }
 
 // Filter contains the name and values of a filter.
@@ -128,6 +129,58 @@ func (c *EC2) ListVPCSubnets(vpcID string, opts ...ListVPCSubnetsOpts) ([]string
 	return subnets, nil
 }
 
+// VPC holds the IPv4 CIDR block and the tags of a VPC.
+type VPC struct {
+	CIDR string
+	Tags map[string]string
+}
+
+// DescribeVPC returns the CIDR block and the tags of a VPC given its ID.
+func (c *EC2) DescribeVPC(vpcID string) (*VPC, error) {
+	response, err := c.client.DescribeVpcs(&ec2.DescribeVpcsInput{
+		VpcIds: aws.StringSlice([]string{vpcID}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe VPC %s: %w", vpcID, err)
+	}
+	if len(response.Vpcs) == 0 {
+		return nil, fmt.Errorf("VPC %s not found", vpcID)
+	}
+	vpc := response.Vpcs[0]
+	tags := make(map[string]string)
+	for _, tag := range vpc.Tags {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return &VPC{
+		CIDR: aws.StringValue(vpc.CidrBlock),
+		Tags: tags,
+	}, nil
+}
+
+// SubnetCIDRs returns the IPv4 CIDR blocks of the subnets in the same order as their IDs.
+func (c *EC2) SubnetCIDRs(subnetIDs []string) ([]string, error) {
+	subnets, err := c.subnets(Filter{
+		Name:   "subnet-id",
+		Values: subnetIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	cidrs := make(map[string]string)
+	for _, subnet := range subnets {
+		cidrs[aws.StringValue(subnet.SubnetId)] = aws.StringValue(subnet.CidrBlock)
+	}
+	ordered := make([]string, len(subnetIDs))
+	for i, id := range subnetIDs {
+		cidr, ok := cidrs[id]
+		if !ok {
+			return nil, fmt.Errorf("subnet %s not found", id)
+		}
+		ordered[i] = cidr
+	}
+	return ordered, nil
+}
+
 // SubnetIDs finds the subnet IDs with optional filters.
 func (c *EC2) SubnetIDs(filters ...Filter) ([]string, error) {
 	subnets, err := c.subnets(filters...)
@@ -177,6 +230,63 @@ func (c *EC2) SecurityGroups(filters ...Filter) ([]string, error) {
 	return securityGroups, nil
 }
 
+// AllowsIngress returns true if any of the security groups allows TCP traffic on the port from the source security group.
+func (c *EC2) AllowsIngress(groupIDs []string, sourceGroupID string, port int64) (bool, error) {
+	response, err := c.client.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+		GroupIds: aws.StringSlice(groupIDs),
+	})
+	if err != nil {
+		return false, fmt.Errorf("describe security groups: %w", err)
+	}
+	for _, sg := range response.SecurityGroups {
+		for _, perm := range sg.IpPermissions {
+			if !allowsTCPPort(perm, port) {
+				continue
+			}
+			for _, pair := range perm.UserIdGroupPairs {
+				if aws.StringValue(pair.GroupId) == sourceGroupID {
+					return true, nil
+				}
+			}
+		}
+	}
+	return false, nil
+}
+
+// AllowIngress adds a rule to the security group that allows TCP traffic on the port from the source security group.
+func (c *EC2) AllowIngress(groupID, sourceGroupID string, port int64) error {
+	_, err := c.client.AuthorizeSecurityGroupIngress(&ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId: aws.String(groupID),
+		IpPermissions: []*ec2.IpPermission{
+			{
+				IpProtocol: aws.String("tcp"),
+				FromPort:   aws.Int64(port),
+				ToPort:     aws.Int64(port),
+				UserIdGroupPairs: []*ec2.UserIdGroupPair{
+					{
+						GroupId: aws.String(sourceGroupID),
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("authorize ingress to security group %s: %w", groupID, err)
+	}
+	return nil
+}
+
+func allowsTCPPort(perm *ec2.IpPermission, port int64) bool {
+	switch aws.StringValue(perm.IpProtocol) {
+	case "-1":
+		return true
+	case "tcp":
+		return aws.Int64Value(perm.FromPort) <= port && port <= aws.Int64Value(perm.ToPort)
+	default:
+		return false
+	}
+}
+
 func (c *EC2) subnets(filters ...Filter) ([]*ec2.Subnet, error) {
 	inputFilters := toEC2Filter(filters)
 	var subnets []*ec2.Subnet
@@ -376,3 +376,308 @@ func TestEC2_SecurityGroups(t *testing.T) {
 		})
 	}
 }
+
+func TestEC2_AllowsIngress(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError   error
+		wantedAllowed bool
+	}{
+		"failed to describe security groups": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecurityGroups(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe security groups: some error"),
+		},
+		"allowed by a tcp rule that covers the port": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+					GroupIds: aws.StringSlice([]string{"sg-1", "sg-2"}),
+				}).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []*ec2.SecurityGroup{
+						{
+							IpPermissions: []*ec2.IpPermission{
+								{
+									IpProtocol:       aws.String("tcp"),
+									FromPort:         aws.Int64(2049),
+									ToPort:           aws.Int64(2049),
+									UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-env")}},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+
+			wantedAllowed: true,
+		},
+		"allowed by a rule for all protocols": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecurityGroups(gomock.Any()).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []*ec2.SecurityGroup{
+						{
+							IpPermissions: []*ec2.IpPermission{
+								{
+									IpProtocol:       aws.String("-1"),
+									UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-env")}},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+
+			wantedAllowed: true,
+		},
+		"not allowed if the port or the source group doesn't match": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecurityGroups(gomock.Any()).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []*ec2.SecurityGroup{
+						{
+							IpPermissions: []*ec2.IpPermission{
+								{
+									IpProtocol:       aws.String("tcp"),
+									FromPort:         aws.Int64(80),
+									ToPort:           aws.Int64(443),
+									UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-env")}},
+								},
+								{
+									IpProtocol:       aws.String("tcp"),
+									FromPort:         aws.Int64(2049),
+									ToPort:           aws.Int64(2049),
+									UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-other")}},
+								},
+								{
+									IpProtocol:       aws.String("udp"),
+									FromPort:         aws.Int64(2049),
+									ToPort:           aws.Int64(2049),
+									UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-env")}},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+
+			wantedAllowed: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			allowed, err := ec2Client.AllowsIngress([]string{"sg-1", "sg-2"}, "sg-env", 2049)
+			if tc.wantedError != nil {
+				require.EqualError(t, tc.wantedError, err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedAllowed, allowed)
+			}
+		})
+	}
+}
+
+func TestEC2_AllowIngress(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+	}{
+		"failed to authorize ingress": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().AuthorizeSecurityGroupIngress(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("authorize ingress to security group sg-1: some error"),
+		},
+		"success": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().AuthorizeSecurityGroupIngress(&ec2.AuthorizeSecurityGroupIngressInput{
+					GroupId: aws.String("sg-1"),
+					IpPermissions: []*ec2.IpPermission{
+						{
+							IpProtocol:       aws.String("tcp"),
+							FromPort:         aws.Int64(2049),
+							ToPort:           aws.Int64(2049),
+							UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-env")}},
+						},
+					},
+				}).Return(&ec2.AuthorizeSecurityGroupIngressOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			err := ec2Client.AllowIngress("sg-1", "sg-env", 2049)
+			if tc.wantedError != nil {
+				require.EqualError(t, tc.wantedError, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEC2_DescribeVPC(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedVPC   *VPC
+	}{
+		"failed to describe the VPC": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcs(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe VPC vpc-1: some error"),
+		},
+		"VPC not found": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcs(gomock.Any()).Return(&ec2.DescribeVpcsOutput{}, nil)
+			},
+
+			wantedError: errors.New("VPC vpc-1 not found"),
+		},
+		"success": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcs(&ec2.DescribeVpcsInput{
+					VpcIds: aws.StringSlice([]string{"vpc-1"}),
+				}).Return(&ec2.DescribeVpcsOutput{
+					Vpcs: []*ec2.Vpc{
+						{
+							CidrBlock: aws.String("10.0.0.0/16"),
+							Tags: []*ec2.Tag{
+								{
+									Key:   aws.String("aws:cloudformation:stack-name"),
+									Value: aws.String("phonetool-test"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+
+			wantedVPC: &VPC{
+				CIDR: "10.0.0.0/16",
+				Tags: map[string]string{
+					"aws:cloudformation:stack-name": "phonetool-test",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			vpc, err := ec2Client.DescribeVPC("vpc-1")
+			if tc.wantedError != nil {
+				require.EqualError(t, tc.wantedError, err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedVPC, vpc)
+			}
+		})
+	}
+}
+
+func TestEC2_SubnetCIDRs(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedCIDRs []string
+	}{
+		"failed to describe the subnets": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSubnets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe subnets: some error"),
+		},
+		"subnet not found": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSubnets(gomock.Any()).Return(&ec2.DescribeSubnetsOutput{
+					Subnets: []*ec2.Subnet{
+						{
+							SubnetId:  aws.String("subnet-1"),
+							CidrBlock: aws.String("10.0.0.0/24"),
+						},
+					},
+				}, nil)
+			},
+
+			wantedError: errors.New("subnet subnet-2 not found"),
+		},
+		"returns the CIDR blocks in the order of the subnet IDs": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSubnets(&ec2.DescribeSubnetsInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("subnet-id"),
+							Values: aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+						},
+					},
+				}).Return(&ec2.DescribeSubnetsOutput{
+					Subnets: []*ec2.Subnet{
+						{
+							SubnetId:  aws.String("subnet-2"),
+							CidrBlock: aws.String("10.0.1.0/24"),
+						},
+						{
+							SubnetId:  aws.String("subnet-1"),
+							CidrBlock: aws.String("10.0.0.0/24"),
+						},
+					},
+				}, nil)
+			},
+
+			wantedCIDRs: []string{"10.0.0.0/24", "10.0.1.0/24"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			cidrs, err := ec2Client.SubnetCIDRs([]string{"subnet-1", "subnet-2"})
+			if tc.wantedError != nil {
+				require.EqualError(t, tc.wantedError, err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedCIDRs, cidrs)
+			}
+		})
+	}
+}
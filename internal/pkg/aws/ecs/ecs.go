@@ -24,8 +24,12 @@ const (
 	shortImageDigestLength = 8
 	imageDigestPrefix      = "sha256:"
 
+	capacityProviderFargateSpot = "FARGATE_SPOT"
+
 	// DesiredStatusStopped represents the desired status "STOPPED" for a task.
 	DesiredStatusStopped = ecs.DesiredStatusStopped
+	// DesiredStatusRunning represents the desired status "RUNNING" for a task.
+	DesiredStatusRunning = ecs.DesiredStatusRunning
 )
 
 type api interface {
@@ -35,6 +39,7 @@ type api interface {
 	ListTasks(input *ecs.ListTasksInput) (*ecs.ListTasksOutput, error)
 	DescribeClusters(input *ecs.DescribeClustersInput) (*ecs.DescribeClustersOutput, error)
 	RunTask(input *ecs.RunTaskInput) (*ecs.RunTaskOutput, error)
+	ExecuteCommand(input *ecs.ExecuteCommandInput) (*ecs.ExecuteCommandOutput, error)
 	WaitUntilTasksRunning(input *ecs.DescribeTasksInput) error
 }
 
@@ -116,6 +121,25 @@ type RunTaskInput struct {
 	SecurityGroups []string
 	TaskFamilyName string
 	StartedBy      string
+	EnableExec     bool
+	Spot           bool // Run the tasks on Fargate Spot capacity.
+}
+
+// ExecuteCommandInput holds the fields needed to execute a command in a running container.
+type ExecuteCommandInput struct {
+	Cluster   string
+	Task      string
+	Container string
+	Command   string
+}
+
+// Session holds the information needed to start an interactive session with a running container.
+type Session struct {
+	ID         string `json:"SessionId"`
+	StreamURL  string `json:"StreamUrl"`
+	TokenValue string `json:"TokenValue"`
+	// Target of the session, formatted as "ecs:<cluster name>_<task ID>_<container runtime ID>".
+	Target string `json:"-"`
 }
 
 // New returns a Service configured against the input session.
@@ -187,6 +211,76 @@ func (e *ECS) ServiceTasks(clusterName, serviceName string) ([]*Task, error) {
 	return tasks, nil
 }
 
+// StoppedServiceTasks calls ECS API and returns the ECS tasks of the service that recently stopped in the cluster.
+func (e *ECS) StoppedServiceTasks(clusterName, serviceName string) ([]*Task, error) {
+	var tasks []*Task
+	var nextToken *string
+	for {
+		listTaskResp, err := e.client.ListTasks(&ecs.ListTasksInput{
+			Cluster:       aws.String(clusterName),
+			ServiceName:   aws.String(serviceName),
+			DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+			NextToken:     nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list stopped tasks of service %s: %w", serviceName, err)
+		}
+		if len(listTaskResp.TaskArns) != 0 {
+			descTaskResp, err := e.client.DescribeTasks(&ecs.DescribeTasksInput{
+				Cluster: aws.String(clusterName),
+				Tasks:   listTaskResp.TaskArns,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("describe stopped tasks in cluster %s: %w", clusterName, err)
+			}
+			for _, task := range descTaskResp.Tasks {
+				t := Task(*task)
+				tasks = append(tasks, &t)
+			}
+		}
+		nextToken = listTaskResp.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return tasks, nil
+}
+
+// RunningTasksInFamily calls ECS API and returns the running ECS tasks in the cluster that belong to the task family.
+func (e *ECS) RunningTasksInFamily(clusterName, family string) ([]*Task, error) {
+	var tasks []*Task
+	var nextToken *string
+	for {
+		listTaskResp, err := e.client.ListTasks(&ecs.ListTasksInput{
+			Cluster:       aws.String(clusterName),
+			Family:        aws.String(family),
+			DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+			NextToken:     nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list running tasks of family %s: %w", family, err)
+		}
+		if len(listTaskResp.TaskArns) != 0 {
+			descTaskResp, err := e.client.DescribeTasks(&ecs.DescribeTasksInput{
+				Cluster: aws.String(clusterName),
+				Tasks:   listTaskResp.TaskArns,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("describe running tasks in cluster %s: %w", clusterName, err)
+			}
+			for _, task := range descTaskResp.Tasks {
+				t := Task(*task)
+				tasks = append(tasks, &t)
+			}
+		}
+		nextToken = listTaskResp.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return tasks, nil
+}
+
 // DefaultCluster returns the default cluster ARN in the account and region.
 func (e *ECS) DefaultCluster() (string, error) {
 	resp, err := e.client.DescribeClusters(&ecs.DescribeClustersInput{})
@@ -217,12 +311,13 @@ func (e *ECS) HasDefaultCluster() (bool, error) {
 // RunTask runs a number of tasks with the task definition and network configurations in a cluster, and returns after
 // the task(s) is running or fails to run, along with task ARNs if possible.
 func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
-	resp, err := e.client.RunTask(&ecs.RunTaskInput{
-		Cluster:        aws.String(input.Cluster),
-		Count:          aws.Int64(int64(input.Count)),
-		LaunchType:     aws.String(ecs.LaunchTypeFargate),
-		StartedBy:      aws.String(input.StartedBy),
-		TaskDefinition: aws.String(input.TaskFamilyName),
+	in := &ecs.RunTaskInput{
+		Cluster:              aws.String(input.Cluster),
+		Count:                aws.Int64(int64(input.Count)),
+		LaunchType:           aws.String(ecs.LaunchTypeFargate),
+		StartedBy:            aws.String(input.StartedBy),
+		TaskDefinition:       aws.String(input.TaskFamilyName),
+		EnableExecuteCommand: aws.Bool(input.EnableExec),
 		NetworkConfiguration: &ecs.NetworkConfiguration{
 			AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
 				AssignPublicIp: aws.String(ecs.AssignPublicIpEnabled),
@@ -230,7 +325,18 @@ func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
 				SecurityGroups: aws.StringSlice(input.SecurityGroups),
 			},
 		},
-	})
+	}
+	if input.Spot {
+		// A launch type can't be specified together with a capacity provider strategy.
+		in.LaunchType = nil
+		in.CapacityProviderStrategy = []*ecs.CapacityProviderStrategyItem{
+			{
+				CapacityProvider: aws.String(capacityProviderFargateSpot),
+				Weight:           aws.Int64(1),
+			},
+		}
+	}
+	resp, err := e.client.RunTask(in)
 	if err != nil {
 		return nil, fmt.Errorf("run task(s) %s: %w", input.TaskFamilyName, err)
 	}
@@ -286,6 +392,45 @@ func (e *ECS) DescribeTasks(cluster string, taskARNs []string) ([]*Task, error)
 	return tasks, nil
 }
 
+// ExecuteCommand starts an interactive command in a running container, and returns the session to connect to it.
+func (e *ECS) ExecuteCommand(in ExecuteCommandInput) (*Session, error) {
+	resp, err := e.client.ExecuteCommand(&ecs.ExecuteCommandInput{
+		Cluster:     aws.String(in.Cluster),
+		Command:     aws.String(in.Command),
+		Container:   aws.String(in.Container),
+		Interactive: aws.Bool(true),
+		Task:        aws.String(in.Task),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("execute command %s in container %s: %w", in.Command, in.Container, err)
+	}
+	tasks, err := e.DescribeTasks(in.Cluster, []string{aws.StringValue(resp.TaskArn)})
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("cannot find task %s", in.Task)
+	}
+	runtimeID, err := tasks[0].containerRuntimeID(in.Container)
+	if err != nil {
+		return nil, err
+	}
+	taskID, err := tasks[0].taskID(aws.StringValue(resp.TaskArn))
+	if err != nil {
+		return nil, err
+	}
+	clusterName, err := resourceName(aws.StringValue(resp.ClusterArn))
+	if err != nil {
+		return nil, err
+	}
+	return &Session{
+		ID:         aws.StringValue(resp.Session.SessionId),
+		StreamURL:  aws.StringValue(resp.Session.StreamUrl),
+		TokenValue: aws.StringValue(resp.Session.TokenValue),
+		Target:     fmt.Sprintf("ecs:%s_%s_%s", clusterName, taskID, runtimeID),
+	}, nil
+}
+
 // TaskStatus returns the status of the running task.
 func (t *Task) TaskStatus() (*TaskStatus, error) {
 	taskID, err := t.taskID(aws.StringValue(t.TaskArn))
@@ -335,6 +480,24 @@ func (t *Task) taskID(taskArn string) (string, error) {
 	return taskID, nil
 }
 
+// ContainerNames returns the names of the containers in the task.
+func (t *Task) ContainerNames() []string {
+	var names []string
+	for _, container := range t.Containers {
+		names = append(names, aws.StringValue(container.Name))
+	}
+	return names
+}
+
+func (t *Task) containerRuntimeID(containerName string) (string, error) {
+	for _, container := range t.Containers {
+		if aws.StringValue(container.Name) == containerName {
+			return aws.StringValue(container.RuntimeId), nil
+		}
+	}
+	return "", fmt.Errorf("container %s not found in task %s", containerName, aws.StringValue(t.TaskArn))
+}
+
 // imageDigest returns the short image digest.
 // For example: sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7
 // becomes 18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7.
@@ -362,6 +525,28 @@ func (t *TaskDefinition) EnvironmentVariables() map[string]string {
 	return envs
 }
 
+// ContainerImage returns the image of the container with the given name in the task definition.
+func (t *TaskDefinition) ContainerImage(containerName string) (string, error) {
+	for _, container := range t.ContainerDefinitions {
+		if aws.StringValue(container.Name) == containerName {
+			return aws.StringValue(container.Image), nil
+		}
+	}
+	return "", fmt.Errorf("container %s not found in task definition %s", containerName, aws.StringValue(t.TaskDefinitionArn))
+}
+
+// resourceName returns the name at the end of the resource of an ARN.
+// For example: arn:aws:ecs:us-west-2:1234567890:cluster/my-project-test-Cluster-9F7Y0RLP60R7
+// will return my-project-test-Cluster-9F7Y0RLP60R7
+func resourceName(resourceArn string) (string, error) {
+	parsedArn, err := arn.Parse(resourceArn)
+	if err != nil {
+		return "", err
+	}
+	resources := strings.Split(parsedArn.Resource, "/")
+	return resources[len(resources)-1], nil
+}
+
 // ServiceArn is the arn of an ECS service.
 type ServiceArn string
 
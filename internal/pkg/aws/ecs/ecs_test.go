@@ -328,6 +328,117 @@ func TestECS_Tasks(t *testing.T) {
 	}
 }
 
+func TestECS_StoppedServiceTasks(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr   error
+		wantTasks []*Task
+	}{
+		"errors if failed to list stopped tasks": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					ServiceName:   aws.String("mockService"),
+					DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("list stopped tasks of service mockService: some error"),
+		},
+		"errors if failed to describe stopped tasks": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(gomock.Any()).Return(&ecs.ListTasksOutput{
+					TaskArns: aws.StringSlice([]string{"mockTaskArn"}),
+				}, nil)
+				m.EXPECT().DescribeTasks(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("describe stopped tasks in cluster mockCluster: some error"),
+		},
+		"does not describe tasks if there are no stopped tasks": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(gomock.Any()).Return(&ecs.ListTasksOutput{}, nil)
+				m.EXPECT().DescribeTasks(gomock.Any()).Times(0)
+			},
+		},
+		"success with pagination": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					ServiceName:   aws.String("mockService"),
+					DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+				}).Return(&ecs.ListTasksOutput{
+					NextToken: aws.String("mockNextToken"),
+					TaskArns:  aws.StringSlice([]string{"mockTaskArn1"}),
+				}, nil)
+				m.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
+					Cluster: aws.String("mockCluster"),
+					Tasks:   aws.StringSlice([]string{"mockTaskArn1"}),
+				}).Return(&ecs.DescribeTasksOutput{
+					Tasks: []*ecs.Task{
+						{
+							TaskArn:       aws.String("mockTaskArn1"),
+							StoppedReason: aws.String("Essential container in task exited"),
+						},
+					},
+				}, nil)
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					ServiceName:   aws.String("mockService"),
+					DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+					NextToken:     aws.String("mockNextToken"),
+				}).Return(&ecs.ListTasksOutput{
+					TaskArns: aws.StringSlice([]string{"mockTaskArn2"}),
+				}, nil)
+				m.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
+					Cluster: aws.String("mockCluster"),
+					Tasks:   aws.StringSlice([]string{"mockTaskArn2"}),
+				}).Return(&ecs.DescribeTasksOutput{
+					Tasks: []*ecs.Task{
+						{
+							TaskArn: aws.String("mockTaskArn2"),
+						},
+					},
+				}, nil)
+			},
+			wantTasks: []*Task{
+				{
+					TaskArn:       aws.String("mockTaskArn1"),
+					StoppedReason: aws.String("Essential container in task exited"),
+				},
+				{
+					TaskArn: aws.String("mockTaskArn2"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			gotTasks, gotErr := service.StoppedServiceTasks("mockCluster", "mockService")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantTasks, gotTasks)
+			}
+		})
+	}
+}
+
 func TestECS_DefaultCluster(t *testing.T) {
 	testCases := map[string]struct {
 		mockECSClient func(m *mocks.Mockapi)
@@ -455,6 +566,7 @@ func TestECS_RunTask(t *testing.T) {
 		securityGroups []string
 		taskFamilyName string
 		startedBy      string
+		spot           bool
 	}
 
 	runTaskInput := input{
@@ -478,11 +590,12 @@ func TestECS_RunTask(t *testing.T) {
 			input: runTaskInput,
 			mockECSClient: func(m *mocks.Mockapi) {
 				m.EXPECT().RunTask(&ecs.RunTaskInput{
-					Cluster:        aws.String("my-cluster"),
-					Count:          aws.Int64(3),
-					LaunchType:     aws.String(ecs.LaunchTypeFargate),
-					StartedBy:      aws.String("task"),
-					TaskDefinition: aws.String("my-task"),
+					Cluster:              aws.String("my-cluster"),
+					Count:                aws.Int64(3),
+					LaunchType:           aws.String(ecs.LaunchTypeFargate),
+					StartedBy:            aws.String("task"),
+					TaskDefinition:       aws.String("my-task"),
+					EnableExecuteCommand: aws.Bool(false),
 					NetworkConfiguration: &ecs.NetworkConfiguration{
 						AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
 							AssignPublicIp: aws.String(ecs.AssignPublicIpEnabled),
@@ -543,11 +656,12 @@ func TestECS_RunTask(t *testing.T) {
 
 			mockECSClient: func(m *mocks.Mockapi) {
 				m.EXPECT().RunTask(&ecs.RunTaskInput{
-					Cluster:        aws.String("my-cluster"),
-					Count:          aws.Int64(3),
-					LaunchType:     aws.String(ecs.LaunchTypeFargate),
-					StartedBy:      aws.String("task"),
-					TaskDefinition: aws.String("my-task"),
+					Cluster:              aws.String("my-cluster"),
+					Count:                aws.Int64(3),
+					LaunchType:           aws.String(ecs.LaunchTypeFargate),
+					StartedBy:            aws.String("task"),
+					TaskDefinition:       aws.String("my-task"),
+					EnableExecuteCommand: aws.Bool(false),
 					NetworkConfiguration: &ecs.NetworkConfiguration{
 						AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
 							AssignPublicIp: aws.String(ecs.AssignPublicIpEnabled),
@@ -561,6 +675,59 @@ func TestECS_RunTask(t *testing.T) {
 			},
 			wantedError: errors.New("run task(s) my-task: error"),
 		},
+		"run task on fargate spot": {
+			input: input{
+				cluster:        "my-cluster",
+				count:          1,
+				subnets:        []string{"subnet-1"},
+				securityGroups: []string{"sg-1"},
+				taskFamilyName: "my-task",
+				startedBy:      "task",
+				spot:           true,
+			},
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RunTask(&ecs.RunTaskInput{
+					Cluster: aws.String("my-cluster"),
+					Count:   aws.Int64(1),
+					CapacityProviderStrategy: []*ecs.CapacityProviderStrategyItem{
+						{
+							CapacityProvider: aws.String("FARGATE_SPOT"),
+							Weight:           aws.Int64(1),
+						},
+					},
+					StartedBy:            aws.String("task"),
+					TaskDefinition:       aws.String("my-task"),
+					EnableExecuteCommand: aws.Bool(false),
+					NetworkConfiguration: &ecs.NetworkConfiguration{
+						AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+							AssignPublicIp: aws.String(ecs.AssignPublicIpEnabled),
+							Subnets:        aws.StringSlice([]string{"subnet-1"}),
+							SecurityGroups: aws.StringSlice([]string{"sg-1"}),
+						},
+					},
+				}).Return(&ecs.RunTaskOutput{
+					Tasks: []*ecs.Task{
+						{
+							TaskArn: aws.String("task-1"),
+						},
+					},
+				}, nil)
+				m.EXPECT().WaitUntilTasksRunning(gomock.Any()).Times(1)
+				m.EXPECT().DescribeTasks(gomock.Any()).Return(&ecs.DescribeTasksOutput{
+					Tasks: []*ecs.Task{
+						{
+							TaskArn: aws.String("task-1"),
+						},
+					},
+				}, nil)
+			},
+
+			wantedTasks: []*Task{
+				{
+					TaskArn: aws.String("task-1"),
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -582,6 +749,7 @@ func TestECS_RunTask(t *testing.T) {
 				Subnets:        tc.subnets,
 				SecurityGroups: tc.securityGroups,
 				StartedBy:      tc.startedBy,
+				Spot:           tc.spot,
 			})
 
 			if tc.wantedError != nil {
@@ -593,6 +761,210 @@ func TestECS_RunTask(t *testing.T) {
 	}
 }
 
+func TestECS_RunningTasksInFamily(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedTasks []*Task
+	}{
+		"errors if failed to list running tasks": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster:       aws.String("mockCluster"),
+					Family:        aws.String("copilot-my-task"),
+					DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("list running tasks of family copilot-my-task: some error"),
+		},
+		"errors if failed to describe running tasks": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(gomock.Any()).Return(&ecs.ListTasksOutput{
+					TaskArns: aws.StringSlice([]string{"mockTaskArn"}),
+				}, nil)
+				m.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
+					Cluster: aws.String("mockCluster"),
+					Tasks:   aws.StringSlice([]string{"mockTaskArn"}),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe running tasks in cluster mockCluster: some error"),
+		},
+		"success with pagination": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().ListTasks(&ecs.ListTasksInput{
+						Cluster:       aws.String("mockCluster"),
+						Family:        aws.String("copilot-my-task"),
+						DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+					}).Return(&ecs.ListTasksOutput{
+						NextToken: aws.String("mockNextToken"),
+						TaskArns:  aws.StringSlice([]string{"mockTaskArn1"}),
+					}, nil),
+					m.EXPECT().DescribeTasks(gomock.Any()).Return(&ecs.DescribeTasksOutput{
+						Tasks: []*ecs.Task{{TaskArn: aws.String("mockTaskArn1")}},
+					}, nil),
+					m.EXPECT().ListTasks(&ecs.ListTasksInput{
+						Cluster:       aws.String("mockCluster"),
+						Family:        aws.String("copilot-my-task"),
+						DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+						NextToken:     aws.String("mockNextToken"),
+					}).Return(&ecs.ListTasksOutput{
+						TaskArns: aws.StringSlice([]string{"mockTaskArn2"}),
+					}, nil),
+					m.EXPECT().DescribeTasks(gomock.Any()).Return(&ecs.DescribeTasksOutput{
+						Tasks: []*ecs.Task{{TaskArn: aws.String("mockTaskArn2")}},
+					}, nil),
+				)
+			},
+			wantedTasks: []*Task{
+				{TaskArn: aws.String("mockTaskArn1")},
+				{TaskArn: aws.String("mockTaskArn2")},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			ecsSvc := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			gotTasks, gotErr := ecsSvc.RunningTasksInFamily("mockCluster", "copilot-my-task")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantedTasks, gotTasks)
+			}
+		})
+	}
+}
+
+func TestECS_ExecuteCommand(t *testing.T) {
+	const (
+		mockClusterArn = "arn:aws:ecs:us-west-2:123456789:cluster/mockCluster"
+		mockTaskArn    = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/4082490ee6c245e09d2145010aa1ba8d"
+	)
+	mockInput := ExecuteCommandInput{
+		Cluster:   mockClusterArn,
+		Task:      mockTaskArn,
+		Container: "frontend",
+		Command:   "/bin/sh",
+	}
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantedError   error
+		wantedSession *Session
+	}{
+		"errors if failed to execute command": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ExecuteCommand(&ecs.ExecuteCommandInput{
+					Cluster:     aws.String(mockClusterArn),
+					Command:     aws.String("/bin/sh"),
+					Container:   aws.String("frontend"),
+					Interactive: aws.Bool(true),
+					Task:        aws.String(mockTaskArn),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("execute command /bin/sh in container frontend: some error"),
+		},
+		"errors if the container is not in the task": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ExecuteCommand(gomock.Any()).Return(&ecs.ExecuteCommandOutput{
+					ClusterArn: aws.String(mockClusterArn),
+					TaskArn:    aws.String(mockTaskArn),
+					Session:    &ecs.Session{},
+				}, nil)
+				m.EXPECT().DescribeTasks(gomock.Any()).Return(&ecs.DescribeTasksOutput{
+					Tasks: []*ecs.Task{
+						{
+							TaskArn: aws.String(mockTaskArn),
+						},
+					},
+				}, nil)
+			},
+			wantedError: fmt.Errorf("container frontend not found in task %s", mockTaskArn),
+		},
+		"success": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ExecuteCommand(gomock.Any()).Return(&ecs.ExecuteCommandOutput{
+					ClusterArn: aws.String(mockClusterArn),
+					TaskArn:    aws.String(mockTaskArn),
+					Session: &ecs.Session{
+						SessionId:  aws.String("mockSessionID"),
+						StreamUrl:  aws.String("mockStreamURL"),
+						TokenValue: aws.String("mockToken"),
+					},
+				}, nil)
+				m.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
+					Cluster: aws.String(mockClusterArn),
+					Tasks:   aws.StringSlice([]string{mockTaskArn}),
+				}).Return(&ecs.DescribeTasksOutput{
+					Tasks: []*ecs.Task{
+						{
+							TaskArn: aws.String(mockTaskArn),
+							Containers: []*ecs.Container{
+								{
+									Name:      aws.String("firelens_log_router"),
+									RuntimeId: aws.String("4082490ee6c245e09d2145010aa1ba8d-1234"),
+								},
+								{
+									Name:      aws.String("frontend"),
+									RuntimeId: aws.String("4082490ee6c245e09d2145010aa1ba8d-5678"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedSession: &Session{
+				ID:         "mockSessionID",
+				StreamURL:  "mockStreamURL",
+				TokenValue: "mockToken",
+				Target:     "ecs:mockCluster_4082490ee6c245e09d2145010aa1ba8d_4082490ee6c245e09d2145010aa1ba8d-5678",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			ecsSvc := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			gotSession, gotErr := ecsSvc.ExecuteCommand(mockInput)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantedSession, gotSession)
+			}
+		})
+	}
+}
+
 func TestECS_DescribeTasks(t *testing.T) {
 	inCluster := "my-cluster"
 	inTaskARNs := []string{"task-1", "task-2", "task-3"}
@@ -713,6 +1085,64 @@ func TestTaskDefinition_EnvVars(t *testing.T) {
 	}
 }
 
+func TestTaskDefinition_ContainerImage(t *testing.T) {
+	testCases := map[string]struct {
+		inContainers []*ecs.ContainerDefinition
+		inName       string
+
+		wantImage string
+		wantErr   error
+	}{
+		"returns the image of the container": {
+			inContainers: []*ecs.ContainerDefinition{
+				{
+					Name:  aws.String("firelens_log_router"),
+					Image: aws.String("amazon/aws-for-fluent-bit"),
+				},
+				{
+					Name:  aws.String("my-svc"),
+					Image: aws.String("1234567890.dkr.ecr.us-west-2.amazonaws.com/my-app/my-svc:v1"),
+				},
+			},
+			inName: "my-svc",
+
+			wantImage: "1234567890.dkr.ecr.us-west-2.amazonaws.com/my-app/my-svc:v1",
+		},
+		"returns an error if the container does not exist": {
+			inContainers: []*ecs.ContainerDefinition{
+				{
+					Name:  aws.String("firelens_log_router"),
+					Image: aws.String("amazon/aws-for-fluent-bit"),
+				},
+			},
+			inName: "my-svc",
+
+			wantErr: errors.New("container my-svc not found in task definition mockTaskDefArn"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			taskDefinition := TaskDefinition{
+				TaskDefinitionArn:    aws.String("mockTaskDefArn"),
+				ContainerDefinitions: tc.inContainers,
+			}
+
+			// WHEN
+			gotImage, err := taskDefinition.ContainerImage(tc.inName)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantImage, gotImage)
+			}
+		})
+	}
+}
+
 func TestTask_TaskStatus(t *testing.T) {
 	startTime, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05+00:00")
 	stopTime, _ := time.Parse(time.RFC3339, "2006-01-02T16:04:05+00:00")
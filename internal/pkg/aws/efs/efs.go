@@ -0,0 +1,63 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package efs provides a client to make API requests to Amazon Elastic File System.
+package efs
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/efs"
+)
+
+type api interface {
+	DescribeMountTargets(input *efs.DescribeMountTargetsInput) (*efs.DescribeMountTargetsOutput, error)
+	DescribeMountTargetSecurityGroups(input *efs.DescribeMountTargetSecurityGroupsInput) (*efs.DescribeMountTargetSecurityGroupsOutput, error)
+}
+
+// EFS wraps an Amazon Elastic File System client.
+type EFS struct {
+	client api
+}
+
+// New returns an EFS client configured against the input session.
+func New(s *session.Session) *EFS {
+	return &EFS{
+		client: efs.New(s),
+	}
+}
+
+// MountTargetSecurityGroups returns the IDs of the security groups of each mount target of the file system,
+// keyed by the ID of the mount target.
+func (e *EFS) MountTargetSecurityGroups(fileSystemID string) (map[string][]string, error) {
+	var mountTargets []*efs.MountTargetDescription
+	in := &efs.DescribeMountTargetsInput{
+		FileSystemId: aws.String(fileSystemID),
+	}
+	for {
+		out, err := e.client.DescribeMountTargets(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe mount targets of file system %s: %w", fileSystemID, err)
+		}
+		mountTargets = append(mountTargets, out.MountTargets...)
+		if out.NextMarker == nil {
+			break
+		}
+		in.Marker = out.NextMarker
+	}
+
+	groups := make(map[string][]string, len(mountTargets))
+	for _, mt := range mountTargets {
+		id := aws.StringValue(mt.MountTargetId)
+		out, err := e.client.DescribeMountTargetSecurityGroups(&efs.DescribeMountTargetSecurityGroupsInput{
+			MountTargetId: mt.MountTargetId,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe security groups of mount target %s: %w", id, err)
+		}
+		groups[id] = aws.StringValueSlice(out.SecurityGroups)
+	}
+	return groups, nil
+}
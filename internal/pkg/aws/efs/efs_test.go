@@ -0,0 +1,94 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package efs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/efs/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEFS_MountTargetSecurityGroups(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedGroups map[string][]string
+		wantedErr    error
+	}{
+		"errors if the mount targets can't be described": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeMountTargets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe mount targets of file system fs-1: some error"),
+		},
+		"errors if the security groups of a mount target can't be described": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeMountTargets(gomock.Any()).Return(&efs.DescribeMountTargetsOutput{
+					MountTargets: []*efs.MountTargetDescription{{MountTargetId: aws.String("fsmt-1")}},
+				}, nil)
+				m.EXPECT().DescribeMountTargetSecurityGroups(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe security groups of mount target fsmt-1: some error"),
+		},
+		"returns the security groups of every page of mount targets": {
+			setupMocks: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().DescribeMountTargets(&efs.DescribeMountTargetsInput{
+						FileSystemId: aws.String("fs-1"),
+					}).Return(&efs.DescribeMountTargetsOutput{
+						MountTargets: []*efs.MountTargetDescription{{MountTargetId: aws.String("fsmt-1")}},
+						NextMarker:   aws.String("next"),
+					}, nil),
+					m.EXPECT().DescribeMountTargets(&efs.DescribeMountTargetsInput{
+						FileSystemId: aws.String("fs-1"),
+						Marker:       aws.String("next"),
+					}).Return(&efs.DescribeMountTargetsOutput{
+						MountTargets: []*efs.MountTargetDescription{{MountTargetId: aws.String("fsmt-2")}},
+					}, nil),
+				)
+				m.EXPECT().DescribeMountTargetSecurityGroups(&efs.DescribeMountTargetSecurityGroupsInput{
+					MountTargetId: aws.String("fsmt-1"),
+				}).Return(&efs.DescribeMountTargetSecurityGroupsOutput{
+					SecurityGroups: aws.StringSlice([]string{"sg-1"}),
+				}, nil)
+				m.EXPECT().DescribeMountTargetSecurityGroups(&efs.DescribeMountTargetSecurityGroupsInput{
+					MountTargetId: aws.String("fsmt-2"),
+				}).Return(&efs.DescribeMountTargetSecurityGroupsOutput{
+					SecurityGroups: aws.StringSlice([]string{"sg-1", "sg-2"}),
+				}, nil)
+			},
+			wantedGroups: map[string][]string{
+				"fsmt-1": {"sg-1"},
+				"fsmt-2": {"sg-1", "sg-2"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := EFS{client: m}
+
+			// WHEN
+			got, err := client.MountTargetSecurityGroups("fs-1")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedGroups, got)
+		})
+	}
+}
@@ -5,13 +5,18 @@
 package s3
 
 import (
+	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"net/url"
 	"path"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
@@ -28,6 +33,17 @@ type s3ManagerApi interface {
 type s3Api interface {
 	ListObjectVersions(input *s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error)
 	DeleteObjects(input *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
+	GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error)
+}
+
+// ErrObjectNotFound occurs when the key of an object doesn't exist in the bucket.
+type ErrObjectNotFound struct {
+	Bucket string
+	Key    string
+}
+
+func (e *ErrObjectNotFound) Error() string {
+	return fmt.Sprintf("object %s not found in bucket %s", e.Key, e.Bucket)
 }
 
 // S3 wraps an Amazon Simple Storage Service client.
@@ -61,6 +77,41 @@ func (s *S3) PutArtifact(bucket, fileName string, data io.Reader) (string, error
 	return resp.Location, nil
 }
 
+// Upload uploads data to a S3 bucket under the key and returns its url.
+func (s *S3) Upload(bucket, key string, data io.Reader) (string, error) {
+	resp, err := s.s3Manager.Upload(&s3manager.UploadInput{
+		Body:   data,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return "", fmt.Errorf("put %s to bucket %s: %w", key, bucket, err)
+	}
+	return resp.Location, nil
+}
+
+// Download returns the content of the object under the key of a S3 bucket.
+// If the key doesn't exist, it returns an ErrObjectNotFound.
+func (s *S3) Download(bucket, key string) ([]byte, error) {
+	out, err := s.s3Client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, &ErrObjectNotFound{Bucket: bucket, Key: key}
+		}
+		return nil, fmt.Errorf("get %s from bucket %s: %w", key, bucket, err)
+	}
+	defer out.Body.Close()
+	content, err := ioutil.ReadAll(out.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read %s from bucket %s: %w", key, bucket, err)
+	}
+	return content, nil
+}
+
 // EmptyBucket deletes all objects within the bucket.
 func (s *S3) EmptyBucket(bucket string) error {
 	var listResp *s3.ListObjectVersionsOutput
@@ -102,3 +153,27 @@ func (s *S3) EmptyBucket(bucket string) error {
 		listParams.VersionIdMarker = listResp.NextVersionIdMarker
 	}
 }
+
+// ParseURL splits the URL of an S3 object, such as "https://bucket.s3.us-west-2.amazonaws.com/key",
+// into the name of its bucket and its key. Both virtual-hosted-style and path-style URLs are supported.
+func ParseURL(objectURL string) (bucket string, key string, err error) {
+	parsed, err := url.Parse(objectURL)
+	if err != nil {
+		return "", "", fmt.Errorf("parse URL %s: %w", objectURL, err)
+	}
+	objectPath := strings.TrimPrefix(parsed.Path, "/")
+	if strings.HasPrefix(parsed.Host, "s3.") || strings.HasPrefix(parsed.Host, "s3-") {
+		// Path-style URL: "https://s3.region.amazonaws.com/bucket/key".
+		parts := strings.SplitN(objectPath, "/", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return "", "", fmt.Errorf("cannot parse S3 object URL %s", objectURL)
+		}
+		return parts[0], parts[1], nil
+	}
+	// Virtual-hosted-style URL: "https://bucket.s3.region.amazonaws.com/key".
+	i := strings.Index(parsed.Host, ".s3")
+	if i <= 0 || objectPath == "" {
+		return "", "", fmt.Errorf("cannot parse S3 object URL %s", objectURL)
+	}
+	return parsed.Host[:i], objectPath, nil
+}
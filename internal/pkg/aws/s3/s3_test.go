@@ -7,11 +7,13 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"strconv"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3/mocks"
@@ -89,6 +91,117 @@ func TestS3_PutArtifact(t *testing.T) {
 	}
 }
 
+func TestS3_Upload(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3ManagerClient func(m *mocks.Mocks3ManagerApi)
+
+		wantErr error
+		wantURL string
+	}{
+		"should put the object under the key": {
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerApi) {
+				m.EXPECT().Upload(gomock.Any()).DoAndReturn(func(in *s3manager.UploadInput, _ ...func(*s3manager.Uploader)) (*s3manager.UploadOutput, error) {
+					require.Equal(t, "mockBucket", aws.StringValue(in.Bucket))
+					require.Equal(t, "revisions/app/test/api/3.json", aws.StringValue(in.Key))
+					return &s3manager.UploadOutput{
+						Location: "https://mockBucket/revisions/app/test/api/3.json",
+					}, nil
+				})
+			},
+			wantURL: "https://mockBucket/revisions/app/test/api/3.json",
+		},
+		"should return error if fail to upload": {
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerApi) {
+				m.EXPECT().Upload(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("put revisions/app/test/api/3.json to bucket mockBucket: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3ManagerClient := mocks.NewMocks3ManagerApi(ctrl)
+			tc.mockS3ManagerClient(mockS3ManagerClient)
+			service := S3{
+				s3Manager: mockS3ManagerClient,
+			}
+
+			// WHEN
+			gotURL, gotErr := service.Upload("mockBucket", "revisions/app/test/api/3.json", bytes.NewBufferString("{}"))
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.wantURL, gotURL)
+		})
+	}
+}
+
+func TestS3_Download(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3Api)
+
+		wantErr     error
+		wantContent []byte
+	}{
+		"should return the content of the object": {
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().GetObject(&s3.GetObjectInput{
+					Bucket: aws.String("mockBucket"),
+					Key:    aws.String("revisions/app/test/api/3.json"),
+				}).Return(&s3.GetObjectOutput{
+					Body: ioutil.NopCloser(bytes.NewBufferString("{}")),
+				}, nil)
+			},
+			wantContent: []byte("{}"),
+		},
+		"should return ErrObjectNotFound if the key doesn't exist": {
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().GetObject(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeNoSuchKey, "not found", nil))
+			},
+			wantErr: &ErrObjectNotFound{Bucket: "mockBucket", Key: "revisions/app/test/api/3.json"},
+		},
+		"should return error if fail to get the object": {
+			mockS3Client: func(m *mocks.Mocks3Api) {
+				m.EXPECT().GetObject(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("get revisions/app/test/api/3.json from bucket mockBucket: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3Api(ctrl)
+			tc.mockS3Client(mockS3Client)
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			// WHEN
+			gotContent, gotErr := service.Download("mockBucket", "revisions/app/test/api/3.json")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.wantContent, gotContent)
+		})
+	}
+}
+
 func TestS3_EmptyBucket(t *testing.T) {
 	batchObject1 := make([]*s3.ObjectVersion, 1000)
 	batchObject2 := make([]*s3.ObjectVersion, 10)
@@ -257,3 +370,46 @@ func TestS3_EmptyBucket(t *testing.T) {
 
 	}
 }
+
+func TestParseURL(t *testing.T) {
+	testCases := map[string]struct {
+		inURL string
+
+		wantedBucket string
+		wantedKey    string
+		wantedErr    error
+	}{
+		"virtual-hosted-style URL": {
+			inURL:        "https://stackset-bucket.s3.us-west-2.amazonaws.com/manual/1612345678/api.env",
+			wantedBucket: "stackset-bucket",
+			wantedKey:    "manual/1612345678/api.env",
+		},
+		"path-style URL": {
+			inURL:        "https://s3.us-west-2.amazonaws.com/stackset-bucket/manual/1612345678/api.env",
+			wantedBucket: "stackset-bucket",
+			wantedKey:    "manual/1612345678/api.env",
+		},
+		"error if the URL has no key": {
+			inURL:     "https://stackset-bucket.s3.us-west-2.amazonaws.com/",
+			wantedErr: errors.New("cannot parse S3 object URL https://stackset-bucket.s3.us-west-2.amazonaws.com/"),
+		},
+		"error if the URL is not an S3 URL": {
+			inURL:     "https://example.com/api.env",
+			wantedErr: errors.New("cannot parse S3 object URL https://example.com/api.env"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			bucket, key, err := ParseURL(tc.inURL)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedBucket, bucket)
+			require.Equal(t, tc.wantedKey, key)
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 )
@@ -17,6 +18,7 @@ import (
 type api interface {
 	CreateSecret(*secretsmanager.CreateSecretInput) (*secretsmanager.CreateSecretOutput, error)
 	DeleteSecret(*secretsmanager.DeleteSecretInput) (*secretsmanager.DeleteSecretOutput, error)
+	GetSecretValue(*secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error)
 }
 
 // SecretsManager wraps the AWS SecretManager client.
@@ -40,6 +42,14 @@ func New() (*SecretsManager, error) {
 	}, nil
 }
 
+// NewWithSession returns a SecretsManager configured with the input session.
+func NewWithSession(s *session.Session) *SecretsManager {
+	return &SecretsManager{
+		secretsManager: secretsmanager.New(s),
+		sessionRegion:  aws.StringValue(s.Config.Region),
+	}
+}
+
 var secretTags = func() []*secretsmanager.Tag {
 	timestamp := time.Now().UTC().Format(time.UnixDate)
 	return []*secretsmanager.Tag{
@@ -88,6 +98,17 @@ func (s *SecretsManager) DeleteSecret(secretName string) error {
 	return nil
 }
 
+// SecretValue returns the decrypted string value of a secret given its name or ARN.
+func (s *SecretsManager) SecretValue(secretID string) (string, error) {
+	resp, err := s.secretsManager.GetSecretValue(&secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(secretID),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get value of secret %s: %w", secretID, err)
+	}
+	return aws.StringValue(resp.SecretString), nil
+}
+
 // ErrSecretAlreadyExists occurs if a secret with the same name already exists.
 type ErrSecretAlreadyExists struct {
 	secretName string
@@ -105,3 +105,55 @@ func TestSecretsManager_CreateSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretsManager_SecretValue(t *testing.T) {
+	mockSecretID := "arn:aws:secretsmanager:us-west-2:123456789012:secret:mydb-secret"
+	mockError := errors.New("mockError")
+
+	tests := map[string]struct {
+		callMock func(m *mocks.Mockapi)
+
+		expectedValue string
+		expectedError error
+	}{
+		"should wrap error returned by GetSecretValue": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValue(&secretsmanager.GetSecretValueInput{
+					SecretId: aws.String(mockSecretID),
+				}).Return(nil, mockError)
+			},
+			expectedError: fmt.Errorf("get value of secret %s: %w", mockSecretID, mockError),
+		},
+		"should return the secret string if successful": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValue(&secretsmanager.GetSecretValueInput{
+					SecretId: aws.String(mockSecretID),
+				}).Return(&secretsmanager.GetSecretValueOutput{
+					SecretString: aws.String(`{"username":"admin"}`),
+				}, nil)
+			},
+			expectedValue: `{"username":"admin"}`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSecretsManager := mocks.NewMockapi(ctrl)
+			tc.callMock(mockSecretsManager)
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+
+			// WHEN
+			value, err := sm.SecretValue(mockSecretID)
+
+			// THEN
+			require.Equal(t, tc.expectedError, err)
+			require.Equal(t, tc.expectedValue, value)
+		})
+	}
+}
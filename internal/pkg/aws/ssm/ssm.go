@@ -0,0 +1,154 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ssm provides a client to make API requests to AWS Systems Manager Parameter Store.
+package ssm
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+type api interface {
+	GetParameter(input *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+	PutParameter(input *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+	GetParametersByPath(input *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error)
+	DeleteParameter(input *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+}
+
+// PutSecretInput holds the configuration of a SecureString parameter to create or update.
+type PutSecretInput struct {
+	Name      string
+	Value     string
+	Overwrite bool              // Update the value of the parameter if it already exists.
+	Tags      map[string]string // Tags applied to the parameter when it's created.
+}
+
+// SSM wraps an AWS SSM client.
+type SSM struct {
+	client api
+}
+
+// New returns a SSM struct configured against the input session.
+func New(s *session.Session) *SSM {
+	return &SSM{
+		client: ssm.New(s),
+	}
+}
+
+// ParameterValue returns the decrypted value of a parameter given its name or ARN.
+func (s *SSM) ParameterValue(name string) (string, error) {
+	out, err := s.client.GetParameter(&ssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get parameter %s: %w", name, err)
+	}
+	return aws.StringValue(out.Parameter.Value), nil
+}
+
+// PutSecret creates a SecureString parameter with the input tags.
+// If the parameter already exists, its value is only updated if the input allows overwriting it,
+// otherwise ErrParameterAlreadyExists is returned.
+func (s *SSM) PutSecret(in PutSecretInput) error {
+	var tags []*ssm.Tag
+	// Sort the keys so that the tags are applied in a stable order.
+	var keys []string
+	for k := range in.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		tags = append(tags, &ssm.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(in.Tags[k]),
+		})
+	}
+	_, err := s.client.PutParameter(&ssm.PutParameterInput{
+		Name:  aws.String(in.Name),
+		Value: aws.String(in.Value),
+		Type:  aws.String(ssm.ParameterTypeSecureString),
+		Tags:  tags,
+	})
+	if err == nil {
+		return nil
+	}
+	if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != ssm.ErrCodeParameterAlreadyExists {
+		return fmt.Errorf("put parameter %s: %w", in.Name, err)
+	}
+	if !in.Overwrite {
+		return &ErrParameterAlreadyExists{name: in.Name}
+	}
+	// Tags can't be specified when overwriting a parameter, the existing parameter keeps its tags.
+	if _, err := s.client.PutParameter(&ssm.PutParameterInput{
+		Name:      aws.String(in.Name),
+		Value:     aws.String(in.Value),
+		Type:      aws.String(ssm.ParameterTypeSecureString),
+		Overwrite: aws.Bool(true),
+	}); err != nil {
+		return fmt.Errorf("overwrite parameter %s: %w", in.Name, err)
+	}
+	return nil
+}
+
+// ParameterNames returns the names of the parameters directly under the input path.
+func (s *SSM) ParameterNames(path string) ([]string, error) {
+	var names []string
+	var nextToken *string
+	for {
+		out, err := s.client.GetParametersByPath(&ssm.GetParametersByPathInput{
+			Path:      aws.String(path),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get parameters by path %s: %w", path, err)
+		}
+		for _, param := range out.Parameters {
+			names = append(names, aws.StringValue(param.Name))
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	return names, nil
+}
+
+// DeleteParameter deletes a parameter given its name.
+// It returns an ErrParameterNotFound if the parameter doesn't exist.
+func (s *SSM) DeleteParameter(name string) error {
+	_, err := s.client.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(name),
+	})
+	if err == nil {
+		return nil
+	}
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == ssm.ErrCodeParameterNotFound {
+		return &ErrParameterNotFound{name: name}
+	}
+	return fmt.Errorf("delete parameter %s: %w", name, err)
+}
+
+// ErrParameterAlreadyExists occurs if a parameter with the same name already exists.
+type ErrParameterAlreadyExists struct {
+	name string
+}
+
+func (err *ErrParameterAlreadyExists) Error() string {
+	return fmt.Sprintf("parameter %s already exists", err.name)
+}
+
+// ErrParameterNotFound occurs if the parameter to delete doesn't exist.
+type ErrParameterNotFound struct {
+	name string
+}
+
+func (err *ErrParameterNotFound) Error() string {
+	return fmt.Sprintf("parameter %s not found", err.name)
+}
@@ -0,0 +1,293 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ssm
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSM_ParameterValue(t *testing.T) {
+	const mockName = "/copilot/phonetool/test/secrets/GITHUB_TOKEN"
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedValue string
+		wantedError error
+	}{
+		"errors if failed to get the parameter": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(&ssm.GetParameterInput{
+					Name:           aws.String(mockName),
+					WithDecryption: aws.Bool(true),
+				}).Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("get parameter %s: %w", mockName, mockError),
+		},
+		"returns the decrypted value": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(&ssm.GetParameterInput{
+					Name:           aws.String(mockName),
+					WithDecryption: aws.Bool(true),
+				}).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Value: aws.String("s3cr3t"),
+					},
+				}, nil)
+			},
+
+			wantedValue: "s3cr3t",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockClient)
+			client := SSM{
+				client: mockClient,
+			}
+
+			// WHEN
+			value, err := client.ParameterValue(mockName)
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedValue, value)
+		})
+	}
+}
+
+func TestSSM_PutSecret(t *testing.T) {
+	const mockName = "/copilot/phonetool/test/secrets/GITHUB_TOKEN"
+	mockError := errors.New("some error")
+	mockExistsErr := awserr.New(ssm.ErrCodeParameterAlreadyExists, "already exists", nil)
+	createInput := &ssm.PutParameterInput{
+		Name:  aws.String(mockName),
+		Value: aws.String("s3cr3t"),
+		Type:  aws.String(ssm.ParameterTypeSecureString),
+		Tags: []*ssm.Tag{
+			{
+				Key:   aws.String("copilot-application"),
+				Value: aws.String("phonetool"),
+			},
+			{
+				Key:   aws.String("copilot-environment"),
+				Value: aws.String("test"),
+			},
+		},
+	}
+	overwriteInput := &ssm.PutParameterInput{
+		Name:      aws.String(mockName),
+		Value:     aws.String("s3cr3t"),
+		Type:      aws.String(ssm.ParameterTypeSecureString),
+		Overwrite: aws.Bool(true),
+	}
+	testCases := map[string]struct {
+		inOverwrite bool
+		setupMocks  func(m *mocks.Mockapi)
+
+		wantedError error
+	}{
+		"errors if failed to create the parameter": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().PutParameter(createInput).Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("put parameter %s: %w", mockName, mockError),
+		},
+		"creates the parameter with tags": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().PutParameter(createInput).Return(&ssm.PutParameterOutput{}, nil)
+			},
+		},
+		"errors if the parameter exists and overwriting is not allowed": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().PutParameter(createInput).Return(nil, mockExistsErr)
+			},
+
+			wantedError: &ErrParameterAlreadyExists{name: mockName},
+		},
+		"overwrites the value of an existing parameter": {
+			inOverwrite: true,
+			setupMocks: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().PutParameter(createInput).Return(nil, mockExistsErr),
+					m.EXPECT().PutParameter(overwriteInput).Return(&ssm.PutParameterOutput{}, nil),
+				)
+			},
+		},
+		"errors if failed to overwrite the parameter": {
+			inOverwrite: true,
+			setupMocks: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().PutParameter(createInput).Return(nil, mockExistsErr),
+					m.EXPECT().PutParameter(overwriteInput).Return(nil, mockError),
+				)
+			},
+
+			wantedError: fmt.Errorf("overwrite parameter %s: %w", mockName, mockError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockClient)
+			client := SSM{
+				client: mockClient,
+			}
+
+			// WHEN
+			err := client.PutSecret(PutSecretInput{
+				Name:      mockName,
+				Value:     "s3cr3t",
+				Overwrite: tc.inOverwrite,
+				Tags: map[string]string{
+					"copilot-environment": "test",
+					"copilot-application": "phonetool",
+				},
+			})
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+		})
+	}
+}
+
+func TestSSM_ParameterNames(t *testing.T) {
+	const mockPath = "/copilot/phonetool/test/secrets/"
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedNames []string
+		wantedError error
+	}{
+		"errors if failed to get the parameters": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParametersByPath(&ssm.GetParametersByPathInput{
+					Path: aws.String(mockPath),
+				}).Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("get parameters by path %s: %w", mockPath, mockError),
+		},
+		"returns the names of the parameters across pages": {
+			setupMocks: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().GetParametersByPath(&ssm.GetParametersByPathInput{
+						Path: aws.String(mockPath),
+					}).Return(&ssm.GetParametersByPathOutput{
+						Parameters: []*ssm.Parameter{
+							{Name: aws.String(mockPath + "GITHUB_TOKEN")},
+						},
+						NextToken: aws.String("next"),
+					}, nil),
+					m.EXPECT().GetParametersByPath(&ssm.GetParametersByPathInput{
+						Path:      aws.String(mockPath),
+						NextToken: aws.String("next"),
+					}).Return(&ssm.GetParametersByPathOutput{
+						Parameters: []*ssm.Parameter{
+							{Name: aws.String(mockPath + "DB_PASSWORD")},
+						},
+					}, nil),
+				)
+			},
+
+			wantedNames: []string{mockPath + "GITHUB_TOKEN", mockPath + "DB_PASSWORD"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockClient)
+			client := SSM{
+				client: mockClient,
+			}
+
+			// WHEN
+			names, err := client.ParameterNames(mockPath)
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedNames, names)
+		})
+	}
+}
+
+func TestSSM_DeleteParameter(t *testing.T) {
+	const mockName = "/copilot/phonetool/test/secrets/GITHUB_TOKEN"
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedError error
+	}{
+		"errors if failed to delete the parameter": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(&ssm.DeleteParameterInput{
+					Name: aws.String(mockName),
+				}).Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("delete parameter %s: %w", mockName, mockError),
+		},
+		"returns ErrParameterNotFound if the parameter does not exist": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(&ssm.DeleteParameterInput{
+					Name: aws.String(mockName),
+				}).Return(nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil))
+			},
+
+			wantedError: &ErrParameterNotFound{name: mockName},
+		},
+		"deletes the parameter": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(&ssm.DeleteParameterInput{
+					Name: aws.String(mockName),
+				}).Return(&ssm.DeleteParameterOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockClient)
+			client := SSM{
+				client: mockClient,
+			}
+
+			// WHEN
+			err := client.DeleteParameter(mockName)
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+		})
+	}
+}
@@ -82,6 +82,8 @@ type importVPCVars struct {
 	ID               string
 	PublicSubnetIDs  []string
 	PrivateSubnetIDs []string
+
+	CIDR string // CIDR range of the VPC, looked up from its ID instead of passed by flags.
 }
 
 func (v importVPCVars) isSet() bool {
@@ -141,6 +143,7 @@ type initEnvOpts struct {
 	prog         progress
 	selVPC       ec2Selector
 	selCreds     credsSelector
+	envVPC       vpcDescriber
 
 	sess *session.Session // Session pointing to environment's AWS account and region.
 }
@@ -352,6 +355,9 @@ func (o *initEnvOpts) askImportResources() error {
 	if o.selVPC == nil {
 		o.selVPC = selector.NewEC2Select(o.prompt, ec2.New(o.sess))
 	}
+	if o.envVPC == nil {
+		o.envVPC = ec2.New(o.sess)
+	}
 	if o.ImportVPC.ID == "" {
 		vpcID, err := o.selVPC.VPC(envInitVPCSelectPrompt, "")
 		if err != nil {
@@ -389,6 +395,11 @@ func (o *initEnvOpts) askImportResources() error {
 		}
 		o.ImportVPC.PrivateSubnetIDs = privateSubnets
 	}
+	vpc, err := o.envVPC.DescribeVPC(o.ImportVPC.ID)
+	if err != nil {
+		return err
+	}
+	o.ImportVPC.CIDR = vpc.CIDR
 	return nil
 }
 
@@ -430,6 +441,7 @@ func (o *initEnvOpts) importVPCConfig() *deploy.ImportVPCConfig {
 	}
 	return &deploy.ImportVPCConfig{
 		ID:               o.ImportVPC.ID,
+		CIDR:             o.ImportVPC.CIDR,
 		PrivateSubnetIDs: o.ImportVPC.PrivateSubnetIDs,
 		PublicSubnetIDs:  o.ImportVPC.PublicSubnetIDs,
 	}
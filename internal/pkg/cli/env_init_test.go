@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -29,6 +30,7 @@ type initEnvMocks struct {
 	selVPC       *mocks.Mockec2Selector
 	selCreds     *mocks.MockcredsSelector
 	config       *mocks.MockprofileNames
+	envVPC       *mocks.MockvpcDescriber
 }
 
 func TestInitEnvOpts_Validate(t *testing.T) {
@@ -319,8 +321,23 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 					Return([]string{"mockPublicSubnet"}, nil)
 				m.selVPC.EXPECT().PrivateSubnets(envInitPrivateSubnetsSelectPrompt, "", "mockVPC").
 					Return([]string{"mockPrivateSubnet"}, nil)
+				m.envVPC.EXPECT().DescribeVPC("mockVPC").Return(&ec2.VPC{CIDR: mockVPCCIDR}, nil)
 			},
 		},
+		"fail to describe the imported VPC": {
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inImportVPCVars: importVPCVars{
+				ID:               "mockVPCID",
+				PrivateSubnetIDs: []string{"mockPrivateSubnetID"},
+				PublicSubnetIDs:  []string{"mockPublicSubnetID"},
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.envVPC.EXPECT().DescribeVPC("mockVPCID").Return(nil, mockErr)
+			},
+			wantedError: mockErr,
+		},
 		"success with importing env resources with flags": {
 			inEnv:     mockEnv,
 			inProfile: mockProfile,
@@ -332,6 +349,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 			setupMocks: func(m initEnvMocks) {
 				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
 				m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, gomock.Any(), gomock.Any()).Times(0)
+				m.envVPC.EXPECT().DescribeVPC("mockVPCID").Return(&ec2.VPC{CIDR: mockVPCCIDR}, nil)
 			},
 		},
 		"fail to get VPC CIDR": {
@@ -420,6 +438,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				config:       mocks.NewMockprofileNames(ctrl),
 				selVPC:       mocks.NewMockec2Selector(ctrl),
 				selCreds:     mocks.NewMockcredsSelector(ctrl),
+				envVPC:       mocks.NewMockvpcDescriber(ctrl),
 			}
 
 			tc.setupMocks(mocks)
@@ -440,6 +459,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				sessProvider: mocks.sessProvider,
 				selVPC:       mocks.selVPC,
 				selCreds:     mocks.selCreds,
+				envVPC:       mocks.envVPC,
 			}
 
 			// WHEN
@@ -45,6 +45,15 @@ const (
 	localFlag             = "local"
 	deleteSecretFlag      = "delete-secret"
 	svcPortFlag           = "port"
+	scheduleFlag          = "schedule"
+	revisionFlag          = "revision"
+	taskIDFlag            = "task-id"
+	containerFlag         = "container"
+	execFlag              = "exec"
+	spotFlag              = "spot"
+	platformFlag          = "platform"
+	valuesFlag            = "values"
+	overwriteFlag         = "overwrite"
 
 	storageTypeFlag         = "storage-type"
 	storagePartitionKeyFlag = "partition-key"
@@ -108,6 +117,8 @@ var (
 	subnetsFlagDescription = fmt.Sprintf(`Optional. The subnet IDs for the task to use. Can be specified multiple times.
 Cannot be specified with '%s', '%s' or '%s'.`, appFlag, envFlag, taskDefaultFlag)
 	securityGroupsFlagDescription = fmt.Sprintf(`Optional. The security group IDs for the task to use. Can be specified multiple times.
+Cannot be specified with '%s' or '%s'.`, appFlag, envFlag)
+	taskExecDefaultFlagDescription = fmt.Sprintf(`Optional. Run the command in a task of the default cluster.
 Cannot be specified with '%s' or '%s'.`, appFlag, envFlag)
 	taskDefaultFlagDescription = fmt.Sprintf(`Optional. Run tasks in default cluster and default subnets. 
 Cannot be specified with '%s', '%s' or '%s'.`, appFlag, envFlag, subnetsFlag)
@@ -153,6 +164,20 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	envProfilesFlagDescription       = "Optional. Environments and the profile to use to delete the environment."
 	deleteSecretFlagDescription      = "Deletes AWS Secrets Manager secret associated with a pipeline source repository."
 	svcPortFlagDescription           = "Optional. The port on which your service listens."
+	scheduleFlagDescription          = `The schedule on which to run this job.
+Accepts cron expressions, "@every <duration>" and predefined schedules such as "@daily".`
+	revisionFlagDescription = `Optional. The task definition revision number to redeploy.
+Defaults to prompting for a previous successful deployment.`
+	taskIDFlagDescription = `Optional. ID, or unique ID prefix, of the running task to run the command in.
+Defaults to prompting for a running task.`
+	containerFlagDescription = `Optional. Name of the container to run the command in.
+Defaults to prompting for a container of the task.`
+	execCommandFlagDescription = `Optional. The command to run in the container.`
+	taskExecFlagDescription    = `Optional. Allow running commands in the task's containers with "copilot task exec".`
+	taskSpotFlagDescription    = `Optional. Run the task on Fargate Spot capacity.
+The cluster must have the FARGATE_SPOT capacity provider.`
+	taskPlatformFlagDescription = `Optional. Platform of the task, such as "linux/arm64".
+The image is built for the platform and the cpu and memory must be supported by Fargate.`
 
 	storageFlagDescription             = "Name of the storage resource to create."
 	storageServiceFlagDescription      = "Name of the service to associate with storage."
@@ -176,7 +201,8 @@ Must be of the format '<keyName>:<dataType>'.`
 	taskGroupFlagDescription     = `Optional. The group name of the task. 
 Tasks with the same group name share the same set of resources. 
 (default directory name)`
-	taskImageTagFlagDescription = `Optional. The container image tag in addition to "latest".`
+	taskImageTagFlagDescription  = `Optional. The container image tag in addition to "latest".`
+	taskExecGroupFlagDescription = `Optional. The group name of the task to run the command in.`
 
 	vpcIDFlagDescription          = "Optional. Use an existing VPC ID."
 	publicSubnetsFlagDescription  = "Optional. Use existing public subnet IDs."
@@ -192,4 +218,11 @@ Tasks with the same group name share the same set of resources.
 	secretAccessKeyFlagDescription = "Optional. An AWS secret access key."
 	sessionTokenFlagDescription    = "Optional. An AWS session token for temporary credentials."
 	envRegionTokenFlagDescription  = "Optional. An AWS region where the environment will be created."
+
+	secretNameFlagDescription      = "Name of the secret."
+	secretValuesFlagDescription    = "Values of the secret in each environment, specified by env=value separated with commas."
+	secretOverwriteFlagDescription = "Optional. Update the value of the secret if it already exists."
+	secretRmEnvFlagDescription     = "Optional. Name of the environment to delete the secret from (default all environments)."
+
+	lintEnvFlagDescription = "Optional. Name of the environment to resolve ${COPILOT_ENVIRONMENT_NAME} in the manifests (default left unresolved)."
 )
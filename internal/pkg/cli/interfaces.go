@@ -6,13 +6,19 @@ package cli
 import (
 	"encoding"
 	"io"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/docker"
@@ -214,6 +220,7 @@ type wsFileDeleter interface {
 
 type svcManifestReader interface {
 	ReadServiceManifest(svcName string) ([]byte, error)
+	ReadServiceDefaults() ([]byte, error)
 }
 
 type svcManifestWriter interface {
@@ -260,6 +267,16 @@ type wsAddonManager interface {
 
 type artifactUploader interface {
 	PutArtifact(bucket, fileName string, data io.Reader) (string, error)
+	Upload(bucket, key string, data io.Reader) (string, error)
+}
+
+type artifactDownloader interface {
+	Download(bucket, key string) ([]byte, error)
+}
+
+type artifactStore interface {
+	artifactUploader
+	artifactDownloader
 }
 
 type bucketEmptier interface {
@@ -313,6 +330,19 @@ type taskDeployer interface {
 	DeployTask(input *deploy.CreateTaskResourcesInput, opts ...cloudformation.StackOption) error
 }
 
+type svcRevisionLister interface {
+	ServiceRevisions(app, env, svc string) ([]deploy.ServiceRevision, error)
+}
+
+type svcRollbacker interface {
+	svcRevisionLister
+	DeployService(conf deploycfn.StackConfiguration, opts ...cloudformation.StackOption) error
+}
+
+type taskDefinitionGetter interface {
+	TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error)
+}
+
 type taskRunner interface {
 	Run() ([]*task.Task, error)
 }
@@ -340,6 +370,90 @@ type statusDescriber interface {
 	Describe() (*describe.ServiceStatusDesc, error)
 }
 
+type stoppedTasksGetter interface {
+	StoppedTasks(since time.Time) ([]ecs.TaskStatus, error)
+}
+
+type mountTargetsGetter interface {
+	MountTargetSecurityGroups(fileSystemID string) (map[string][]string, error)
+}
+
+type securityGroupIngressManager interface {
+	SecurityGroups(filters ...ec2.Filter) ([]string, error)
+	AllowsIngress(groupIDs []string, sourceGroupID string, port int64) (bool, error)
+	AllowIngress(groupID, sourceGroupID string, port int64) error
+}
+
+type internalLoadBalancerEnabler interface {
+	InternalLoadBalancerEnabled(appName, envName string) (bool, error)
+	EnvironmentVPC(appName, envName string) (*deploy.ImportVPCConfig, error)
+	EnableInternalLoadBalancer(env deploycfn.StackConfiguration, roleARN string) error
+}
+
+type vpcDescriber interface {
+	DescribeVPC(vpcID string) (*ec2.VPC, error)
+	SubnetCIDRs(subnetIDs []string) ([]string, error)
+}
+
+type runningTasksGetter interface {
+	RunningTasks() ([]*ecs.Task, error)
+}
+
+type runningTaskSelector interface {
+	RunningTask(prompt, help string, tasks []*ecs.Task, taskID string) (*ecs.Task, error)
+	Container(prompt, help string, task *ecs.Task) (string, error)
+}
+
+type commandExecutor interface {
+	ExecuteCommand(in ecs.ExecuteCommandInput) (*ecs.Session, error)
+}
+
+type taskFamilyLister interface {
+	RunningTasksInFamily(clusterName, family string) ([]*ecs.Task, error)
+	DefaultCluster() (string, error)
+}
+
+type resourcesByTagsGetter interface {
+	GetResourcesByTags(resourceType string, tags map[string]string) ([]*resourcegroups.Resource, error)
+}
+
+type ssmSessionStarter interface {
+	ValidateBinary() error
+	StartSession(ssmSess *ecs.Session) error
+}
+
+type localContainerRunner interface {
+	Build(in *docker.BuildArguments) error
+	CreateNetwork(name string) error
+	RunContainer(in *docker.RunArguments) error
+	FollowLogs(name string) error
+	RemoveContainers(names ...string) error
+}
+
+type addonsOutputsGetter interface {
+	AddonsOutputs() (map[string]string, error)
+}
+
+type parameterValueGetter interface {
+	ParameterValue(name string) (string, error)
+}
+
+type secretValueGetter interface {
+	SecretValue(secretID string) (string, error)
+}
+
+type secretPutter interface {
+	PutSecret(in ssm.PutSecretInput) error
+}
+
+type parameterNamesLister interface {
+	ParameterNames(path string) ([]string, error)
+}
+
+type parameterDeleter interface {
+	DeleteParameter(name string) error
+}
+
 type envDescriber interface {
 	Describe() (*describe.EnvDescription, error)
 }
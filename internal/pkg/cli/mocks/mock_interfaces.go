@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: ./internal/pkg/cli/interfaces.go
+// Source: internal/pkg/cli/interfaces.go
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -10,9 +10,13 @@ import (
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	cloudwatchlogs "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	resourcegroups "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	ssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
 	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
+	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	stack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	describe "github.com/aws/copilot-cli/internal/pkg/describe"
 	docker "github.com/aws/copilot-cli/internal/pkg/docker"
@@ -25,6 +29,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	io "io"
 	reflect "reflect"
+	time "time"
 )
 
 // MockactionCommand is a mock of actionCommand interface
@@ -1982,6 +1987,21 @@ func (mr *MocksvcManifestReaderMockRecorder) ReadServiceManifest(svcName interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MocksvcManifestReader)(nil).ReadServiceManifest), svcName)
 }
 
+// ReadServiceDefaults mocks base method
+func (m *MocksvcManifestReader) ReadServiceDefaults() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadServiceDefaults")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadServiceDefaults indicates an expected call of ReadServiceDefaults
+func (mr *MocksvcManifestReaderMockRecorder) ReadServiceDefaults() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceDefaults", reflect.TypeOf((*MocksvcManifestReader)(nil).ReadServiceDefaults))
+}
+
 // MocksvcManifestWriter is a mock of svcManifestWriter interface
 type MocksvcManifestWriter struct {
 	ctrl     *gomock.Controller
@@ -2202,6 +2222,21 @@ func (mr *MockwsSvcReaderMockRecorder) ReadServiceManifest(svcName interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockwsSvcReader)(nil).ReadServiceManifest), svcName)
 }
 
+// ReadServiceDefaults mocks base method
+func (m *MockwsSvcReader) ReadServiceDefaults() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadServiceDefaults")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadServiceDefaults indicates an expected call of ReadServiceDefaults
+func (mr *MockwsSvcReaderMockRecorder) ReadServiceDefaults() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceDefaults", reflect.TypeOf((*MockwsSvcReader)(nil).ReadServiceDefaults))
+}
+
 // MockwsSvcDirReader is a mock of wsSvcDirReader interface
 type MockwsSvcDirReader struct {
 	ctrl     *gomock.Controller
@@ -2255,6 +2290,21 @@ func (mr *MockwsSvcDirReaderMockRecorder) ReadServiceManifest(svcName interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockwsSvcDirReader)(nil).ReadServiceManifest), svcName)
 }
 
+// ReadServiceDefaults mocks base method
+func (m *MockwsSvcDirReader) ReadServiceDefaults() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadServiceDefaults")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadServiceDefaults indicates an expected call of ReadServiceDefaults
+func (mr *MockwsSvcDirReaderMockRecorder) ReadServiceDefaults() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceDefaults", reflect.TypeOf((*MockwsSvcDirReader)(nil).ReadServiceDefaults))
+}
+
 // CopilotDirPath mocks base method
 func (m *MockwsSvcDirReader) CopilotDirPath() (string, error) {
 	m.ctrl.T.Helper()
@@ -2443,6 +2493,21 @@ func (mr *MockwsAddonManagerMockRecorder) ReadServiceManifest(svcName interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockwsAddonManager)(nil).ReadServiceManifest), svcName)
 }
 
+// ReadServiceDefaults mocks base method
+func (m *MockwsAddonManager) ReadServiceDefaults() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadServiceDefaults")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadServiceDefaults indicates an expected call of ReadServiceDefaults
+func (mr *MockwsAddonManagerMockRecorder) ReadServiceDefaults() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceDefaults", reflect.TypeOf((*MockwsAddonManager)(nil).ReadServiceDefaults))
+}
+
 // MockartifactUploader is a mock of artifactUploader interface
 type MockartifactUploader struct {
 	ctrl     *gomock.Controller
@@ -2481,6 +2546,127 @@ func (mr *MockartifactUploaderMockRecorder) PutArtifact(bucket, fileName, data i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutArtifact", reflect.TypeOf((*MockartifactUploader)(nil).PutArtifact), bucket, fileName, data)
 }
 
+// Upload mocks base method
+func (m *MockartifactUploader) Upload(bucket, key string, data io.Reader) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", bucket, key, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload
+func (mr *MockartifactUploaderMockRecorder) Upload(bucket, key, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockartifactUploader)(nil).Upload), bucket, key, data)
+}
+
+// MockartifactDownloader is a mock of artifactDownloader interface
+type MockartifactDownloader struct {
+	ctrl     *gomock.Controller
+	recorder *MockartifactDownloaderMockRecorder
+}
+
+// MockartifactDownloaderMockRecorder is the mock recorder for MockartifactDownloader
+type MockartifactDownloaderMockRecorder struct {
+	mock *MockartifactDownloader
+}
+
+// NewMockartifactDownloader creates a new mock instance
+func NewMockartifactDownloader(ctrl *gomock.Controller) *MockartifactDownloader {
+	mock := &MockartifactDownloader{ctrl: ctrl}
+	mock.recorder = &MockartifactDownloaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockartifactDownloader) EXPECT() *MockartifactDownloaderMockRecorder {
+	return m.recorder
+}
+
+// Download mocks base method
+func (m *MockartifactDownloader) Download(bucket, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", bucket, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Download indicates an expected call of Download
+func (mr *MockartifactDownloaderMockRecorder) Download(bucket, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockartifactDownloader)(nil).Download), bucket, key)
+}
+
+// MockartifactStore is a mock of artifactStore interface
+type MockartifactStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockartifactStoreMockRecorder
+}
+
+// MockartifactStoreMockRecorder is the mock recorder for MockartifactStore
+type MockartifactStoreMockRecorder struct {
+	mock *MockartifactStore
+}
+
+// NewMockartifactStore creates a new mock instance
+func NewMockartifactStore(ctrl *gomock.Controller) *MockartifactStore {
+	mock := &MockartifactStore{ctrl: ctrl}
+	mock.recorder = &MockartifactStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockartifactStore) EXPECT() *MockartifactStoreMockRecorder {
+	return m.recorder
+}
+
+// PutArtifact mocks base method
+func (m *MockartifactStore) PutArtifact(bucket, fileName string, data io.Reader) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutArtifact", bucket, fileName, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutArtifact indicates an expected call of PutArtifact
+func (mr *MockartifactStoreMockRecorder) PutArtifact(bucket, fileName, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutArtifact", reflect.TypeOf((*MockartifactStore)(nil).PutArtifact), bucket, fileName, data)
+}
+
+// Upload mocks base method
+func (m *MockartifactStore) Upload(bucket, key string, data io.Reader) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", bucket, key, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload
+func (mr *MockartifactStoreMockRecorder) Upload(bucket, key, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockartifactStore)(nil).Upload), bucket, key, data)
+}
+
+// Download mocks base method
+func (m *MockartifactStore) Download(bucket, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", bucket, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Download indicates an expected call of Download
+func (mr *MockartifactStoreMockRecorder) Download(bucket, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockartifactStore)(nil).Download), bucket, key)
+}
+
 // MockbucketEmptier is a mock of bucketEmptier interface
 type MockbucketEmptier struct {
 	ctrl     *gomock.Controller
@@ -3022,6 +3208,139 @@ func (mr *MocktaskDeployerMockRecorder) DeployTask(input interface{}, opts ...in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployTask", reflect.TypeOf((*MocktaskDeployer)(nil).DeployTask), varargs...)
 }
 
+// MocksvcRevisionLister is a mock of svcRevisionLister interface
+type MocksvcRevisionLister struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcRevisionListerMockRecorder
+}
+
+// MocksvcRevisionListerMockRecorder is the mock recorder for MocksvcRevisionLister
+type MocksvcRevisionListerMockRecorder struct {
+	mock *MocksvcRevisionLister
+}
+
+// NewMocksvcRevisionLister creates a new mock instance
+func NewMocksvcRevisionLister(ctrl *gomock.Controller) *MocksvcRevisionLister {
+	mock := &MocksvcRevisionLister{ctrl: ctrl}
+	mock.recorder = &MocksvcRevisionListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcRevisionLister) EXPECT() *MocksvcRevisionListerMockRecorder {
+	return m.recorder
+}
+
+// ServiceRevisions mocks base method
+func (m *MocksvcRevisionLister) ServiceRevisions(app, env, svc string) ([]deploy.ServiceRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceRevisions", app, env, svc)
+	ret0, _ := ret[0].([]deploy.ServiceRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceRevisions indicates an expected call of ServiceRevisions
+func (mr *MocksvcRevisionListerMockRecorder) ServiceRevisions(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceRevisions", reflect.TypeOf((*MocksvcRevisionLister)(nil).ServiceRevisions), app, env, svc)
+}
+
+// MocksvcRollbacker is a mock of svcRollbacker interface
+type MocksvcRollbacker struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcRollbackerMockRecorder
+}
+
+// MocksvcRollbackerMockRecorder is the mock recorder for MocksvcRollbacker
+type MocksvcRollbackerMockRecorder struct {
+	mock *MocksvcRollbacker
+}
+
+// NewMocksvcRollbacker creates a new mock instance
+func NewMocksvcRollbacker(ctrl *gomock.Controller) *MocksvcRollbacker {
+	mock := &MocksvcRollbacker{ctrl: ctrl}
+	mock.recorder = &MocksvcRollbackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcRollbacker) EXPECT() *MocksvcRollbackerMockRecorder {
+	return m.recorder
+}
+
+// ServiceRevisions mocks base method
+func (m *MocksvcRollbacker) ServiceRevisions(app, env, svc string) ([]deploy.ServiceRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceRevisions", app, env, svc)
+	ret0, _ := ret[0].([]deploy.ServiceRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceRevisions indicates an expected call of ServiceRevisions
+func (mr *MocksvcRollbackerMockRecorder) ServiceRevisions(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceRevisions", reflect.TypeOf((*MocksvcRollbacker)(nil).ServiceRevisions), app, env, svc)
+}
+
+// DeployService mocks base method
+func (m *MocksvcRollbacker) DeployService(conf cloudformation0.StackConfiguration, opts ...cloudformation.StackOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{conf}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployService", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployService indicates an expected call of DeployService
+func (mr *MocksvcRollbackerMockRecorder) DeployService(conf interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{conf}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployService", reflect.TypeOf((*MocksvcRollbacker)(nil).DeployService), varargs...)
+}
+
+// MocktaskDefinitionGetter is a mock of taskDefinitionGetter interface
+type MocktaskDefinitionGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskDefinitionGetterMockRecorder
+}
+
+// MocktaskDefinitionGetterMockRecorder is the mock recorder for MocktaskDefinitionGetter
+type MocktaskDefinitionGetterMockRecorder struct {
+	mock *MocktaskDefinitionGetter
+}
+
+// NewMocktaskDefinitionGetter creates a new mock instance
+func NewMocktaskDefinitionGetter(ctrl *gomock.Controller) *MocktaskDefinitionGetter {
+	mock := &MocktaskDefinitionGetter{ctrl: ctrl}
+	mock.recorder = &MocktaskDefinitionGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocktaskDefinitionGetter) EXPECT() *MocktaskDefinitionGetterMockRecorder {
+	return m.recorder
+}
+
+// TaskDefinition mocks base method
+func (m *MocktaskDefinitionGetter) TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskDefinition", taskDefName)
+	ret0, _ := ret[0].(*ecs.TaskDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskDefinition indicates an expected call of TaskDefinition
+func (mr *MocktaskDefinitionGetterMockRecorder) TaskDefinition(taskDefName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MocktaskDefinitionGetter)(nil).TaskDefinition), taskDefName)
+}
+
 // MocktaskRunner is a mock of taskRunner interface
 type MocktaskRunner struct {
 	ctrl     *gomock.Controller
@@ -3441,156 +3760,941 @@ func (mr *MockdockerfileParserMockRecorder) GetHealthCheck() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealthCheck", reflect.TypeOf((*MockdockerfileParser)(nil).GetHealthCheck))
 }
 
-// MockserviceArnGetter is a mock of serviceArnGetter interface
-type MockserviceArnGetter struct {
+// MockstatusDescriber is a mock of statusDescriber interface
+type MockstatusDescriber struct {
 	ctrl     *gomock.Controller
-	recorder *MockserviceArnGetterMockRecorder
+	recorder *MockstatusDescriberMockRecorder
 }
 
-// MockserviceArnGetterMockRecorder is the mock recorder for MockserviceArnGetter
-type MockserviceArnGetterMockRecorder struct {
-	mock *MockserviceArnGetter
+// MockstatusDescriberMockRecorder is the mock recorder for MockstatusDescriber
+type MockstatusDescriberMockRecorder struct {
+	mock *MockstatusDescriber
 }
 
-// NewMockserviceArnGetter creates a new mock instance
-func NewMockserviceArnGetter(ctrl *gomock.Controller) *MockserviceArnGetter {
-	mock := &MockserviceArnGetter{ctrl: ctrl}
-	mock.recorder = &MockserviceArnGetterMockRecorder{mock}
+// NewMockstatusDescriber creates a new mock instance
+func NewMockstatusDescriber(ctrl *gomock.Controller) *MockstatusDescriber {
+	mock := &MockstatusDescriber{ctrl: ctrl}
+	mock.recorder = &MockstatusDescriberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockserviceArnGetter) EXPECT() *MockserviceArnGetterMockRecorder {
+func (m *MockstatusDescriber) EXPECT() *MockstatusDescriberMockRecorder {
 	return m.recorder
 }
 
-// GetServiceArn mocks base method
-func (m *MockserviceArnGetter) GetServiceArn() (*ecs.ServiceArn, error) {
+// Describe mocks base method
+func (m *MockstatusDescriber) Describe() (*describe.ServiceStatusDesc, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetServiceArn")
-	ret0, _ := ret[0].(*ecs.ServiceArn)
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.ServiceStatusDesc)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetServiceArn indicates an expected call of GetServiceArn
-func (mr *MockserviceArnGetterMockRecorder) GetServiceArn() *gomock.Call {
+// Describe indicates an expected call of Describe
+func (mr *MockstatusDescriberMockRecorder) Describe() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceArn", reflect.TypeOf((*MockserviceArnGetter)(nil).GetServiceArn))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstatusDescriber)(nil).Describe))
 }
 
-// MockstatusDescriber is a mock of statusDescriber interface
-type MockstatusDescriber struct {
+// MockstoppedTasksGetter is a mock of stoppedTasksGetter interface
+type MockstoppedTasksGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockstatusDescriberMockRecorder
+	recorder *MockstoppedTasksGetterMockRecorder
 }
 
-// MockstatusDescriberMockRecorder is the mock recorder for MockstatusDescriber
-type MockstatusDescriberMockRecorder struct {
-	mock *MockstatusDescriber
+// MockstoppedTasksGetterMockRecorder is the mock recorder for MockstoppedTasksGetter
+type MockstoppedTasksGetterMockRecorder struct {
+	mock *MockstoppedTasksGetter
 }
 
-// NewMockstatusDescriber creates a new mock instance
-func NewMockstatusDescriber(ctrl *gomock.Controller) *MockstatusDescriber {
-	mock := &MockstatusDescriber{ctrl: ctrl}
-	mock.recorder = &MockstatusDescriberMockRecorder{mock}
+// NewMockstoppedTasksGetter creates a new mock instance
+func NewMockstoppedTasksGetter(ctrl *gomock.Controller) *MockstoppedTasksGetter {
+	mock := &MockstoppedTasksGetter{ctrl: ctrl}
+	mock.recorder = &MockstoppedTasksGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockstatusDescriber) EXPECT() *MockstatusDescriberMockRecorder {
+func (m *MockstoppedTasksGetter) EXPECT() *MockstoppedTasksGetterMockRecorder {
 	return m.recorder
 }
 
-// Describe mocks base method
-func (m *MockstatusDescriber) Describe() (*describe.ServiceStatusDesc, error) {
+// StoppedTasks mocks base method
+func (m *MockstoppedTasksGetter) StoppedTasks(since time.Time) ([]ecs.TaskStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Describe")
-	ret0, _ := ret[0].(*describe.ServiceStatusDesc)
+	ret := m.ctrl.Call(m, "StoppedTasks", since)
+	ret0, _ := ret[0].([]ecs.TaskStatus)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Describe indicates an expected call of Describe
-func (mr *MockstatusDescriberMockRecorder) Describe() *gomock.Call {
+// StoppedTasks indicates an expected call of StoppedTasks
+func (mr *MockstoppedTasksGetterMockRecorder) StoppedTasks(since interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstatusDescriber)(nil).Describe))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoppedTasks", reflect.TypeOf((*MockstoppedTasksGetter)(nil).StoppedTasks), since)
 }
 
-// MockenvDescriber is a mock of envDescriber interface
-type MockenvDescriber struct {
+// MockmountTargetsGetter is a mock of mountTargetsGetter interface
+type MockmountTargetsGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockenvDescriberMockRecorder
+	recorder *MockmountTargetsGetterMockRecorder
 }
 
-// MockenvDescriberMockRecorder is the mock recorder for MockenvDescriber
-type MockenvDescriberMockRecorder struct {
-	mock *MockenvDescriber
+// MockmountTargetsGetterMockRecorder is the mock recorder for MockmountTargetsGetter
+type MockmountTargetsGetterMockRecorder struct {
+	mock *MockmountTargetsGetter
 }
 
-// NewMockenvDescriber creates a new mock instance
-func NewMockenvDescriber(ctrl *gomock.Controller) *MockenvDescriber {
-	mock := &MockenvDescriber{ctrl: ctrl}
-	mock.recorder = &MockenvDescriberMockRecorder{mock}
+// NewMockmountTargetsGetter creates a new mock instance
+func NewMockmountTargetsGetter(ctrl *gomock.Controller) *MockmountTargetsGetter {
+	mock := &MockmountTargetsGetter{ctrl: ctrl}
+	mock.recorder = &MockmountTargetsGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockenvDescriber) EXPECT() *MockenvDescriberMockRecorder {
+func (m *MockmountTargetsGetter) EXPECT() *MockmountTargetsGetterMockRecorder {
 	return m.recorder
 }
 
-// Describe mocks base method
-func (m *MockenvDescriber) Describe() (*describe.EnvDescription, error) {
+// MountTargetSecurityGroups mocks base method
+func (m *MockmountTargetsGetter) MountTargetSecurityGroups(fileSystemID string) (map[string][]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Describe")
-	ret0, _ := ret[0].(*describe.EnvDescription)
+	ret := m.ctrl.Call(m, "MountTargetSecurityGroups", fileSystemID)
+	ret0, _ := ret[0].(map[string][]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Describe indicates an expected call of Describe
-func (mr *MockenvDescriberMockRecorder) Describe() *gomock.Call {
+// MountTargetSecurityGroups indicates an expected call of MountTargetSecurityGroups
+func (mr *MockmountTargetsGetterMockRecorder) MountTargetSecurityGroups(fileSystemID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockenvDescriber)(nil).Describe))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MountTargetSecurityGroups", reflect.TypeOf((*MockmountTargetsGetter)(nil).MountTargetSecurityGroups), fileSystemID)
 }
 
-// MockresourceGroupsClient is a mock of resourceGroupsClient interface
-type MockresourceGroupsClient struct {
+// MocksecurityGroupIngressManager is a mock of securityGroupIngressManager interface
+type MocksecurityGroupIngressManager struct {
 	ctrl     *gomock.Controller
-	recorder *MockresourceGroupsClientMockRecorder
+	recorder *MocksecurityGroupIngressManagerMockRecorder
 }
 
-// MockresourceGroupsClientMockRecorder is the mock recorder for MockresourceGroupsClient
-type MockresourceGroupsClientMockRecorder struct {
-	mock *MockresourceGroupsClient
+// MocksecurityGroupIngressManagerMockRecorder is the mock recorder for MocksecurityGroupIngressManager
+type MocksecurityGroupIngressManagerMockRecorder struct {
+	mock *MocksecurityGroupIngressManager
 }
 
-// NewMockresourceGroupsClient creates a new mock instance
-func NewMockresourceGroupsClient(ctrl *gomock.Controller) *MockresourceGroupsClient {
-	mock := &MockresourceGroupsClient{ctrl: ctrl}
-	mock.recorder = &MockresourceGroupsClientMockRecorder{mock}
+// NewMocksecurityGroupIngressManager creates a new mock instance
+func NewMocksecurityGroupIngressManager(ctrl *gomock.Controller) *MocksecurityGroupIngressManager {
+	mock := &MocksecurityGroupIngressManager{ctrl: ctrl}
+	mock.recorder = &MocksecurityGroupIngressManagerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockresourceGroupsClient) EXPECT() *MockresourceGroupsClientMockRecorder {
+func (m *MocksecurityGroupIngressManager) EXPECT() *MocksecurityGroupIngressManagerMockRecorder {
 	return m.recorder
 }
 
-// GetResourcesByTags mocks base method
-func (m *MockresourceGroupsClient) GetResourcesByTags(resourceType string, tags map[string]string) ([]string, error) {
+// SecurityGroups mocks base method
+func (m *MocksecurityGroupIngressManager) SecurityGroups(filters ...ec2.Filter) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetResourcesByTags", resourceType, tags)
+	varargs := []interface{}{}
+	for _, a := range filters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SecurityGroups", varargs...)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetResourcesByTags indicates an expected call of GetResourcesByTags
-func (mr *MockresourceGroupsClientMockRecorder) GetResourcesByTags(resourceType, tags interface{}) *gomock.Call {
+// SecurityGroups indicates an expected call of SecurityGroups
+func (mr *MocksecurityGroupIngressManagerMockRecorder) SecurityGroups(filters ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecurityGroups", reflect.TypeOf((*MocksecurityGroupIngressManager)(nil).SecurityGroups), filters...)
+}
+
+// AllowsIngress mocks base method
+func (m *MocksecurityGroupIngressManager) AllowsIngress(groupIDs []string, sourceGroupID string, port int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowsIngress", groupIDs, sourceGroupID, port)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllowsIngress indicates an expected call of AllowsIngress
+func (mr *MocksecurityGroupIngressManagerMockRecorder) AllowsIngress(groupIDs, sourceGroupID, port interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowsIngress", reflect.TypeOf((*MocksecurityGroupIngressManager)(nil).AllowsIngress), groupIDs, sourceGroupID, port)
+}
+
+// AllowIngress mocks base method
+func (m *MocksecurityGroupIngressManager) AllowIngress(groupID, sourceGroupID string, port int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowIngress", groupID, sourceGroupID, port)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AllowIngress indicates an expected call of AllowIngress
+func (mr *MocksecurityGroupIngressManagerMockRecorder) AllowIngress(groupID, sourceGroupID, port interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourcesByTags", reflect.TypeOf((*MockresourceGroupsClient)(nil).GetResourcesByTags), resourceType, tags)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowIngress", reflect.TypeOf((*MocksecurityGroupIngressManager)(nil).AllowIngress), groupID, sourceGroupID, port)
+}
+
+// MockinternalLoadBalancerEnabler is a mock of internalLoadBalancerEnabler interface
+type MockinternalLoadBalancerEnabler struct {
+	ctrl     *gomock.Controller
+	recorder *MockinternalLoadBalancerEnablerMockRecorder
+}
+
+// MockinternalLoadBalancerEnablerMockRecorder is the mock recorder for MockinternalLoadBalancerEnabler
+type MockinternalLoadBalancerEnablerMockRecorder struct {
+	mock *MockinternalLoadBalancerEnabler
+}
+
+// NewMockinternalLoadBalancerEnabler creates a new mock instance
+func NewMockinternalLoadBalancerEnabler(ctrl *gomock.Controller) *MockinternalLoadBalancerEnabler {
+	mock := &MockinternalLoadBalancerEnabler{ctrl: ctrl}
+	mock.recorder = &MockinternalLoadBalancerEnablerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockinternalLoadBalancerEnabler) EXPECT() *MockinternalLoadBalancerEnablerMockRecorder {
+	return m.recorder
+}
+
+// InternalLoadBalancerEnabled mocks base method
+func (m *MockinternalLoadBalancerEnabler) InternalLoadBalancerEnabled(appName, envName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InternalLoadBalancerEnabled", appName, envName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InternalLoadBalancerEnabled indicates an expected call of InternalLoadBalancerEnabled
+func (mr *MockinternalLoadBalancerEnablerMockRecorder) InternalLoadBalancerEnabled(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InternalLoadBalancerEnabled", reflect.TypeOf((*MockinternalLoadBalancerEnabler)(nil).InternalLoadBalancerEnabled), appName, envName)
+}
+
+// EnvironmentVPC mocks base method
+func (m *MockinternalLoadBalancerEnabler) EnvironmentVPC(appName, envName string) (*deploy.ImportVPCConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvironmentVPC", appName, envName)
+	ret0, _ := ret[0].(*deploy.ImportVPCConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnvironmentVPC indicates an expected call of EnvironmentVPC
+func (mr *MockinternalLoadBalancerEnablerMockRecorder) EnvironmentVPC(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentVPC", reflect.TypeOf((*MockinternalLoadBalancerEnabler)(nil).EnvironmentVPC), appName, envName)
+}
+
+// EnableInternalLoadBalancer mocks base method
+func (m *MockinternalLoadBalancerEnabler) EnableInternalLoadBalancer(env cloudformation0.StackConfiguration, roleARN string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableInternalLoadBalancer", env, roleARN)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableInternalLoadBalancer indicates an expected call of EnableInternalLoadBalancer
+func (mr *MockinternalLoadBalancerEnablerMockRecorder) EnableInternalLoadBalancer(env, roleARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableInternalLoadBalancer", reflect.TypeOf((*MockinternalLoadBalancerEnabler)(nil).EnableInternalLoadBalancer), env, roleARN)
+}
+
+// MockvpcDescriber is a mock of vpcDescriber interface
+type MockvpcDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockvpcDescriberMockRecorder
+}
+
+// MockvpcDescriberMockRecorder is the mock recorder for MockvpcDescriber
+type MockvpcDescriberMockRecorder struct {
+	mock *MockvpcDescriber
+}
+
+// NewMockvpcDescriber creates a new mock instance
+func NewMockvpcDescriber(ctrl *gomock.Controller) *MockvpcDescriber {
+	mock := &MockvpcDescriber{ctrl: ctrl}
+	mock.recorder = &MockvpcDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockvpcDescriber) EXPECT() *MockvpcDescriberMockRecorder {
+	return m.recorder
+}
+
+// DescribeVPC mocks base method
+func (m *MockvpcDescriber) DescribeVPC(vpcID string) (*ec2.VPC, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVPC", vpcID)
+	ret0, _ := ret[0].(*ec2.VPC)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVPC indicates an expected call of DescribeVPC
+func (mr *MockvpcDescriberMockRecorder) DescribeVPC(vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVPC", reflect.TypeOf((*MockvpcDescriber)(nil).DescribeVPC), vpcID)
+}
+
+// SubnetCIDRs mocks base method
+func (m *MockvpcDescriber) SubnetCIDRs(subnetIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubnetCIDRs", subnetIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubnetCIDRs indicates an expected call of SubnetCIDRs
+func (mr *MockvpcDescriberMockRecorder) SubnetCIDRs(subnetIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubnetCIDRs", reflect.TypeOf((*MockvpcDescriber)(nil).SubnetCIDRs), subnetIDs)
+}
+
+// MockrunningTasksGetter is a mock of runningTasksGetter interface
+type MockrunningTasksGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockrunningTasksGetterMockRecorder
+}
+
+// MockrunningTasksGetterMockRecorder is the mock recorder for MockrunningTasksGetter
+type MockrunningTasksGetterMockRecorder struct {
+	mock *MockrunningTasksGetter
+}
+
+// NewMockrunningTasksGetter creates a new mock instance
+func NewMockrunningTasksGetter(ctrl *gomock.Controller) *MockrunningTasksGetter {
+	mock := &MockrunningTasksGetter{ctrl: ctrl}
+	mock.recorder = &MockrunningTasksGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockrunningTasksGetter) EXPECT() *MockrunningTasksGetterMockRecorder {
+	return m.recorder
+}
+
+// RunningTasks mocks base method
+func (m *MockrunningTasksGetter) RunningTasks() ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunningTasks")
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunningTasks indicates an expected call of RunningTasks
+func (mr *MockrunningTasksGetterMockRecorder) RunningTasks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTasks", reflect.TypeOf((*MockrunningTasksGetter)(nil).RunningTasks))
+}
+
+// MockrunningTaskSelector is a mock of runningTaskSelector interface
+type MockrunningTaskSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockrunningTaskSelectorMockRecorder
+}
+
+// MockrunningTaskSelectorMockRecorder is the mock recorder for MockrunningTaskSelector
+type MockrunningTaskSelectorMockRecorder struct {
+	mock *MockrunningTaskSelector
+}
+
+// NewMockrunningTaskSelector creates a new mock instance
+func NewMockrunningTaskSelector(ctrl *gomock.Controller) *MockrunningTaskSelector {
+	mock := &MockrunningTaskSelector{ctrl: ctrl}
+	mock.recorder = &MockrunningTaskSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockrunningTaskSelector) EXPECT() *MockrunningTaskSelectorMockRecorder {
+	return m.recorder
+}
+
+// RunningTask mocks base method
+func (m *MockrunningTaskSelector) RunningTask(prompt, help string, tasks []*ecs.Task, taskID string) (*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunningTask", prompt, help, tasks, taskID)
+	ret0, _ := ret[0].(*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunningTask indicates an expected call of RunningTask
+func (mr *MockrunningTaskSelectorMockRecorder) RunningTask(prompt, help, tasks, taskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTask", reflect.TypeOf((*MockrunningTaskSelector)(nil).RunningTask), prompt, help, tasks, taskID)
+}
+
+// Container mocks base method
+func (m *MockrunningTaskSelector) Container(prompt, help string, task *ecs.Task) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Container", prompt, help, task)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Container indicates an expected call of Container
+func (mr *MockrunningTaskSelectorMockRecorder) Container(prompt, help, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Container", reflect.TypeOf((*MockrunningTaskSelector)(nil).Container), prompt, help, task)
+}
+
+// MockcommandExecutor is a mock of commandExecutor interface
+type MockcommandExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockcommandExecutorMockRecorder
+}
+
+// MockcommandExecutorMockRecorder is the mock recorder for MockcommandExecutor
+type MockcommandExecutorMockRecorder struct {
+	mock *MockcommandExecutor
+}
+
+// NewMockcommandExecutor creates a new mock instance
+func NewMockcommandExecutor(ctrl *gomock.Controller) *MockcommandExecutor {
+	mock := &MockcommandExecutor{ctrl: ctrl}
+	mock.recorder = &MockcommandExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockcommandExecutor) EXPECT() *MockcommandExecutorMockRecorder {
+	return m.recorder
+}
+
+// ExecuteCommand mocks base method
+func (m *MockcommandExecutor) ExecuteCommand(in ecs.ExecuteCommandInput) (*ecs.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteCommand", in)
+	ret0, _ := ret[0].(*ecs.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteCommand indicates an expected call of ExecuteCommand
+func (mr *MockcommandExecutorMockRecorder) ExecuteCommand(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*MockcommandExecutor)(nil).ExecuteCommand), in)
+}
+
+// MocktaskFamilyLister is a mock of taskFamilyLister interface
+type MocktaskFamilyLister struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskFamilyListerMockRecorder
+}
+
+// MocktaskFamilyListerMockRecorder is the mock recorder for MocktaskFamilyLister
+type MocktaskFamilyListerMockRecorder struct {
+	mock *MocktaskFamilyLister
+}
+
+// NewMocktaskFamilyLister creates a new mock instance
+func NewMocktaskFamilyLister(ctrl *gomock.Controller) *MocktaskFamilyLister {
+	mock := &MocktaskFamilyLister{ctrl: ctrl}
+	mock.recorder = &MocktaskFamilyListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocktaskFamilyLister) EXPECT() *MocktaskFamilyListerMockRecorder {
+	return m.recorder
+}
+
+// RunningTasksInFamily mocks base method
+func (m *MocktaskFamilyLister) RunningTasksInFamily(clusterName, family string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunningTasksInFamily", clusterName, family)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunningTasksInFamily indicates an expected call of RunningTasksInFamily
+func (mr *MocktaskFamilyListerMockRecorder) RunningTasksInFamily(clusterName, family interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTasksInFamily", reflect.TypeOf((*MocktaskFamilyLister)(nil).RunningTasksInFamily), clusterName, family)
+}
+
+// DefaultCluster mocks base method
+func (m *MocktaskFamilyLister) DefaultCluster() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DefaultCluster")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DefaultCluster indicates an expected call of DefaultCluster
+func (mr *MocktaskFamilyListerMockRecorder) DefaultCluster() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultCluster", reflect.TypeOf((*MocktaskFamilyLister)(nil).DefaultCluster))
+}
+
+// MockresourcesByTagsGetter is a mock of resourcesByTagsGetter interface
+type MockresourcesByTagsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockresourcesByTagsGetterMockRecorder
+}
+
+// MockresourcesByTagsGetterMockRecorder is the mock recorder for MockresourcesByTagsGetter
+type MockresourcesByTagsGetterMockRecorder struct {
+	mock *MockresourcesByTagsGetter
+}
+
+// NewMockresourcesByTagsGetter creates a new mock instance
+func NewMockresourcesByTagsGetter(ctrl *gomock.Controller) *MockresourcesByTagsGetter {
+	mock := &MockresourcesByTagsGetter{ctrl: ctrl}
+	mock.recorder = &MockresourcesByTagsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockresourcesByTagsGetter) EXPECT() *MockresourcesByTagsGetterMockRecorder {
+	return m.recorder
+}
+
+// GetResourcesByTags mocks base method
+func (m *MockresourcesByTagsGetter) GetResourcesByTags(resourceType string, tags map[string]string) ([]*resourcegroups.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourcesByTags", resourceType, tags)
+	ret0, _ := ret[0].([]*resourcegroups.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourcesByTags indicates an expected call of GetResourcesByTags
+func (mr *MockresourcesByTagsGetterMockRecorder) GetResourcesByTags(resourceType, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourcesByTags", reflect.TypeOf((*MockresourcesByTagsGetter)(nil).GetResourcesByTags), resourceType, tags)
+}
+
+// MockssmSessionStarter is a mock of ssmSessionStarter interface
+type MockssmSessionStarter struct {
+	ctrl     *gomock.Controller
+	recorder *MockssmSessionStarterMockRecorder
+}
+
+// MockssmSessionStarterMockRecorder is the mock recorder for MockssmSessionStarter
+type MockssmSessionStarterMockRecorder struct {
+	mock *MockssmSessionStarter
+}
+
+// NewMockssmSessionStarter creates a new mock instance
+func NewMockssmSessionStarter(ctrl *gomock.Controller) *MockssmSessionStarter {
+	mock := &MockssmSessionStarter{ctrl: ctrl}
+	mock.recorder = &MockssmSessionStarterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockssmSessionStarter) EXPECT() *MockssmSessionStarterMockRecorder {
+	return m.recorder
+}
+
+// ValidateBinary mocks base method
+func (m *MockssmSessionStarter) ValidateBinary() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateBinary")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateBinary indicates an expected call of ValidateBinary
+func (mr *MockssmSessionStarterMockRecorder) ValidateBinary() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBinary", reflect.TypeOf((*MockssmSessionStarter)(nil).ValidateBinary))
+}
+
+// StartSession mocks base method
+func (m *MockssmSessionStarter) StartSession(ssmSess *ecs.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSession", ssmSess)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartSession indicates an expected call of StartSession
+func (mr *MockssmSessionStarterMockRecorder) StartSession(ssmSess interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSession", reflect.TypeOf((*MockssmSessionStarter)(nil).StartSession), ssmSess)
+}
+
+// MocklocalContainerRunner is a mock of localContainerRunner interface
+type MocklocalContainerRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MocklocalContainerRunnerMockRecorder
+}
+
+// MocklocalContainerRunnerMockRecorder is the mock recorder for MocklocalContainerRunner
+type MocklocalContainerRunnerMockRecorder struct {
+	mock *MocklocalContainerRunner
+}
+
+// NewMocklocalContainerRunner creates a new mock instance
+func NewMocklocalContainerRunner(ctrl *gomock.Controller) *MocklocalContainerRunner {
+	mock := &MocklocalContainerRunner{ctrl: ctrl}
+	mock.recorder = &MocklocalContainerRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocklocalContainerRunner) EXPECT() *MocklocalContainerRunnerMockRecorder {
+	return m.recorder
+}
+
+// Build mocks base method
+func (m *MocklocalContainerRunner) Build(in *docker.BuildArguments) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Build", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Build indicates an expected call of Build
+func (mr *MocklocalContainerRunnerMockRecorder) Build(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MocklocalContainerRunner)(nil).Build), in)
+}
+
+// CreateNetwork mocks base method
+func (m *MocklocalContainerRunner) CreateNetwork(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetwork", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNetwork indicates an expected call of CreateNetwork
+func (mr *MocklocalContainerRunnerMockRecorder) CreateNetwork(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetwork", reflect.TypeOf((*MocklocalContainerRunner)(nil).CreateNetwork), name)
+}
+
+// RunContainer mocks base method
+func (m *MocklocalContainerRunner) RunContainer(in *docker.RunArguments) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunContainer", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunContainer indicates an expected call of RunContainer
+func (mr *MocklocalContainerRunnerMockRecorder) RunContainer(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunContainer", reflect.TypeOf((*MocklocalContainerRunner)(nil).RunContainer), in)
+}
+
+// FollowLogs mocks base method
+func (m *MocklocalContainerRunner) FollowLogs(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowLogs", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FollowLogs indicates an expected call of FollowLogs
+func (mr *MocklocalContainerRunnerMockRecorder) FollowLogs(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowLogs", reflect.TypeOf((*MocklocalContainerRunner)(nil).FollowLogs), name)
+}
+
+// RemoveContainers mocks base method
+func (m *MocklocalContainerRunner) RemoveContainers(names ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range names {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveContainers", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveContainers indicates an expected call of RemoveContainers
+func (mr *MocklocalContainerRunnerMockRecorder) RemoveContainers(names ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveContainers", reflect.TypeOf((*MocklocalContainerRunner)(nil).RemoveContainers), names...)
+}
+
+// MockaddonsOutputsGetter is a mock of addonsOutputsGetter interface
+type MockaddonsOutputsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockaddonsOutputsGetterMockRecorder
+}
+
+// MockaddonsOutputsGetterMockRecorder is the mock recorder for MockaddonsOutputsGetter
+type MockaddonsOutputsGetterMockRecorder struct {
+	mock *MockaddonsOutputsGetter
+}
+
+// NewMockaddonsOutputsGetter creates a new mock instance
+func NewMockaddonsOutputsGetter(ctrl *gomock.Controller) *MockaddonsOutputsGetter {
+	mock := &MockaddonsOutputsGetter{ctrl: ctrl}
+	mock.recorder = &MockaddonsOutputsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockaddonsOutputsGetter) EXPECT() *MockaddonsOutputsGetterMockRecorder {
+	return m.recorder
+}
+
+// AddonsOutputs mocks base method
+func (m *MockaddonsOutputsGetter) AddonsOutputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddonsOutputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddonsOutputs indicates an expected call of AddonsOutputs
+func (mr *MockaddonsOutputsGetterMockRecorder) AddonsOutputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonsOutputs", reflect.TypeOf((*MockaddonsOutputsGetter)(nil).AddonsOutputs))
+}
+
+// MockparameterValueGetter is a mock of parameterValueGetter interface
+type MockparameterValueGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockparameterValueGetterMockRecorder
+}
+
+// MockparameterValueGetterMockRecorder is the mock recorder for MockparameterValueGetter
+type MockparameterValueGetterMockRecorder struct {
+	mock *MockparameterValueGetter
+}
+
+// NewMockparameterValueGetter creates a new mock instance
+func NewMockparameterValueGetter(ctrl *gomock.Controller) *MockparameterValueGetter {
+	mock := &MockparameterValueGetter{ctrl: ctrl}
+	mock.recorder = &MockparameterValueGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockparameterValueGetter) EXPECT() *MockparameterValueGetterMockRecorder {
+	return m.recorder
+}
+
+// ParameterValue mocks base method
+func (m *MockparameterValueGetter) ParameterValue(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParameterValue", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParameterValue indicates an expected call of ParameterValue
+func (mr *MockparameterValueGetterMockRecorder) ParameterValue(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParameterValue", reflect.TypeOf((*MockparameterValueGetter)(nil).ParameterValue), name)
+}
+
+// MocksecretValueGetter is a mock of secretValueGetter interface
+type MocksecretValueGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretValueGetterMockRecorder
+}
+
+// MocksecretValueGetterMockRecorder is the mock recorder for MocksecretValueGetter
+type MocksecretValueGetterMockRecorder struct {
+	mock *MocksecretValueGetter
+}
+
+// NewMocksecretValueGetter creates a new mock instance
+func NewMocksecretValueGetter(ctrl *gomock.Controller) *MocksecretValueGetter {
+	mock := &MocksecretValueGetter{ctrl: ctrl}
+	mock.recorder = &MocksecretValueGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksecretValueGetter) EXPECT() *MocksecretValueGetterMockRecorder {
+	return m.recorder
+}
+
+// SecretValue mocks base method
+func (m *MocksecretValueGetter) SecretValue(secretID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretValue", secretID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretValue indicates an expected call of SecretValue
+func (mr *MocksecretValueGetterMockRecorder) SecretValue(secretID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretValue", reflect.TypeOf((*MocksecretValueGetter)(nil).SecretValue), secretID)
+}
+
+// MocksecretPutter is a mock of secretPutter interface
+type MocksecretPutter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretPutterMockRecorder
+}
+
+// MocksecretPutterMockRecorder is the mock recorder for MocksecretPutter
+type MocksecretPutterMockRecorder struct {
+	mock *MocksecretPutter
+}
+
+// NewMocksecretPutter creates a new mock instance
+func NewMocksecretPutter(ctrl *gomock.Controller) *MocksecretPutter {
+	mock := &MocksecretPutter{ctrl: ctrl}
+	mock.recorder = &MocksecretPutterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksecretPutter) EXPECT() *MocksecretPutterMockRecorder {
+	return m.recorder
+}
+
+// PutSecret mocks base method
+func (m *MocksecretPutter) PutSecret(in ssm.PutSecretInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutSecret", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutSecret indicates an expected call of PutSecret
+func (mr *MocksecretPutterMockRecorder) PutSecret(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MocksecretPutter)(nil).PutSecret), in)
+}
+
+// MockparameterNamesLister is a mock of parameterNamesLister interface
+type MockparameterNamesLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockparameterNamesListerMockRecorder
+}
+
+// MockparameterNamesListerMockRecorder is the mock recorder for MockparameterNamesLister
+type MockparameterNamesListerMockRecorder struct {
+	mock *MockparameterNamesLister
+}
+
+// NewMockparameterNamesLister creates a new mock instance
+func NewMockparameterNamesLister(ctrl *gomock.Controller) *MockparameterNamesLister {
+	mock := &MockparameterNamesLister{ctrl: ctrl}
+	mock.recorder = &MockparameterNamesListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockparameterNamesLister) EXPECT() *MockparameterNamesListerMockRecorder {
+	return m.recorder
+}
+
+// ParameterNames mocks base method
+func (m *MockparameterNamesLister) ParameterNames(path string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParameterNames", path)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParameterNames indicates an expected call of ParameterNames
+func (mr *MockparameterNamesListerMockRecorder) ParameterNames(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParameterNames", reflect.TypeOf((*MockparameterNamesLister)(nil).ParameterNames), path)
+}
+
+// MockparameterDeleter is a mock of parameterDeleter interface
+type MockparameterDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockparameterDeleterMockRecorder
+}
+
+// MockparameterDeleterMockRecorder is the mock recorder for MockparameterDeleter
+type MockparameterDeleterMockRecorder struct {
+	mock *MockparameterDeleter
+}
+
+// NewMockparameterDeleter creates a new mock instance
+func NewMockparameterDeleter(ctrl *gomock.Controller) *MockparameterDeleter {
+	mock := &MockparameterDeleter{ctrl: ctrl}
+	mock.recorder = &MockparameterDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockparameterDeleter) EXPECT() *MockparameterDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteParameter mocks base method
+func (m *MockparameterDeleter) DeleteParameter(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteParameter", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteParameter indicates an expected call of DeleteParameter
+func (mr *MockparameterDeleterMockRecorder) DeleteParameter(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteParameter", reflect.TypeOf((*MockparameterDeleter)(nil).DeleteParameter), name)
+}
+
+// MockenvDescriber is a mock of envDescriber interface
+type MockenvDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvDescriberMockRecorder
+}
+
+// MockenvDescriberMockRecorder is the mock recorder for MockenvDescriber
+type MockenvDescriberMockRecorder struct {
+	mock *MockenvDescriber
+}
+
+// NewMockenvDescriber creates a new mock instance
+func NewMockenvDescriber(ctrl *gomock.Controller) *MockenvDescriber {
+	mock := &MockenvDescriber{ctrl: ctrl}
+	mock.recorder = &MockenvDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockenvDescriber) EXPECT() *MockenvDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method
+func (m *MockenvDescriber) Describe() (*describe.EnvDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.EnvDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe
+func (mr *MockenvDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockenvDescriber)(nil).Describe))
 }
 
 // MockpipelineGetter is a mock of pipelineGetter interface
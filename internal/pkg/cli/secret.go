@@ -0,0 +1,45 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+)
+
+const (
+	fmtSecretParameterPath = "/copilot/%s/%s/secrets/" // Path of the secrets of an environment, such as "/copilot/phonetool/test/secrets/".
+)
+
+// secretParameterName returns the name of the SSM parameter that stores a secret in an environment.
+func secretParameterName(app, env, name string) string {
+	return fmt.Sprintf(fmtSecretParameterPath, app, env) + name
+}
+
+// BuildSecretCmd is the top level command for secrets.
+func BuildSecretCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "secret",
+		Short: `Commands for secrets.`,
+		Long: `Commands for secrets.
+Secrets are sensitive information that you need in your services, stored as SSM parameters in each environment.`,
+	}
+	// The flags bound by viper are available to all sub-commands through viper.GetString({flagName})
+	cmd.PersistentFlags().StringP(appFlag, appFlagShort, "" /* default */, appFlagDescription)
+	_ = viper.BindPFlag(appFlag, cmd.PersistentFlags().Lookup(appFlag)) // Ignore err because the flag name is not empty.
+
+	cmd.AddCommand(BuildSecretInitCmd())
+	cmd.AddCommand(BuildSecretListCmd())
+	cmd.AddCommand(BuildSecretRmCmd())
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Develop,
+	}
+	return cmd
+}
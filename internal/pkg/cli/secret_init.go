@@ -0,0 +1,206 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretInitNamePrompt      = "What would you like to name this secret?"
+	secretInitNameHelpPrompt  = "The name of the secret, such as 'GITHUB_TOKEN'. It's also the name of the environment variable that your services read it from."
+	fmtSecretInitValuePrompt  = "What is the value of secret %s in environment %s?"
+	secretInitValueHelpPrompt = "Leave the value empty to skip creating the secret in this environment."
+)
+
+var errNoSecretValues = errors.New("the secret must have a value in at least one environment")
+
+type secretInitVars struct {
+	*GlobalOpts
+	Name      string
+	Values    map[string]string // Values of the secret keyed by environment name.
+	Overwrite bool
+}
+
+type secretInitOpts struct {
+	secretInitVars
+
+	store        store
+	sessProvider sessionFromRoleProvider
+	newPutter    func(sess *session.Session) secretPutter
+}
+
+func newSecretInitOpts(vars secretInitVars) (*secretInitOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	return &secretInitOpts{
+		secretInitVars: vars,
+		store:          store,
+		sessProvider:   sessions.NewProvider(),
+		newPutter: func(sess *session.Session) secretPutter {
+			return ssm.New(sess)
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *secretInitOpts) Validate() error {
+	if o.AppName() == "" {
+		return errNoAppInWorkspace
+	}
+	if _, err := o.store.GetApplication(o.AppName()); err != nil {
+		return fmt.Errorf("get application %s: %w", o.AppName(), err)
+	}
+	if o.Name != "" {
+		if err := validateSecretName(o.Name); err != nil {
+			return err
+		}
+	}
+	for env := range o.Values {
+		if _, err := o.store.GetEnvironment(o.AppName(), env); err != nil {
+			return fmt.Errorf("get environment %s from config store: %w", env, err)
+		}
+	}
+	return nil
+}
+
+// Ask prompts for the name of the secret and its value in each environment if they're not provided.
+func (o *secretInitOpts) Ask() error {
+	if o.Name == "" {
+		name, err := o.prompt.Get(secretInitNamePrompt, secretInitNameHelpPrompt, validateSecretName)
+		if err != nil {
+			return fmt.Errorf("get secret name: %w", err)
+		}
+		o.Name = name
+	}
+	if len(o.Values) != 0 {
+		return nil
+	}
+	envs, err := o.store.ListEnvironments(o.AppName())
+	if err != nil {
+		return fmt.Errorf("list environments in application %s: %w", o.AppName(), err)
+	}
+	values := make(map[string]string)
+	for _, env := range envs {
+		value, err := o.prompt.GetSecret(fmt.Sprintf(fmtSecretInitValuePrompt, color.HighlightUserInput(o.Name), env.Name), secretInitValueHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("get value of secret %s in environment %s: %w", o.Name, env.Name, err)
+		}
+		if value != "" {
+			values[env.Name] = value
+		}
+	}
+	if len(values) == 0 {
+		return errNoSecretValues
+	}
+	o.Values = values
+	return nil
+}
+
+// Execute stores the secret as a SecureString parameter in each environment, tagged with the application and environment.
+func (o *secretInitOpts) Execute() error {
+	var envNames []string
+	for env := range o.Values {
+		envNames = append(envNames, env)
+	}
+	sort.Strings(envNames)
+
+	var created []string
+	for _, envName := range envNames {
+		env, err := o.store.GetEnvironment(o.AppName(), envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s from config store: %w", envName, err)
+		}
+		sess, err := o.sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("create session for environment %s: %w", envName, err)
+		}
+		err = o.newPutter(sess).PutSecret(ssm.PutSecretInput{
+			Name:      secretParameterName(o.AppName(), envName, o.Name),
+			Value:     o.Values[envName],
+			Overwrite: o.Overwrite,
+			Tags: map[string]string{
+				deploy.AppTagKey: o.AppName(),
+				deploy.EnvTagKey: envName,
+			},
+		})
+		var existsErr *ssm.ErrParameterAlreadyExists
+		if errors.As(err, &existsErr) {
+			log.Warningf("Secret %s already exists in environment %s, run with %s to update its value.\n",
+				o.Name, envName, color.HighlightCode("--"+overwriteFlag))
+			created = append(created, envName)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("put secret %s in environment %s: %w", o.Name, envName, err)
+		}
+		log.Successf("Put secret %s in environment %s.\n", color.HighlightUserInput(o.Name), color.HighlightUserInput(envName))
+		created = append(created, envName)
+	}
+	log.Infof("\nYou can refer to the secret in your manifest with:\n%s", o.manifestSnippet(created))
+	return nil
+}
+
+// manifestSnippet returns the manifest configuration that injects the secret into a service in each environment.
+func (o *secretInitOpts) manifestSnippet(envs []string) string {
+	b := &strings.Builder{}
+	b.WriteString("environments:\n")
+	for _, env := range envs {
+		fmt.Fprintf(b, "  %s:\n    secrets:\n      %s: %s\n", env, o.Name, secretParameterName(o.AppName(), env, o.Name))
+	}
+	return b.String()
+}
+
+// BuildSecretInitCmd builds the command for creating a secret in the environments of an application.
+func BuildSecretInitCmd() *cobra.Command {
+	vars := secretInitVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Creates or updates a secret in the environments of your application.",
+		Long: `Creates or updates a secret in the environments of your application.
+The secret is stored as a SecureString SSM parameter that the services in the environment can read.`,
+		Example: `
+  Create a secret, prompting for its value in each environment.
+  /code $ copilot secret init --name GITHUB_TOKEN
+
+  Create a secret in the "test" and "prod" environments.
+  /code $ copilot secret init --name GITHUB_TOKEN --values test=ghp_test,prod=ghp_prod
+
+  Update the value of an existing secret.
+  /code $ copilot secret init --name GITHUB_TOKEN --values test=ghp_test --overwrite`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSecretInitOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.Name, nameFlag, nameFlagShort, "", secretNameFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.Values, valuesFlag, nil, secretValuesFlagDescription)
+	cmd.Flags().BoolVar(&vars.Overwrite, overwriteFlag, false, secretOverwriteFlagDescription)
+	return cmd
+}
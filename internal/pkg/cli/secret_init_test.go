@@ -0,0 +1,268 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretInitOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName string
+		inName    string
+		inValues  map[string]string
+
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedErr error
+	}{
+		"error if no app in workspace": {
+			setupMocks: func(m *mocks.Mockstore) {},
+			wantedErr:  errNoAppInWorkspace,
+		},
+		"error if the app does not exist": {
+			inAppName: "phonetool",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get application phonetool: some error"),
+		},
+		"error if the secret name is invalid": {
+			inAppName: "phonetool",
+			inName:    "db/password",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+			},
+			wantedErr: errSecretNameBadFormat,
+		},
+		"error if an environment does not exist": {
+			inAppName: "phonetool",
+			inName:    "GITHUB_TOKEN",
+			inValues:  map[string]string{"test": "ghp_test"},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get environment test from config store: some error"),
+		},
+		"valid": {
+			inAppName: "phonetool",
+			inName:    "GITHUB_TOKEN",
+			inValues:  map[string]string{"test": "ghp_test"},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+
+			opts := &secretInitOpts{
+				secretInitVars: secretInitVars{
+					GlobalOpts: &GlobalOpts{appName: tc.inAppName},
+					Name:       tc.inName,
+					Values:     tc.inValues,
+				},
+				store: mockStore,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSecretInitOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName   string
+		inValues map[string]string
+
+		setupMocks func(mockStore *mocks.Mockstore, mockPrompt *mocks.Mockprompter)
+
+		wantedName   string
+		wantedValues map[string]string
+		wantedErr    error
+	}{
+		"does not prompt if the name and values are provided": {
+			inName:       "GITHUB_TOKEN",
+			inValues:     map[string]string{"test": "ghp_test"},
+			setupMocks:   func(mockStore *mocks.Mockstore, mockPrompt *mocks.Mockprompter) {},
+			wantedName:   "GITHUB_TOKEN",
+			wantedValues: map[string]string{"test": "ghp_test"},
+		},
+		"error if fail to prompt for the name": {
+			setupMocks: func(mockStore *mocks.Mockstore, mockPrompt *mocks.Mockprompter) {
+				mockPrompt.EXPECT().Get(secretInitNamePrompt, secretInitNameHelpPrompt, gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("get secret name: some error"),
+		},
+		"prompts for the value in each environment and skips empty values": {
+			setupMocks: func(mockStore *mocks.Mockstore, mockPrompt *mocks.Mockprompter) {
+				mockPrompt.EXPECT().Get(secretInitNamePrompt, secretInitNameHelpPrompt, gomock.Any()).Return("GITHUB_TOKEN", nil)
+				mockStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "test"}, {Name: "prod"},
+				}, nil)
+				mockPrompt.EXPECT().GetSecret(gomock.Any(), secretInitValueHelpPrompt).Return("ghp_test", nil)
+				mockPrompt.EXPECT().GetSecret(gomock.Any(), secretInitValueHelpPrompt).Return("", nil)
+			},
+			wantedName:   "GITHUB_TOKEN",
+			wantedValues: map[string]string{"test": "ghp_test"},
+		},
+		"error if no value is provided in any environment": {
+			inName: "GITHUB_TOKEN",
+			setupMocks: func(mockStore *mocks.Mockstore, mockPrompt *mocks.Mockprompter) {
+				mockStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "test"},
+				}, nil)
+				mockPrompt.EXPECT().GetSecret(gomock.Any(), secretInitValueHelpPrompt).Return("", nil)
+			},
+			wantedErr: errNoSecretValues,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(mockStore, mockPrompt)
+
+			opts := &secretInitOpts{
+				secretInitVars: secretInitVars{
+					GlobalOpts: &GlobalOpts{
+						appName: "phonetool",
+						prompt:  mockPrompt,
+					},
+					Name:   tc.inName,
+					Values: tc.inValues,
+				},
+				store: mockStore,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.Name)
+			require.Equal(t, tc.wantedValues, opts.Values)
+		})
+	}
+}
+
+func TestSecretInitOpts_Execute(t *testing.T) {
+	testEnv := &config.Environment{
+		Name:           "test",
+		Region:         "us-west-2",
+		ManagerRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole",
+	}
+	testInput := ssm.PutSecretInput{
+		Name:  "/copilot/phonetool/test/secrets/GITHUB_TOKEN",
+		Value: "ghp_test",
+		Tags: map[string]string{
+			deploy.AppTagKey: "phonetool",
+			deploy.EnvTagKey: "test",
+		},
+	}
+	testCases := map[string]struct {
+		inOverwrite bool
+
+		setupMocks func(mockStore *mocks.Mockstore, mockSess *mocks.MocksessionFromRoleProvider, mockPutter *mocks.MocksecretPutter)
+
+		wantedErr error
+	}{
+		"error if fail to create the environment session": {
+			setupMocks: func(mockStore *mocks.Mockstore, mockSess *mocks.MocksessionFromRoleProvider, mockPutter *mocks.MocksecretPutter) {
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+				mockSess.EXPECT().FromRole(testEnv.ManagerRoleARN, testEnv.Region).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("create session for environment test: some error"),
+		},
+		"error if fail to put the secret": {
+			setupMocks: func(mockStore *mocks.Mockstore, mockSess *mocks.MocksessionFromRoleProvider, mockPutter *mocks.MocksecretPutter) {
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+				mockSess.EXPECT().FromRole(testEnv.ManagerRoleARN, testEnv.Region).Return(&session.Session{}, nil)
+				mockPutter.EXPECT().PutSecret(testInput).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("put secret GITHUB_TOKEN in environment test: some error"),
+		},
+		"does not error if the secret already exists": {
+			setupMocks: func(mockStore *mocks.Mockstore, mockSess *mocks.MocksessionFromRoleProvider, mockPutter *mocks.MocksecretPutter) {
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+				mockSess.EXPECT().FromRole(testEnv.ManagerRoleARN, testEnv.Region).Return(&session.Session{}, nil)
+				mockPutter.EXPECT().PutSecret(testInput).Return(fmt.Errorf("put parameter: %w", &ssm.ErrParameterAlreadyExists{}))
+			},
+		},
+		"overwrites the secret": {
+			inOverwrite: true,
+			setupMocks: func(mockStore *mocks.Mockstore, mockSess *mocks.MocksessionFromRoleProvider, mockPutter *mocks.MocksecretPutter) {
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+				mockSess.EXPECT().FromRole(testEnv.ManagerRoleARN, testEnv.Region).Return(&session.Session{}, nil)
+				in := testInput
+				in.Overwrite = true
+				mockPutter.EXPECT().PutSecret(in).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockSess := mocks.NewMocksessionFromRoleProvider(ctrl)
+			mockPutter := mocks.NewMocksecretPutter(ctrl)
+			tc.setupMocks(mockStore, mockSess, mockPutter)
+
+			opts := &secretInitOpts{
+				secretInitVars: secretInitVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					Name:       "GITHUB_TOKEN",
+					Values:     map[string]string{"test": "ghp_test"},
+					Overwrite:  tc.inOverwrite,
+				},
+				store:        mockStore,
+				sessProvider: mockSess,
+				newPutter: func(sess *session.Session) secretPutter {
+					return mockPutter
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/spf13/cobra"
+)
+
+type listSecretVars struct {
+	*GlobalOpts
+	ShouldOutputJSON bool
+}
+
+type listSecretOpts struct {
+	listSecretVars
+
+	store        store
+	sessProvider sessionFromRoleProvider
+	newLister    func(sess *session.Session) parameterNamesLister
+
+	w io.Writer
+}
+
+type secretSummary struct {
+	Name        string `json:"name"`
+	Environment string `json:"environment"`
+	Parameter   string `json:"parameter"`
+}
+
+func newListSecretOpts(vars listSecretVars) (*listSecretOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	return &listSecretOpts{
+		listSecretVars: vars,
+		store:          store,
+		sessProvider:   sessions.NewProvider(),
+		newLister: func(sess *session.Session) parameterNamesLister {
+			return ssm.New(sess)
+		},
+		w: os.Stdout,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *listSecretOpts) Validate() error {
+	if o.AppName() == "" {
+		return errNoAppInWorkspace
+	}
+	return nil
+}
+
+// Execute lists the secrets in each environment of the application.
+func (o *listSecretOpts) Execute() error {
+	if _, err := o.store.GetApplication(o.AppName()); err != nil {
+		return fmt.Errorf("get application %s: %w", o.AppName(), err)
+	}
+	envs, err := o.store.ListEnvironments(o.AppName())
+	if err != nil {
+		return fmt.Errorf("list environments in application %s: %w", o.AppName(), err)
+	}
+	var secrets []*secretSummary
+	for _, env := range envs {
+		sess, err := o.sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("create session for environment %s: %w", env.Name, err)
+		}
+		path := secretParameterName(o.AppName(), env.Name, "")
+		names, err := o.newLister(sess).ParameterNames(path)
+		if err != nil {
+			return fmt.Errorf("list secrets in environment %s: %w", env.Name, err)
+		}
+		for _, name := range names {
+			secrets = append(secrets, &secretSummary{
+				Name:        strings.TrimPrefix(name, path),
+				Environment: env.Name,
+				Parameter:   name,
+			})
+		}
+	}
+
+	if o.ShouldOutputJSON {
+		data, err := o.jsonOutput(secrets)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	o.humanOutput(secrets)
+	return nil
+}
+
+func (o *listSecretOpts) humanOutput(secrets []*secretSummary) {
+	writer := tabwriter.NewWriter(o.w, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, "%s\t%s\t%s\n", "Name", "Environment", "Parameter")
+	nameLengthMax := len("Name")
+	envLengthMax := len("Environment")
+	paramLengthMax := len("Parameter")
+	for _, secret := range secrets {
+		nameLengthMax = int(math.Max(float64(nameLengthMax), float64(len(secret.Name))))
+		envLengthMax = int(math.Max(float64(envLengthMax), float64(len(secret.Environment))))
+		paramLengthMax = int(math.Max(float64(paramLengthMax), float64(len(secret.Parameter))))
+	}
+	fmt.Fprintf(writer, "%s\t%s\t%s\n", strings.Repeat("-", nameLengthMax), strings.Repeat("-", envLengthMax), strings.Repeat("-", paramLengthMax))
+	for _, secret := range secrets {
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", secret.Name, secret.Environment, secret.Parameter)
+	}
+	writer.Flush()
+}
+
+func (o *listSecretOpts) jsonOutput(secrets []*secretSummary) (string, error) {
+	type out struct {
+		Secrets []*secretSummary `json:"secrets"`
+	}
+	b, err := json.Marshal(out{Secrets: secrets})
+	if err != nil {
+		return "", fmt.Errorf("marshal secrets: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// BuildSecretListCmd builds the command for listing the secrets in the environments of an application.
+func BuildSecretListCmd() *cobra.Command {
+	vars := listSecretVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists the secrets in each environment of your application.",
+		Example: `
+  Lists the secrets of the "frontend" application.
+  /code $ copilot secret ls -a frontend`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newListSecretOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().BoolVar(&vars.ShouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,110 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSecretOpts_Execute(t *testing.T) {
+	testEnvs := []*config.Environment{
+		{Name: "test", Region: "us-west-2", ManagerRoleARN: "test-role"},
+		{Name: "prod", Region: "us-east-1", ManagerRoleARN: "prod-role"},
+	}
+	testCases := map[string]struct {
+		inJSON bool
+
+		setupMocks func(mockStore *mocks.Mockstore, mockSess *mocks.MocksessionFromRoleProvider, mockLister *mocks.MockparameterNamesLister)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"error if fail to list environments": {
+			setupMocks: func(mockStore *mocks.Mockstore, mockSess *mocks.MocksessionFromRoleProvider, mockLister *mocks.MockparameterNamesLister) {
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				mockStore.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list environments in application phonetool: some error"),
+		},
+		"error if fail to list the secrets of an environment": {
+			setupMocks: func(mockStore *mocks.Mockstore, mockSess *mocks.MocksessionFromRoleProvider, mockLister *mocks.MockparameterNamesLister) {
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				mockStore.EXPECT().ListEnvironments("phonetool").Return(testEnvs, nil)
+				mockSess.EXPECT().FromRole("test-role", "us-west-2").Return(&session.Session{}, nil)
+				mockLister.EXPECT().ParameterNames("/copilot/phonetool/test/secrets/").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list secrets in environment test: some error"),
+		},
+		"outputs secrets in JSON": {
+			inJSON: true,
+			setupMocks: func(mockStore *mocks.Mockstore, mockSess *mocks.MocksessionFromRoleProvider, mockLister *mocks.MockparameterNamesLister) {
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				mockStore.EXPECT().ListEnvironments("phonetool").Return(testEnvs, nil)
+				mockSess.EXPECT().FromRole("test-role", "us-west-2").Return(&session.Session{}, nil)
+				mockLister.EXPECT().ParameterNames("/copilot/phonetool/test/secrets/").Return([]string{"/copilot/phonetool/test/secrets/GITHUB_TOKEN"}, nil)
+				mockSess.EXPECT().FromRole("prod-role", "us-east-1").Return(&session.Session{}, nil)
+				mockLister.EXPECT().ParameterNames("/copilot/phonetool/prod/secrets/").Return(nil, nil)
+			},
+			wantedContent: `{"secrets":[{"name":"GITHUB_TOKEN","environment":"test","parameter":"/copilot/phonetool/test/secrets/GITHUB_TOKEN"}]}` + "\n",
+		},
+		"outputs secrets in a table": {
+			setupMocks: func(mockStore *mocks.Mockstore, mockSess *mocks.MocksessionFromRoleProvider, mockLister *mocks.MockparameterNamesLister) {
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				mockStore.EXPECT().ListEnvironments("phonetool").Return(testEnvs, nil)
+				mockSess.EXPECT().FromRole("test-role", "us-west-2").Return(&session.Session{}, nil)
+				mockLister.EXPECT().ParameterNames("/copilot/phonetool/test/secrets/").Return([]string{"/copilot/phonetool/test/secrets/GITHUB_TOKEN"}, nil)
+				mockSess.EXPECT().FromRole("prod-role", "us-east-1").Return(&session.Session{}, nil)
+				mockLister.EXPECT().ParameterNames("/copilot/phonetool/prod/secrets/").Return([]string{"/copilot/phonetool/prod/secrets/GITHUB_TOKEN"}, nil)
+			},
+			wantedContent: `Name                Environment         Parameter
+------------        -----------         --------------------------------------------
+GITHUB_TOKEN        test                /copilot/phonetool/test/secrets/GITHUB_TOKEN
+GITHUB_TOKEN        prod                /copilot/phonetool/prod/secrets/GITHUB_TOKEN
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockSess := mocks.NewMocksessionFromRoleProvider(ctrl)
+			mockLister := mocks.NewMockparameterNamesLister(ctrl)
+			tc.setupMocks(mockStore, mockSess, mockLister)
+			b := &bytes.Buffer{}
+
+			opts := &listSecretOpts{
+				listSecretVars: listSecretVars{
+					GlobalOpts:       &GlobalOpts{appName: "phonetool"},
+					ShouldOutputJSON: tc.inJSON,
+				},
+				store:        mockStore,
+				sessProvider: mockSess,
+				newLister: func(sess *session.Session) parameterNamesLister {
+					return mockLister
+				},
+				w: b,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
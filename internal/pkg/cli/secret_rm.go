@@ -0,0 +1,181 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretRmNamePrompt               = "Which secret would you like to delete?"
+	fmtSecretRmConfirmPrompt         = "Are you sure you want to delete secret %s from application %s?"
+	fmtSecretRmFromEnvConfirmPrompt  = "Are you sure you want to delete secret %s from environment %s?"
+	secretRmConfirmHelp              = "This will delete the secret from all environments of the application."
+	fmtSecretRmFromEnvConfirmHelp    = "This will delete the secret from just the %s environment."
+	fmtSecretRmConfirmPromptDeclined = "Secret %s was not deleted."
+)
+
+type secretRmVars struct {
+	*GlobalOpts
+	Name             string
+	EnvName          string
+	SkipConfirmation bool
+}
+
+type secretRmOpts struct {
+	secretRmVars
+
+	store        store
+	sessProvider sessionFromRoleProvider
+	newDeleter   func(sess *session.Session) parameterDeleter
+}
+
+func newSecretRmOpts(vars secretRmVars) (*secretRmOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	return &secretRmOpts{
+		secretRmVars: vars,
+		store:        store,
+		sessProvider: sessions.NewProvider(),
+		newDeleter: func(sess *session.Session) parameterDeleter {
+			return ssm.New(sess)
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *secretRmOpts) Validate() error {
+	if o.AppName() == "" {
+		return errNoAppInWorkspace
+	}
+	if _, err := o.store.GetApplication(o.AppName()); err != nil {
+		return fmt.Errorf("get application %s: %w", o.AppName(), err)
+	}
+	if o.Name != "" {
+		if err := validateSecretName(o.Name); err != nil {
+			return err
+		}
+	}
+	if o.EnvName != "" {
+		if _, err := o.store.GetEnvironment(o.AppName(), o.EnvName); err != nil {
+			return fmt.Errorf("get environment %s from config store: %w", o.EnvName, err)
+		}
+	}
+	return nil
+}
+
+// Ask prompts for the name of the secret if it's not provided, and confirms the deletion.
+func (o *secretRmOpts) Ask() error {
+	if o.Name == "" {
+		name, err := o.prompt.Get(secretRmNamePrompt, secretInitNameHelpPrompt, validateSecretName)
+		if err != nil {
+			return fmt.Errorf("get secret name: %w", err)
+		}
+		o.Name = name
+	}
+	if o.SkipConfirmation {
+		return nil
+	}
+
+	deletePrompt := fmt.Sprintf(fmtSecretRmConfirmPrompt, o.Name, o.AppName())
+	deleteConfirmHelp := secretRmConfirmHelp
+	if o.EnvName != "" {
+		deletePrompt = fmt.Sprintf(fmtSecretRmFromEnvConfirmPrompt, o.Name, o.EnvName)
+		deleteConfirmHelp = fmt.Sprintf(fmtSecretRmFromEnvConfirmHelp, o.EnvName)
+	}
+	confirmed, err := o.prompt.Confirm(deletePrompt, deleteConfirmHelp)
+	if err != nil {
+		return fmt.Errorf("secret delete confirmation prompt: %w", err)
+	}
+	if !confirmed {
+		log.Infof(fmtSecretRmConfirmPromptDeclined+"\n", o.Name)
+		return errOperationCancelled
+	}
+	return nil
+}
+
+// Execute deletes the secret from the selected environments.
+func (o *secretRmOpts) Execute() error {
+	envs, err := o.targetEnvironments()
+	if err != nil {
+		return err
+	}
+	for _, env := range envs {
+		sess, err := o.sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("create session for environment %s: %w", env.Name, err)
+		}
+		err = o.newDeleter(sess).DeleteParameter(secretParameterName(o.AppName(), env.Name, o.Name))
+		var notFoundErr *ssm.ErrParameterNotFound
+		if errors.As(err, &notFoundErr) {
+			log.Infof("Secret %s doesn't exist in environment %s, nothing was deleted.\n", color.HighlightUserInput(o.Name), color.HighlightUserInput(env.Name))
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("delete secret %s from environment %s: %w", o.Name, env.Name, err)
+		}
+		log.Successf("Deleted secret %s from environment %s.\n", color.HighlightUserInput(o.Name), color.HighlightUserInput(env.Name))
+	}
+	return nil
+}
+
+func (o *secretRmOpts) targetEnvironments() ([]*config.Environment, error) {
+	if o.EnvName != "" {
+		env, err := o.store.GetEnvironment(o.AppName(), o.EnvName)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s from config store: %w", o.EnvName, err)
+		}
+		return []*config.Environment{env}, nil
+	}
+	envs, err := o.store.ListEnvironments(o.AppName())
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.AppName(), err)
+	}
+	return envs, nil
+}
+
+// BuildSecretRmCmd builds the command for deleting a secret from the environments of an application.
+func BuildSecretRmCmd() *cobra.Command {
+	vars := secretRmVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "rm",
+		Short: "Deletes a secret from the environments of your application.",
+		Example: `
+  Delete the secret "GITHUB_TOKEN" from all environments.
+  /code $ copilot secret rm --name GITHUB_TOKEN
+
+  Delete the secret "GITHUB_TOKEN" from the "test" environment without confirmation.
+  /code $ copilot secret rm --name GITHUB_TOKEN --env test --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSecretRmOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.Name, nameFlag, nameFlagShort, "", secretNameFlagDescription)
+	cmd.Flags().StringVarP(&vars.EnvName, envFlag, envFlagShort, "", secretRmEnvFlagDescription)
+	cmd.Flags().BoolVar(&vars.SkipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
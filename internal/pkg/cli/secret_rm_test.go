@@ -0,0 +1,172 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretRmOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName           string
+		inEnvName        string
+		skipConfirmation bool
+
+		setupMocks func(m *mocks.Mockprompter)
+
+		wantedName string
+		wantedErr  error
+	}{
+		"prompts for the name and skips confirmation": {
+			skipConfirmation: true,
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(secretRmNamePrompt, secretInitNameHelpPrompt, gomock.Any()).Return("GITHUB_TOKEN", nil)
+			},
+			wantedName: "GITHUB_TOKEN",
+		},
+		"confirms deletion from all environments": {
+			inName: "GITHUB_TOKEN",
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(fmt.Sprintf(fmtSecretRmConfirmPrompt, "GITHUB_TOKEN", "phonetool"), secretRmConfirmHelp).Return(true, nil)
+			},
+			wantedName: "GITHUB_TOKEN",
+		},
+		"error if the deletion from an environment is cancelled": {
+			inName:    "GITHUB_TOKEN",
+			inEnvName: "test",
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(fmt.Sprintf(fmtSecretRmFromEnvConfirmPrompt, "GITHUB_TOKEN", "test"), fmt.Sprintf(fmtSecretRmFromEnvConfirmHelp, "test")).Return(false, nil)
+			},
+			wantedErr: errOperationCancelled,
+		},
+		"error if fail to confirm": {
+			inName: "GITHUB_TOKEN",
+			setupMocks: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(false, errors.New("some error"))
+			},
+			wantedErr: errors.New("secret delete confirmation prompt: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(mockPrompt)
+
+			opts := &secretRmOpts{
+				secretRmVars: secretRmVars{
+					GlobalOpts: &GlobalOpts{
+						appName: "phonetool",
+						prompt:  mockPrompt,
+					},
+					Name:             tc.inName,
+					EnvName:          tc.inEnvName,
+					SkipConfirmation: tc.skipConfirmation,
+				},
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.Name)
+		})
+	}
+}
+
+func TestSecretRmOpts_Execute(t *testing.T) {
+	testEnv := &config.Environment{Name: "test", Region: "us-west-2", ManagerRoleARN: "test-role"}
+	prodEnv := &config.Environment{Name: "prod", Region: "us-east-1", ManagerRoleARN: "prod-role"}
+	testCases := map[string]struct {
+		inEnvName string
+
+		setupMocks func(mockStore *mocks.Mockstore, mockSess *mocks.MocksessionFromRoleProvider, mockDeleter *mocks.MockparameterDeleter)
+
+		wantedErr error
+	}{
+		"deletes the secret from a single environment": {
+			inEnvName: "test",
+			setupMocks: func(mockStore *mocks.Mockstore, mockSess *mocks.MocksessionFromRoleProvider, mockDeleter *mocks.MockparameterDeleter) {
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+				mockSess.EXPECT().FromRole("test-role", "us-west-2").Return(&session.Session{}, nil)
+				mockDeleter.EXPECT().DeleteParameter("/copilot/phonetool/test/secrets/GITHUB_TOKEN").Return(nil)
+			},
+		},
+		"deletes the secret from all environments": {
+			setupMocks: func(mockStore *mocks.Mockstore, mockSess *mocks.MocksessionFromRoleProvider, mockDeleter *mocks.MockparameterDeleter) {
+				mockStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv, prodEnv}, nil)
+				mockSess.EXPECT().FromRole("test-role", "us-west-2").Return(&session.Session{}, nil)
+				mockDeleter.EXPECT().DeleteParameter("/copilot/phonetool/test/secrets/GITHUB_TOKEN").Return(nil)
+				mockSess.EXPECT().FromRole("prod-role", "us-east-1").Return(&session.Session{}, nil)
+				mockDeleter.EXPECT().DeleteParameter("/copilot/phonetool/prod/secrets/GITHUB_TOKEN").Return(nil)
+			},
+		},
+		"does not error if the secret doesn't exist in an environment": {
+			setupMocks: func(mockStore *mocks.Mockstore, mockSess *mocks.MocksessionFromRoleProvider, mockDeleter *mocks.MockparameterDeleter) {
+				mockStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv, prodEnv}, nil)
+				mockSess.EXPECT().FromRole("test-role", "us-west-2").Return(&session.Session{}, nil)
+				mockDeleter.EXPECT().DeleteParameter("/copilot/phonetool/test/secrets/GITHUB_TOKEN").Return(&ssm.ErrParameterNotFound{})
+				mockSess.EXPECT().FromRole("prod-role", "us-east-1").Return(&session.Session{}, nil)
+				mockDeleter.EXPECT().DeleteParameter("/copilot/phonetool/prod/secrets/GITHUB_TOKEN").Return(nil)
+			},
+		},
+		"error if fail to delete the secret": {
+			inEnvName: "test",
+			setupMocks: func(mockStore *mocks.Mockstore, mockSess *mocks.MocksessionFromRoleProvider, mockDeleter *mocks.MockparameterDeleter) {
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+				mockSess.EXPECT().FromRole("test-role", "us-west-2").Return(&session.Session{}, nil)
+				mockDeleter.EXPECT().DeleteParameter("/copilot/phonetool/test/secrets/GITHUB_TOKEN").Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("delete secret GITHUB_TOKEN from environment test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockSess := mocks.NewMocksessionFromRoleProvider(ctrl)
+			mockDeleter := mocks.NewMockparameterDeleter(ctrl)
+			tc.setupMocks(mockStore, mockSess, mockDeleter)
+
+			opts := &secretRmOpts{
+				secretRmVars: secretRmVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					Name:       "GITHUB_TOKEN",
+					EnvName:    tc.inEnvName,
+				},
+				store:        mockStore,
+				sessProvider: mockSess,
+				newDeleter: func(sess *session.Session) parameterDeleter {
+					return mockDeleter
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
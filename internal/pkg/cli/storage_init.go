@@ -23,11 +23,13 @@ import (
 const (
 	dynamoDBStorageType = "DynamoDB"
 	s3StorageType       = "S3"
+	efsStorageType      = "EFS"
 )
 
 const (
 	s3BucketFriendlyText      = "S3 Bucket"
 	dynamoDBTableFriendlyText = "DynamoDB Table"
+	efsFileSystemFriendlyText = "EFS File System"
 )
 
 const (
@@ -37,6 +39,7 @@ const (
 var storageTypes = []string{
 	dynamoDBStorageType,
 	s3StorageType,
+	efsStorageType,
 }
 
 // General-purpose prompts, collected for all storage resources.
@@ -44,7 +47,8 @@ var (
 	fmtStorageInitTypePrompt = "What " + color.Emphasize("type") + " of storage would you like to associate with %s?"
 	storageInitTypeHelp      = `The type of storage you'd like to add to your service. 
 DynamoDB is a key-value and document database that delivers single-digit millisecond performance at any scale.
-S3 is a web object store built to store and retrieve any amount of data from anywhere on the Internet.`
+S3 is a web object store built to store and retrieve any amount of data from anywhere on the Internet.
+EFS is a file system that containers in your environment can mount to share and persist files.`
 
 	fmtStorageInitNamePrompt = "What would you like to " + color.Emphasize("name") + " this %s?"
 	storageInitNameHelp      = "The name of this storage resource. You can use the following characters: a-zA-Z0-9-_"
@@ -153,6 +157,8 @@ func (o *initStorageOpts) Validate() error {
 			err = dynamoTableNameValidation(o.storageName)
 		case s3StorageType:
 			err = s3BucketNameValidation(o.storageName)
+		case efsStorageType:
+			err = efsFileSystemNameValidation(o.storageName)
 		default:
 			// use dynamo since it's a superset of s3
 			err = dynamoTableNameValidation(o.storageName)
@@ -251,6 +257,9 @@ func (o *initStorageOpts) askStorageName() error {
 	case dynamoDBStorageType:
 		validator = dynamoTableNameValidation
 		friendlyText = dynamoDBTableFriendlyText
+	case efsStorageType:
+		validator = efsFileSystemNameValidation
+		friendlyText = efsFileSystemFriendlyText
 	}
 
 	name, err := o.prompt.Get(fmt.Sprintf(fmtStorageInitNamePrompt,
@@ -463,6 +472,8 @@ func (o *initStorageOpts) createAddon() error {
 		addonFriendlyText = dynamoDBTableFriendlyText
 	case s3StorageType:
 		addonFriendlyText = s3BucketFriendlyText
+	case efsStorageType:
+		addonFriendlyText = efsFileSystemFriendlyText
 	default:
 		return fmt.Errorf(fmtErrInvalidStorageType, o.storageType, prettify(storageTypes))
 	}
@@ -485,6 +496,8 @@ func (o *initStorageOpts) newAddon() (encoding.BinaryMarshaler, error) {
 		return o.newDynamoDBAddon()
 	case s3StorageType:
 		return o.newS3Addon()
+	case efsStorageType:
+		return o.newEFSAddon()
 	default:
 		return nil, fmt.Errorf("storage type %s doesn't have a CF template", o.storageType)
 	}
@@ -525,12 +538,32 @@ func (o *initStorageOpts) newS3Addon() (*addon.S3, error) {
 	return addon.NewS3(props), nil
 }
 
+func (o *initStorageOpts) newEFSAddon() (*addon.EFS, error) {
+	props := &addon.EFSProps{
+		StorageProps: &addon.StorageProps{
+			Name: o.storageName,
+		},
+	}
+	return addon.NewEFS(props), nil
+}
+
 func (o *initStorageOpts) RecommendedActions() []string {
 
 	newVar := template.ToSnakeCaseFunc(template.EnvVarNameFunc(o.storageName))
 
 	svcDeployCmd := fmt.Sprintf("copilot svc deploy --name %s", o.storageSvc)
 
+	if o.storageType == efsStorageType {
+		fsVar := template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName) + "FileSystemID")
+		apVar := template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName) + "AccessPointID")
+		return []string{
+			fmt.Sprintf("Run %s to deploy your file system to your environments.", color.HighlightCode(svcDeployCmd)),
+			fmt.Sprintf(`Mount the file system by adding a volume to %s in your manifest
+  with the IDs injected in the environment variables %s and %s, with %s set to true,
+  then run %s again.`, color.HighlightCode("storage.volumes"), color.HighlightCode(fsVar), color.HighlightCode(apVar),
+				color.HighlightCode("auth.iam"), color.HighlightCode(svcDeployCmd)),
+		}
+	}
 	return []string{
 		fmt.Sprintf("Update your service code to leverage the injected environment variable %s", color.HighlightCode(newVar)),
 		fmt.Sprintf("Run %s to deploy your storage resources to your environments.", color.HighlightCode(svcDeployCmd)),
@@ -555,7 +588,9 @@ environment variables for easy access.`,
   Create a basic DynamoDB table named "my-table" attached to the "frontend" service with a sort key specified.
   /code $ copilot storage init -n my-table -t DynamoDB -s frontend --partition-key Email:S --sort-key UserId:N --no-lsi
   Create a DynamoDB table with multiple alternate sort keys.
-  /code $ copilot storage init -n my-table -t DynamoDB -s frontend --partition-key Email:S --sort-key UserId:N --lsi Points:N --lsi Goodness:N`,
+  /code $ copilot storage init -n my-table -t DynamoDB -s frontend --partition-key Email:S --sort-key UserId:N --lsi Points:N --lsi Goodness:N
+  Create an EFS file system named "my-fs" attached to the "frontend" service.
+  /code $ copilot storage init -n my-fs -t EFS -s frontend`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newStorageInitOpts(vars)
 			if err != nil {
@@ -104,6 +104,17 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 			inStorageName: "mybadbucket???",
 			wantedErr:     errValueBadFormatWithPeriod,
 		},
+		"efs bad character": {
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ServiceNames().Return([]string{"frontend"}, nil)
+			},
+			mockStore:     func(m *mocks.Mockstore) {},
+			inAppName:     "bowie",
+			inStorageType: efsStorageType,
+			inSvcName:     "frontend",
+			inStorageName: "my-cool.fs",
+			wantedErr:     errEFSValueBadFormat,
+		},
 		"ddb bad character": {
 			mockWs: func(m *mocks.MockwsAddonManager) {
 				m.EXPECT().ServiceNames().Return([]string{"frontend"}, nil)
@@ -752,6 +763,18 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 
 			wantedErr: nil,
 		},
+		"happy calls for EFS": {
+			inAppName:     wantedAppName,
+			inStorageType: efsStorageType,
+			inSvcName:     wantedSvcName,
+			inStorageName: "my-fs",
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "my-fs").Return("/frontend/addons/my-fs.yml", nil)
+			},
+
+			wantedErr: nil,
+		},
 		"happy calls for DDB": {
 			inAppName:     wantedAppName,
 			inStorageType: dynamoDBStorageType,
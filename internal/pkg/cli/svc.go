@@ -5,9 +5,11 @@ package cli
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -31,10 +33,14 @@ Services are long-running Amazon ECS services.`,
 	cmd.AddCommand(BuildSvcListCmd())
 	cmd.AddCommand(BuildSvcPackageCmd())
 	cmd.AddCommand(BuildSvcDeployCmd())
+	cmd.AddCommand(BuildSvcRollbackCmd())
 	cmd.AddCommand(BuildSvcDeleteCmd())
 	cmd.AddCommand(BuildSvcShowCmd())
 	cmd.AddCommand(BuildSvcStatusCmd())
 	cmd.AddCommand(BuildSvcLogsCmd())
+	cmd.AddCommand(BuildSvcExecCmd())
+	cmd.AddCommand(BuildSvcRunLocalCmd())
+	cmd.AddCommand(BuildSvcLintCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
@@ -43,3 +49,16 @@ Services are long-running Amazon ECS services.`,
 	}
 	return cmd
 }
+
+// svcManifestOpts returns the options to unmarshal the manifest of a service with the defaults of the workspace
+// and with the variables that it references substituted for the application and environment.
+func svcManifestOpts(ws svcManifestReader, appName, envName string) ([]manifest.UnmarshalOption, error) {
+	defaults, err := ws.ReadServiceDefaults()
+	if err != nil {
+		return nil, fmt.Errorf("read manifest defaults: %w", err)
+	}
+	return []manifest.UnmarshalOption{
+		manifest.WithDefaults(defaults),
+		manifest.WithInterpolator(manifest.NewInterpolator(appName, envName)),
+	}, nil
+}
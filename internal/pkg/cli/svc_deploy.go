@@ -4,18 +4,25 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/efs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
@@ -29,11 +36,19 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
 const (
 	inputImageTagPrompt = "Input an image tag value:"
+
+	stoppedTasksPollInterval = 10 * time.Second // How often stopped tasks are retrieved while a service is deploying.
+
+	nfsPort                   = 2049                            // Port that EFS mount targets accept NFS traffic on.
+	cfnLogicalIDTagKey        = "aws:cloudformation:logical-id" // Tag that CloudFormation adds to the resources it creates.
+	cfnStackNameTagKey        = "aws:cloudformation:stack-name"
+	envSecurityGroupLogicalID = "EnvironmentSecurityGroup"
 )
 
 type deploySvcVars struct {
@@ -49,13 +64,19 @@ type deploySvcOpts struct {
 
 	store              store
 	ws                 wsSvcDirReader
+	fs                 *afero.Afero
 	imageBuilderPusher imageBuilderPusher
-	unmarshal          func(in []byte) (interface{}, error)
+	unmarshal          func(in []byte, opts ...manifest.UnmarshalOption) (interface{}, error)
 	s3                 artifactUploader
 	cmd                runner
 	addons             templater
 	appCFN             appResourcesGetter
 	svcCFN             cloudformation.CloudFormation
+	stoppedTasks       stoppedTasksGetter
+	mountTargets       mountTargetsGetter
+	envSecurityGroup   securityGroupIngressManager
+	envVPC             vpcDescriber
+	internalLB         internalLoadBalancerEnabler
 	sessProvider       sessionProvider
 
 	spinner progress
@@ -65,6 +86,8 @@ type deploySvcOpts struct {
 	targetApp         *config.Application
 	targetEnvironment *config.Environment
 	targetSvc         *config.Service
+	imageLocation     string
+	envFileURL        string
 }
 
 func newSvcDeployOpts(vars deploySvcVars) (*deploySvcOpts, error) {
@@ -82,6 +105,7 @@ func newSvcDeployOpts(vars deploySvcVars) (*deploySvcOpts, error) {
 
 		store:        store,
 		ws:           ws,
+		fs:           &afero.Afero{Fs: afero.NewOsFs()},
 		unmarshal:    manifest.UnmarshalService,
 		spinner:      termprogress.NewSpinner(),
 		sel:          selector.NewWorkspaceSelect(vars.prompt, store, ws),
@@ -146,15 +170,40 @@ func (o *deploySvcOpts) Execute() error {
 		return err
 	}
 
-	if err := o.pushToECRRepo(); err != nil {
+	mft, err := o.manifest()
+	if err != nil {
+		return err
+	}
+	if err := o.allowEFSIngress(mft); err != nil {
 		return err
 	}
+	location, err := envImageLocation(mft, o.EnvName)
+	if err != nil {
+		return err
+	}
+	o.imageLocation = location
+
+	// Services that deploy an existing image don't need to build and push one.
+	if o.imageLocation == "" {
+		if err := o.pushToECRRepo(); err != nil {
+			return err
+		}
+	}
 
 	// TODO: delete addons template from S3 bucket when deleting the environment.
 	addonsURL, err := o.pushAddonsTemplateToS3Bucket()
 	if err != nil {
 		return err
 	}
+	envFileURL, err := o.pushEnvFileToS3Bucket(mft)
+	if err != nil {
+		return err
+	}
+	o.envFileURL = envFileURL
+
+	if err := o.enableInternalLoadBalancer(mft); err != nil {
+		return err
+	}
 
 	if err := o.deploySvc(addonsURL); err != nil {
 		return err
@@ -266,8 +315,13 @@ func (o *deploySvcOpts) configureClients() error {
 
 	o.s3 = s3.New(defaultSessEnvRegion)
 
-	// CF client against env account profile AND target environment region
+	// EFS, EC2 and CF clients against env account profile AND target environment region
+	o.mountTargets = efs.New(envSession)
+	vpcClient := ec2.New(envSession)
+	o.envSecurityGroup = vpcClient
+	o.envVPC = vpcClient
 	o.svcCFN = cloudformation.New(envSession)
+	o.internalLB = o.svcCFN
 
 	addonsSvc, err := addon.New(o.Name)
 	if err != nil {
@@ -281,6 +335,17 @@ func (o *deploySvcOpts) configureClients() error {
 		return fmt.Errorf("create default session: %w", err)
 	}
 	o.appCFN = cloudformation.New(defaultSess)
+
+	// client to retrieve the reasons why tasks stopped while the service is deploying
+	o.stoppedTasks, err = describe.NewServiceStatus(&describe.NewServiceStatusConfig{
+		App:         o.AppName(),
+		Env:         o.targetEnvironment.Name,
+		Svc:         o.Name,
+		ConfigStore: o.store,
+	})
+	if err != nil {
+		return fmt.Errorf("create service status describer for service %s: %w", o.Name, err)
+	}
 	return nil
 }
 
@@ -307,7 +372,11 @@ func (o *deploySvcOpts) getBuildArgs() (*docker.BuildArguments, error) {
 	if err != nil {
 		return nil, fmt.Errorf("read manifest file %s: %w", o.Name, err)
 	}
-	svc, err := o.unmarshal(manifestBytes)
+	opts, err := svcManifestOpts(o.ws, o.AppName(), o.EnvName)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := o.unmarshal(manifestBytes, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal service %s manifest: %w", o.Name, err)
 	}
@@ -320,6 +389,10 @@ func (o *deploySvcOpts) getBuildArgs() (*docker.BuildArguments, error) {
 		return nil, fmt.Errorf("get copilot directory: %w", err)
 	}
 	wsRoot := filepath.Dir(copilotDir)
+	platform, err := envPlatform(svc, o.EnvName)
+	if err != nil {
+		return nil, err
+	}
 
 	args := mf.BuildArgs(wsRoot)
 	return &docker.BuildArguments{
@@ -327,6 +400,7 @@ func (o *deploySvcOpts) getBuildArgs() (*docker.BuildArguments, error) {
 		Context:    *args.Context,
 		Args:       args.Args,
 		ImageTag:   o.ImageTag,
+		Platform:   platform,
 	}, nil
 }
 
@@ -356,12 +430,42 @@ func (o *deploySvcOpts) pushAddonsTemplateToS3Bucket() (string, error) {
 	return url, nil
 }
 
+// pushEnvFileToS3Bucket uploads the environment file of the service in the target environment to S3.
+// If the service doesn't have an environment file, it returns the empty string and no errors.
+// Otherwise, it returns the URL of the S3 object storing the environment file.
+func (o *deploySvcOpts) pushEnvFileToS3Bucket(mft interface{}) (string, error) {
+	path, err := envFilePath(mft, o.targetEnvironment.Name)
+	if err != nil {
+		return "", err
+	}
+	if path == "" {
+		return "", nil
+	}
+	content, err := readEnvFile(o.ws, o.fs, path)
+	if err != nil {
+		return "", err
+	}
+	resources, err := o.appCFN.GetAppResourcesByRegion(o.targetApp, o.targetEnvironment.Region)
+	if err != nil {
+		return "", fmt.Errorf("get app resources: %w", err)
+	}
+	url, err := o.s3.PutArtifact(resources.S3Bucket, fmt.Sprintf(config.EnvFileNameFormat, o.Name), bytes.NewReader(content))
+	if err != nil {
+		return "", fmt.Errorf("put environment file %s to bucket %s: %w", path, resources.S3Bucket, err)
+	}
+	return url, nil
+}
+
 func (o *deploySvcOpts) manifest() (interface{}, error) {
 	raw, err := o.ws.ReadServiceManifest(o.Name)
 	if err != nil {
 		return nil, fmt.Errorf("read service %s manifest from workspace: %w", o.Name, err)
 	}
-	mft, err := o.unmarshal(raw)
+	opts, err := svcManifestOpts(o.ws, o.AppName(), o.EnvName)
+	if err != nil {
+		return nil, err
+	}
+	mft, err := o.unmarshal(raw, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal service %s manifest: %w", o.Name, err)
 	}
@@ -374,7 +478,7 @@ func (o *deploySvcOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, e
 		return nil, fmt.Errorf("get application %s resources from region %s: %w", o.targetApp.Name, o.targetEnvironment.Region, err)
 	}
 	repoURL, ok := resources.RepositoryURLs[o.Name]
-	if !ok {
+	if !ok && o.imageLocation == "" {
 		return nil, &errRepoNotFound{
 			svcName:      o.Name,
 			envRegion:    o.targetEnvironment.Region,
@@ -384,11 +488,162 @@ func (o *deploySvcOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, e
 	return &stack.RuntimeConfig{
 		ImageRepoURL:      repoURL,
 		ImageTag:          o.ImageTag,
+		ImageLocation:     o.imageLocation,
 		AddonsTemplateURL: addonsURL,
+		EnvFileURL:        o.envFileURL,
 		AdditionalTags:    tags.Merge(o.targetApp.Tags, o.ResourceTags),
 	}, nil
 }
 
+// envWorkload is implemented by every service and job manifest to return its configuration in an environment.
+type envWorkload interface {
+	EnvWorkload(envName string) (*manifest.WorkloadConfig, error)
+}
+
+// envWorkloadConfig returns the configuration of the service after applying the environment's overrides.
+func envWorkloadConfig(mft interface{}, envName string) (*manifest.WorkloadConfig, error) {
+	w, ok := mft.(envWorkload)
+	if !ok {
+		return nil, fmt.Errorf("unknown manifest type %T", mft)
+	}
+	conf, err := w.EnvWorkload(envName)
+	if err != nil {
+		return nil, fmt.Errorf("apply environment %s override: %w", envName, err)
+	}
+	return conf, nil
+}
+
+// envImageLocation returns the existing image that the service deploys to the environment.
+// If the image is built from a Dockerfile instead, it returns the empty string.
+func envImageLocation(mft interface{}, envName string) (string, error) {
+	conf, err := envWorkloadConfig(mft, envName)
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(conf.Image.Location), nil
+}
+
+// envTaskConfig returns the task configuration of the service after applying the environment's overrides.
+func envTaskConfig(mft interface{}, envName string) (*manifest.TaskConfig, error) {
+	conf, err := envWorkloadConfig(mft, envName)
+	if err != nil {
+		return nil, err
+	}
+	return &conf.Task, nil
+}
+
+// envPlatform returns the platform, such as "linux/arm64", that the service's image is built for in the environment.
+// If the platform isn't specified, it returns the empty string.
+func envPlatform(mft interface{}, envName string) (string, error) {
+	conf, err := envTaskConfig(mft, envName)
+	if err != nil {
+		return "", err
+	}
+	return conf.Platform.Platform(), nil
+}
+
+// envFilePath returns the path, relative to the workspace root, of the environment file of the service in the environment.
+// If the service doesn't have an environment file, it returns the empty string.
+func envFilePath(mft interface{}, envName string) (string, error) {
+	conf, err := envTaskConfig(mft, envName)
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(conf.EnvFile), nil
+}
+
+// allowEFSIngress adds an ingress rule for NFS traffic from the environment's security group to the mount targets
+// of the EFS file systems mounted by the service that don't allow it yet.
+func (o *deploySvcOpts) allowEFSIngress(mft interface{}) error {
+	conf, err := envTaskConfig(mft, o.targetEnvironment.Name)
+	if err != nil {
+		return err
+	}
+	if conf.Storage == nil {
+		return nil
+	}
+	var fileSystemIDs []string
+	for _, vol := range conf.Storage.Volumes {
+		if vol.EFS == nil || aws.StringValue(vol.EFS.FileSystemID) == "" {
+			continue
+		}
+		fileSystemIDs = append(fileSystemIDs, aws.StringValue(vol.EFS.FileSystemID))
+	}
+	if len(fileSystemIDs) == 0 {
+		return nil
+	}
+	sort.Strings(fileSystemIDs)
+
+	envSecurityGroups, err := o.envSecurityGroup.SecurityGroups(
+		ec2.Filter{
+			Name:   fmt.Sprintf(ec2.TagFilterName, deploy.AppTagKey),
+			Values: []string{o.targetApp.Name},
+		},
+		ec2.Filter{
+			Name:   fmt.Sprintf(ec2.TagFilterName, deploy.EnvTagKey),
+			Values: []string{o.targetEnvironment.Name},
+		},
+		ec2.Filter{
+			Name:   fmt.Sprintf(ec2.TagFilterName, cfnLogicalIDTagKey),
+			Values: []string{envSecurityGroupLogicalID},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("get security group of environment %s: %w", o.targetEnvironment.Name, err)
+	}
+	if len(envSecurityGroups) == 0 {
+		return fmt.Errorf("security group of environment %s not found", o.targetEnvironment.Name)
+	}
+	envSecurityGroup := envSecurityGroups[0]
+
+	for _, id := range fileSystemIDs {
+		mountTargets, err := o.mountTargets.MountTargetSecurityGroups(id)
+		if err != nil {
+			return err
+		}
+		if len(mountTargets) == 0 {
+			return fmt.Errorf("EFS file system %s doesn't have mount targets", id)
+		}
+		targetIDs := make([]string, 0, len(mountTargets))
+		for targetID := range mountTargets {
+			targetIDs = append(targetIDs, targetID)
+		}
+		sort.Strings(targetIDs)
+		for _, targetID := range targetIDs {
+			groupIDs := mountTargets[targetID]
+			if len(groupIDs) == 0 {
+				return fmt.Errorf("mount target %s of EFS file system %s doesn't have security groups", targetID, id)
+			}
+			allowed, err := o.envSecurityGroup.AllowsIngress(groupIDs, envSecurityGroup, nfsPort)
+			if err != nil {
+				return fmt.Errorf("check ingress rules of mount target %s: %w", targetID, err)
+			}
+			if allowed {
+				continue
+			}
+			if err := o.envSecurityGroup.AllowIngress(groupIDs[0], envSecurityGroup, nfsPort); err != nil {
+				return fmt.Errorf("allow NFS traffic to mount target %s of EFS file system %s: %w", targetID, id, err)
+			}
+			log.Infof("Allowed NFS traffic on TCP port %d from the environment's security group %s to security group %s of mount target %s.\n",
+				nfsPort, envSecurityGroup, groupIDs[0], targetID)
+		}
+	}
+	return nil
+}
+
+// readEnvFile returns the content of an environment file given its path relative to the workspace root.
+func readEnvFile(ws wsSvcDirReader, fs afero.Fs, path string) ([]byte, error) {
+	copilotDir, err := ws.CopilotDirPath()
+	if err != nil {
+		return nil, fmt.Errorf("get copilot directory: %w", err)
+	}
+	content, err := afero.ReadFile(fs, filepath.Join(filepath.Dir(copilotDir), path))
+	if err != nil {
+		return nil, fmt.Errorf("read environment file %s: %w", path, err)
+	}
+	return content, nil
+}
+
 func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.StackConfiguration, error) {
 	mft, err := o.manifest()
 	if err != nil {
@@ -402,12 +657,20 @@ func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 	switch t := mft.(type) {
 	case *manifest.LoadBalancedWebService:
 		if o.targetApp.RequiresDNSDelegation() {
-			conf, err = stack.NewHTTPSLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
+			conf, err = stack.NewHTTPSLoadBalancedWebService(t, o.targetEnvironment.Name, deploy.AppInformation{
+				Name:      o.targetEnvironment.App,
+				AccountID: o.targetApp.AccountID,
+				DNSName:   o.targetApp.Domain,
+			}, *rc)
 		} else {
 			conf, err = stack.NewLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
 		}
 	case *manifest.BackendService:
 		conf, err = stack.NewBackendService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
+	case *manifest.ScheduledJob:
+		conf, err = stack.NewScheduledJob(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
+	case *manifest.WorkerService:
+		conf, err = stack.NewWorkerService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
 	default:
 		return nil, fmt.Errorf("unknown manifest type %T while creating the CloudFormation stack", t)
 	}
@@ -417,6 +680,85 @@ func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 	return conf, nil
 }
 
+// enableInternalLoadBalancer creates the environment's internal load balancer if the backend service routes requests through it.
+func (o *deploySvcOpts) enableInternalLoadBalancer(mft interface{}) error {
+	svc, ok := mft.(*manifest.BackendService)
+	if !ok {
+		return nil
+	}
+	envSvc, err := svc.ApplyEnv(o.targetEnvironment.Name)
+	if err != nil {
+		return fmt.Errorf("apply environment %s override: %w", o.targetEnvironment.Name, err)
+	}
+	if envSvc.HTTP.IsEmpty() {
+		return nil
+	}
+	enabled, err := o.internalLB.InternalLoadBalancerEnabled(o.targetEnvironment.App, o.targetEnvironment.Name)
+	if err != nil {
+		return fmt.Errorf("check if environment %s has an internal load balancer: %w", o.targetEnvironment.Name, err)
+	}
+	if enabled {
+		return nil
+	}
+	env, err := o.envStackInput()
+	if err != nil {
+		return err
+	}
+	log.Infof("Environment %s will be updated to the latest template of this version of Copilot to add an internal load balancer.\n",
+		color.HighlightUserInput(o.targetEnvironment.Name))
+	o.spinner.Start(fmt.Sprintf("Setting up the internal load balancer in environment %s.", color.HighlightUserInput(o.targetEnvironment.Name)))
+	if err := o.internalLB.EnableInternalLoadBalancer(stack.NewEnvStackConfig(env), o.targetEnvironment.ExecutionRoleARN); err != nil {
+		o.spinner.Stop(log.Serrorf("Failed to set up the internal load balancer.\n"))
+		return fmt.Errorf("enable internal load balancer in environment %s: %w", o.targetEnvironment.Name, err)
+	}
+	o.spinner.Stop(log.Ssuccessf("Set up the internal load balancer in environment %s.\n", color.HighlightUserInput(o.targetEnvironment.Name)))
+	return nil
+}
+
+// envStackInput returns the configuration to render the latest template of the environment stack for its existing VPC.
+func (o *deploySvcOpts) envStackInput() (*deploy.CreateEnvironmentInput, error) {
+	app, env := o.targetEnvironment.App, o.targetEnvironment.Name
+	vpc, err := o.internalLB.EnvironmentVPC(app, env)
+	if err != nil {
+		return nil, fmt.Errorf("get VPC of environment %s: %w", env, err)
+	}
+	if len(vpc.PrivateSubnetIDs) == 0 {
+		return nil, fmt.Errorf("environment %s must have private subnets to place the internal load balancer in", env)
+	}
+	input := &deploy.CreateEnvironmentInput{
+		AppName: app,
+		Name:    env,
+	}
+	descr, err := o.envVPC.DescribeVPC(vpc.ID)
+	if err != nil {
+		return nil, err
+	}
+	// Imported VPCs aren't created by the environment stack, so CloudFormation doesn't tag them with the stack name.
+	if descr.Tags[cfnStackNameTagKey] != stack.NameForEnv(app, env) {
+		input.ImportVPCConfig = &deploy.ImportVPCConfig{
+			ID:               vpc.ID,
+			CIDR:             descr.CIDR,
+			PublicSubnetIDs:  vpc.PublicSubnetIDs,
+			PrivateSubnetIDs: vpc.PrivateSubnetIDs,
+		}
+		return input, nil
+	}
+	publicCIDRs, err := o.envVPC.SubnetCIDRs(vpc.PublicSubnetIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get CIDR blocks of the public subnets of environment %s: %w", env, err)
+	}
+	privateCIDRs, err := o.envVPC.SubnetCIDRs(vpc.PrivateSubnetIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get CIDR blocks of the private subnets of environment %s: %w", env, err)
+	}
+	input.AdjustVPCConfig = &deploy.AdjustVPCConfig{
+		CIDR:               descr.CIDR,
+		PublicSubnetCIDRs:  publicCIDRs,
+		PrivateSubnetCIDRs: privateCIDRs,
+	}
+	return input, nil
+}
+
 func (o *deploySvcOpts) deploySvc(addonsURL string) error {
 	conf, err := o.stackConfiguration(addonsURL)
 	if err != nil {
@@ -427,19 +769,69 @@ func (o *deploySvcOpts) deploySvc(addonsURL string) error {
 			fmt.Sprintf("%s:%s", color.HighlightUserInput(o.Name), color.HighlightUserInput(o.ImageTag)),
 			color.HighlightUserInput(o.targetEnvironment.Name)))
 
-	if err := o.svcCFN.DeployService(conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)); err != nil {
+	done := make(chan struct{})
+	streamed := make(chan struct{})
+	go func() {
+		o.streamStoppedTasks(done, time.Now())
+		close(streamed)
+	}()
+	err = o.svcCFN.DeployService(conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN))
+	close(done)
+	<-streamed
+	if err != nil {
 		o.spinner.Stop(log.Serrorf("Failed to deploy service.\n"))
 		return fmt.Errorf("deploy service: %w", err)
 	}
 	o.spinner.Stop("\n")
+	if err := o.saveRevision(conf); err != nil {
+		log.Warningf("Couldn't save the new revision of service %s, so it can't be rolled back to: %v\n", o.Name, err)
+	}
 	return nil
 }
 
+// saveRevision stores the template and parameters that the service was just deployed with, so that "svc rollback" can redeploy them.
+func (o *deploySvcOpts) saveRevision(conf cloudformation.StackConfiguration) error {
+	revision, err := stack.NewSvcRevision(conf)
+	if err != nil {
+		return fmt.Errorf("generate revision of service %s: %w", o.Name, err)
+	}
+	resources, err := o.appCFN.GetAppResourcesByRegion(o.targetApp, o.targetEnvironment.Region)
+	if err != nil {
+		return fmt.Errorf("get application %s resources from region %s: %w", o.targetApp.Name, o.targetEnvironment.Region, err)
+	}
+	return saveSvcRevision(o.svcCFN, o.s3, resources.S3Bucket, o.AppName(), o.targetEnvironment.Name, o.Name, revision)
+}
+
+// streamStoppedTasks displays the reasons why tasks stopped since the deployment started, until the done channel is closed.
+// Errors are ignored so that the deployment is not interrupted, for example if the ECS service doesn't exist yet.
+func (o *deploySvcOpts) streamStoppedTasks(done <-chan struct{}, since time.Time) {
+	for {
+		select {
+		case <-time.After(stoppedTasksPollInterval):
+			tasks, err := o.stoppedTasks.StoppedTasks(since)
+			if err != nil {
+				continue
+			}
+			if rows := termprogress.HumanizeStoppedTasks(tasks); len(rows) > 0 {
+				o.spinner.Events(rows)
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
 func (o *deploySvcOpts) showAppURI() error {
 	type identifier interface {
 		URI(string) (string, error)
 	}
 
+	if o.targetSvc.Type == manifest.ScheduledJobType || o.targetSvc.Type == manifest.WorkerServiceType {
+		// Jobs and worker services aren't reachable over the network, so there is no URI to show.
+		log.Successf("Deployed %s.\n", color.HighlightUserInput(o.Name))
+		return nil
+	}
+
 	var svcDescriber identifier
 	var err error
 	switch o.targetSvc.Type {
@@ -472,6 +864,10 @@ func (o *deploySvcOpts) showAppURI() error {
 	}
 	switch o.targetSvc.Type {
 	case manifest.BackendServiceType:
+		if strings.HasPrefix(uri, "http://") {
+			log.Successf("Deployed %s, you can access it from within the environment at %s.\n", color.HighlightUserInput(o.Name), color.HighlightResource(uri))
+			break
+		}
 		log.Successf("Deployed %s, its service discovery endpoint is %s.\n", color.HighlightUserInput(o.Name), color.HighlightResource(uri))
 	default:
 		log.Successf("Deployed %s, you can access it at %s.\n", color.HighlightUserInput(o.Name), color.HighlightResource(uri))
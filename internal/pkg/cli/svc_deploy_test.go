@@ -9,12 +9,16 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	addon "github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/docker"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
@@ -203,23 +207,36 @@ image:
   build:
     dockerfile: path/to/Dockerfile
     context: path
+  port: 80
 `)
 	mockMftBuildString := []byte(`name: serviceA
 type: 'Load Balanced Web Service'
 image:
   build: path/to/Dockerfile
+  port: 80
 `)
 	mockMftNoContext := []byte(`name: serviceA
 type: 'Load Balanced Web Service'
 image:
   build:
-    dockerfile: path/to/Dockerfile`)
+    dockerfile: path/to/Dockerfile
+  port: 80`)
+	mockMftPlatform := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  build: path/to/Dockerfile
+  port: 80
+platform: linux/amd64
+environments:
+  test:
+    platform: linux/arm64`)
 
 	tests := map[string]struct {
 		inputSvc      string
+		inputEnv      string
 		setupMocks    func(controller *gomock.Controller)
 		mockWs        func(m *mocks.MockwsSvcDirReader)
-		mockUnmarshal func(in []byte) (interface{}, error)
+		mockUnmarshal func(in []byte, opts ...manifest.UnmarshalOption) (interface{}, error)
 
 		wantData *docker.BuildArguments
 		wantErr  error
@@ -238,9 +255,10 @@ image:
 			inputSvc:      "serviceA",
 			wantData:      nil,
 			wantErr:       fmt.Errorf("unmarshal service %s manifest: %w", "serviceA", mockError),
-			mockUnmarshal: func(in []byte) (interface{}, error) { return nil, mockError },
+			mockUnmarshal: func(in []byte, opts ...manifest.UnmarshalOption) (interface{}, error) { return nil, mockError },
 			mockWs: func(m *mocks.MockwsSvcDirReader) {
 				m.EXPECT().ReadServiceManifest(gomock.Any()).Return([]byte("bad manifest file bytes"), nil)
+				m.EXPECT().ReadServiceDefaults().Return(nil, nil)
 			},
 		},
 		"should return error if workspace methods fail": {
@@ -249,6 +267,7 @@ image:
 			wantErr:  fmt.Errorf("get copilot directory: %w", mockError),
 			mockWs: func(m *mocks.MockwsSvcDirReader) {
 				m.EXPECT().ReadServiceManifest(gomock.Any()).Return(mockManifest, nil)
+				m.EXPECT().ReadServiceDefaults().Return(nil, nil)
 				m.EXPECT().CopilotDirPath().Return("", mockError)
 			},
 		},
@@ -262,6 +281,7 @@ image:
 			mockWs: func(m *mocks.MockwsSvcDirReader) {
 				m.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil)
 				m.EXPECT().ReadServiceManifest("serviceA").Times(1).Return(mockManifest, nil)
+				m.EXPECT().ReadServiceDefaults().Return(nil, nil)
 			},
 		},
 		"using simple buildstring (backwards compatible)": {
@@ -273,6 +293,7 @@ image:
 			wantErr: nil,
 			mockWs: func(m *mocks.MockwsSvcDirReader) {
 				m.EXPECT().ReadServiceManifest("serviceA").Times(1).Return(mockMftBuildString, nil)
+				m.EXPECT().ReadServiceDefaults().Return(nil, nil)
 				m.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil)
 			},
 		},
@@ -285,6 +306,22 @@ image:
 			wantErr: nil,
 			mockWs: func(m *mocks.MockwsSvcDirReader) {
 				m.EXPECT().ReadServiceManifest("serviceA").Times(1).Return(mockMftNoContext, nil)
+				m.EXPECT().ReadServiceDefaults().Return(nil, nil)
+				m.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil)
+			},
+		},
+		"with the platform of the environment": {
+			inputSvc: "serviceA",
+			inputEnv: "test",
+			wantData: &docker.BuildArguments{
+				Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+				Context:    filepath.Join("/ws", "root", "path", "to"),
+				Platform:   "linux/arm64",
+			},
+			wantErr: nil,
+			mockWs: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ReadServiceManifest("serviceA").Times(1).Return(mockMftPlatform, nil)
+				m.EXPECT().ReadServiceDefaults().Return(nil, nil)
 				m.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil)
 			},
 		},
@@ -303,7 +340,9 @@ image:
 			}
 			opts := deploySvcOpts{
 				deploySvcVars: deploySvcVars{
-					Name: test.inputSvc,
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					Name:       test.inputSvc,
+					EnvName:    test.inputEnv,
 				},
 				ws:        mockWorkspace,
 				unmarshal: unmarshaler,
@@ -317,6 +356,7 @@ image:
 			} else {
 				require.Equal(t, test.wantData.Dockerfile, got.Dockerfile)
 				require.Equal(t, test.wantData.Context, got.Context)
+				require.Equal(t, test.wantData.Platform, got.Platform)
 				require.Nil(t, gotErr)
 			}
 		})
@@ -455,7 +495,8 @@ func TestSvcDeployOpts_pushAddonsTemplateToS3Bucket(t *testing.T) {
 
 			opts := deploySvcOpts{
 				deploySvcVars: deploySvcVars{
-					Name: tc.inputSvc,
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					Name:       tc.inputSvc,
 				},
 				store:             mockProjectSvc,
 				appCFN:            mockProjectResourcesGetter,
@@ -475,3 +516,457 @@ func TestSvcDeployOpts_pushAddonsTemplateToS3Bucket(t *testing.T) {
 		})
 	}
 }
+
+func TestSvcDeployOpts_pushEnvFileToS3Bucket(t *testing.T) {
+	mockError := errors.New("some error")
+	testEnv := &config.Environment{
+		Name:   "test",
+		Region: "us-west-2",
+	}
+	testApp := &config.Application{
+		Name: "phonetool",
+	}
+	testCases := map[string]struct {
+		inEnvFile    *string
+		inEnvEnvFile *string
+
+		mockWs                 func(m *mocks.MockwsSvcDirReader)
+		mockAppResourcesGetter func(m *mocks.MockappResourcesGetter)
+		mockS3Svc              func(m *mocks.MockartifactUploader)
+
+		wantURL string
+		wantErr error
+	}{
+		"should return empty url if the service doesn't have an environment file": {
+			mockWs:                 func(m *mocks.MockwsSvcDirReader) {},
+			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
+			mockS3Svc:              func(m *mocks.MockartifactUploader) {},
+		},
+		"should return error if the environment file does not exist": {
+			inEnvFile: aws.String("missing.env"),
+			mockWs: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+			},
+			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
+			mockS3Svc:              func(m *mocks.MockartifactUploader) {},
+			wantErr:                fmt.Errorf("read environment file missing.env: open %s: file does not exist", filepath.FromSlash("/ws/missing.env")),
+		},
+		"should return error if fail to upload to S3 bucket": {
+			inEnvFile: aws.String("api.env"),
+			mockWs: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+			},
+			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetAppResourcesByRegion(testApp, "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockBucket",
+				}, nil)
+			},
+			mockS3Svc: func(m *mocks.MockartifactUploader) {
+				m.EXPECT().PutArtifact("mockBucket", "api.env", gomock.Any()).Return("", mockError)
+			},
+			wantErr: fmt.Errorf("put environment file api.env to bucket mockBucket: some error"),
+		},
+		"should push the environment override of the environment file to S3 bucket": {
+			inEnvFile:    aws.String("missing.env"),
+			inEnvEnvFile: aws.String("test.env"),
+			mockWs: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+			},
+			mockAppResourcesGetter: func(m *mocks.MockappResourcesGetter) {
+				m.EXPECT().GetAppResourcesByRegion(testApp, "us-west-2").Return(&stack.AppRegionalResources{
+					S3Bucket: "mockBucket",
+				}, nil)
+			},
+			mockS3Svc: func(m *mocks.MockartifactUploader) {
+				m.EXPECT().PutArtifact("mockBucket", "api.env", gomock.Any()).Return("https://mockBucket.s3.us-west-2.amazonaws.com/manual/1/api.env", nil)
+			},
+			wantURL: "https://mockBucket.s3.us-west-2.amazonaws.com/manual/1/api.env",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWs := mocks.NewMockwsSvcDirReader(ctrl)
+			mockAppResourcesGetter := mocks.NewMockappResourcesGetter(ctrl)
+			mockS3Svc := mocks.NewMockartifactUploader(ctrl)
+			tc.mockWs(mockWs)
+			tc.mockAppResourcesGetter(mockAppResourcesGetter)
+			tc.mockS3Svc(mockS3Svc)
+
+			fs := &afero.Afero{Fs: afero.NewMemMapFs()}
+			require.NoError(t, fs.WriteFile("/ws/api.env", []byte("LOG_LEVEL=info"), 0644))
+			require.NoError(t, fs.WriteFile("/ws/test.env", []byte("LOG_LEVEL=debug"), 0644))
+
+			mft := manifest.NewBackendService(manifest.BackendServiceProps{
+				ServiceProps: manifest.ServiceProps{
+					Name:       "api",
+					Dockerfile: "api/Dockerfile",
+				},
+			})
+			mft.EnvFile = tc.inEnvFile
+			if tc.inEnvEnvFile != nil {
+				mft.Environments = map[string]*manifest.BackendServiceConfig{
+					"test": {
+						TaskConfig: manifest.TaskConfig{
+							EnvFile: tc.inEnvEnvFile,
+						},
+					},
+				}
+			}
+
+			opts := deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					Name:       "api",
+				},
+				ws:                mockWs,
+				fs:                fs,
+				appCFN:            mockAppResourcesGetter,
+				s3:                mockS3Svc,
+				targetEnvironment: testEnv,
+				targetApp:         testApp,
+			}
+
+			gotURL, gotErr := opts.pushEnvFileToS3Bucket(mft)
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.wantURL, gotURL)
+		})
+	}
+}
+
+func TestSvcDeployOpts_allowEFSIngress(t *testing.T) {
+	mockError := errors.New("some error")
+	testEnv := &config.Environment{
+		Name:   "test",
+		Region: "us-west-2",
+	}
+	testApp := &config.Application{
+		Name: "phonetool",
+	}
+	envSecurityGroupFilters := []ec2.Filter{
+		{
+			Name:   "tag:copilot-application",
+			Values: []string{"phonetool"},
+		},
+		{
+			Name:   "tag:copilot-environment",
+			Values: []string{"test"},
+		},
+		{
+			Name:   "tag:aws:cloudformation:logical-id",
+			Values: []string{"EnvironmentSecurityGroup"},
+		},
+	}
+	efsVolumes := map[string]manifest.Volume{
+		"data": {
+			ContainerPath: aws.String("/data"),
+			EFS: &manifest.EFSVolumeConfiguration{
+				FileSystemID: aws.String("fs-1"),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inVolumes map[string]manifest.Volume
+
+		mockMountTargets     func(m *mocks.MockmountTargetsGetter)
+		mockEnvSecurityGroup func(m *mocks.MocksecurityGroupIngressManager)
+
+		wantErr error
+	}{
+		"should do nothing if the service doesn't mount EFS file systems": {
+			inVolumes: map[string]manifest.Volume{
+				"scratch": {
+					ContainerPath: aws.String("/scratch"),
+				},
+			},
+			mockMountTargets:     func(m *mocks.MockmountTargetsGetter) {},
+			mockEnvSecurityGroup: func(m *mocks.MocksecurityGroupIngressManager) {},
+		},
+		"should return error if the environment security group can't be found": {
+			inVolumes:        efsVolumes,
+			mockMountTargets: func(m *mocks.MockmountTargetsGetter) {},
+			mockEnvSecurityGroup: func(m *mocks.MocksecurityGroupIngressManager) {
+				m.EXPECT().SecurityGroups(envSecurityGroupFilters).Return(nil, nil)
+			},
+			wantErr: errors.New("security group of environment test not found"),
+		},
+		"should return error if the file system doesn't have mount targets": {
+			inVolumes: efsVolumes,
+			mockMountTargets: func(m *mocks.MockmountTargetsGetter) {
+				m.EXPECT().MountTargetSecurityGroups("fs-1").Return(map[string][]string{}, nil)
+			},
+			mockEnvSecurityGroup: func(m *mocks.MocksecurityGroupIngressManager) {
+				m.EXPECT().SecurityGroups(envSecurityGroupFilters).Return([]string{"sg-env"}, nil)
+			},
+			wantErr: errors.New("EFS file system fs-1 doesn't have mount targets"),
+		},
+		"should return error if the ingress rules can't be checked": {
+			inVolumes: efsVolumes,
+			mockMountTargets: func(m *mocks.MockmountTargetsGetter) {
+				m.EXPECT().MountTargetSecurityGroups("fs-1").Return(map[string][]string{"fsmt-1": {"sg-1"}}, nil)
+			},
+			mockEnvSecurityGroup: func(m *mocks.MocksecurityGroupIngressManager) {
+				m.EXPECT().SecurityGroups(envSecurityGroupFilters).Return([]string{"sg-env"}, nil)
+				m.EXPECT().AllowsIngress([]string{"sg-1"}, "sg-env", int64(2049)).Return(false, mockError)
+			},
+			wantErr: errors.New("check ingress rules of mount target fsmt-1: some error"),
+		},
+		"should return error if a mount target doesn't have security groups": {
+			inVolumes: efsVolumes,
+			mockMountTargets: func(m *mocks.MockmountTargetsGetter) {
+				m.EXPECT().MountTargetSecurityGroups("fs-1").Return(map[string][]string{"fsmt-1": {}}, nil)
+			},
+			mockEnvSecurityGroup: func(m *mocks.MocksecurityGroupIngressManager) {
+				m.EXPECT().SecurityGroups(envSecurityGroupFilters).Return([]string{"sg-env"}, nil)
+			},
+			wantErr: errors.New("mount target fsmt-1 of EFS file system fs-1 doesn't have security groups"),
+		},
+		"should return error if the ingress rule can't be added": {
+			inVolumes: efsVolumes,
+			mockMountTargets: func(m *mocks.MockmountTargetsGetter) {
+				m.EXPECT().MountTargetSecurityGroups("fs-1").Return(map[string][]string{"fsmt-1": {"sg-1", "sg-2"}}, nil)
+			},
+			mockEnvSecurityGroup: func(m *mocks.MocksecurityGroupIngressManager) {
+				m.EXPECT().SecurityGroups(envSecurityGroupFilters).Return([]string{"sg-env"}, nil)
+				m.EXPECT().AllowsIngress([]string{"sg-1", "sg-2"}, "sg-env", int64(2049)).Return(false, nil)
+				m.EXPECT().AllowIngress("sg-1", "sg-env", int64(2049)).Return(mockError)
+			},
+			wantErr: errors.New("allow NFS traffic to mount target fsmt-1 of EFS file system fs-1: some error"),
+		},
+		"should allow NFS traffic from the environment to mount targets that don't allow it yet": {
+			inVolumes: efsVolumes,
+			mockMountTargets: func(m *mocks.MockmountTargetsGetter) {
+				m.EXPECT().MountTargetSecurityGroups("fs-1").Return(map[string][]string{
+					"fsmt-1": {"sg-1"},
+					"fsmt-2": {"sg-2"},
+				}, nil)
+			},
+			mockEnvSecurityGroup: func(m *mocks.MocksecurityGroupIngressManager) {
+				m.EXPECT().SecurityGroups(envSecurityGroupFilters).Return([]string{"sg-env"}, nil)
+				m.EXPECT().AllowsIngress([]string{"sg-1"}, "sg-env", int64(2049)).Return(true, nil)
+				m.EXPECT().AllowsIngress([]string{"sg-2"}, "sg-env", int64(2049)).Return(false, nil)
+				m.EXPECT().AllowIngress("sg-2", "sg-env", int64(2049)).Return(nil)
+			},
+		},
+		"should succeed if every mount target allows NFS traffic from the environment": {
+			inVolumes: efsVolumes,
+			mockMountTargets: func(m *mocks.MockmountTargetsGetter) {
+				m.EXPECT().MountTargetSecurityGroups("fs-1").Return(map[string][]string{"fsmt-1": {"sg-1"}}, nil)
+			},
+			mockEnvSecurityGroup: func(m *mocks.MocksecurityGroupIngressManager) {
+				m.EXPECT().SecurityGroups(envSecurityGroupFilters).Return([]string{"sg-env"}, nil)
+				m.EXPECT().AllowsIngress([]string{"sg-1"}, "sg-env", int64(2049)).Return(true, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMountTargets := mocks.NewMockmountTargetsGetter(ctrl)
+			mockEnvSecurityGroup := mocks.NewMocksecurityGroupIngressManager(ctrl)
+			tc.mockMountTargets(mockMountTargets)
+			tc.mockEnvSecurityGroup(mockEnvSecurityGroup)
+
+			mft := manifest.NewBackendService(manifest.BackendServiceProps{
+				ServiceProps: manifest.ServiceProps{
+					Name:       "api",
+					Dockerfile: "api/Dockerfile",
+				},
+			})
+			mft.Storage = &manifest.Storage{
+				Volumes: tc.inVolumes,
+			}
+
+			opts := deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					Name:       "api",
+				},
+				mountTargets:      mockMountTargets,
+				envSecurityGroup:  mockEnvSecurityGroup,
+				targetEnvironment: testEnv,
+				targetApp:         testApp,
+			}
+
+			gotErr := opts.allowEFSIngress(mft)
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestSvcDeployOpts_enableInternalLoadBalancer(t *testing.T) {
+	mockError := errors.New("some error")
+	testEnv := &config.Environment{
+		App:              "phonetool",
+		Name:             "test",
+		Region:           "us-west-2",
+		ExecutionRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole",
+	}
+	envVPC := &deploy.ImportVPCConfig{
+		ID:               "vpc-1",
+		PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+		PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+	}
+	testCases := map[string]struct {
+		inHTTP bool
+
+		mockInternalLB func(m *mocks.MockinternalLoadBalancerEnabler)
+		mockEnvVPC     func(m *mocks.MockvpcDescriber)
+		mockSpinner    func(m *mocks.Mockprogress)
+
+		wantErr error
+	}{
+		"should do nothing if the service doesn't route requests through the internal load balancer": {
+			mockInternalLB: func(m *mocks.MockinternalLoadBalancerEnabler) {},
+			mockEnvVPC:     func(m *mocks.MockvpcDescriber) {},
+			mockSpinner:    func(m *mocks.Mockprogress) {},
+		},
+		"should do nothing if the environment already has an internal load balancer": {
+			inHTTP: true,
+			mockInternalLB: func(m *mocks.MockinternalLoadBalancerEnabler) {
+				m.EXPECT().InternalLoadBalancerEnabled("phonetool", "test").Return(true, nil)
+			},
+			mockEnvVPC:  func(m *mocks.MockvpcDescriber) {},
+			mockSpinner: func(m *mocks.Mockprogress) {},
+		},
+		"should return error if the imported VPC of the environment doesn't have private subnets": {
+			inHTTP: true,
+			mockInternalLB: func(m *mocks.MockinternalLoadBalancerEnabler) {
+				m.EXPECT().InternalLoadBalancerEnabled("phonetool", "test").Return(false, nil)
+				m.EXPECT().EnvironmentVPC("phonetool", "test").Return(&deploy.ImportVPCConfig{
+					ID:              "vpc-1",
+					PublicSubnetIDs: []string{"subnet-1", "subnet-2"},
+				}, nil)
+			},
+			mockEnvVPC:  func(m *mocks.MockvpcDescriber) {},
+			mockSpinner: func(m *mocks.Mockprogress) {},
+			wantErr:     errors.New("environment test must have private subnets to place the internal load balancer in"),
+		},
+		"should return error if the VPC of the environment can't be described": {
+			inHTTP: true,
+			mockInternalLB: func(m *mocks.MockinternalLoadBalancerEnabler) {
+				m.EXPECT().InternalLoadBalancerEnabled("phonetool", "test").Return(false, nil)
+				m.EXPECT().EnvironmentVPC("phonetool", "test").Return(envVPC, nil)
+			},
+			mockEnvVPC: func(m *mocks.MockvpcDescriber) {
+				m.EXPECT().DescribeVPC("vpc-1").Return(nil, mockError)
+			},
+			mockSpinner: func(m *mocks.Mockprogress) {},
+			wantErr:     mockError,
+		},
+		"should keep an imported VPC": {
+			inHTTP: true,
+			mockInternalLB: func(m *mocks.MockinternalLoadBalancerEnabler) {
+				m.EXPECT().InternalLoadBalancerEnabled("phonetool", "test").Return(false, nil)
+				m.EXPECT().EnvironmentVPC("phonetool", "test").Return(envVPC, nil)
+				m.EXPECT().EnableInternalLoadBalancer(stack.NewEnvStackConfig(&deploy.CreateEnvironmentInput{
+					AppName: "phonetool",
+					Name:    "test",
+					ImportVPCConfig: &deploy.ImportVPCConfig{
+						ID:               "vpc-1",
+						CIDR:             "10.0.0.0/16",
+						PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+						PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+					},
+				}), testEnv.ExecutionRoleARN).Return(nil)
+			},
+			mockEnvVPC: func(m *mocks.MockvpcDescriber) {
+				m.EXPECT().DescribeVPC("vpc-1").Return(&ec2.VPC{CIDR: "10.0.0.0/16"}, nil)
+			},
+			mockSpinner: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any())
+				m.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"should keep the CIDR blocks of a VPC created by the environment": {
+			inHTTP: true,
+			mockInternalLB: func(m *mocks.MockinternalLoadBalancerEnabler) {
+				m.EXPECT().InternalLoadBalancerEnabled("phonetool", "test").Return(false, nil)
+				m.EXPECT().EnvironmentVPC("phonetool", "test").Return(envVPC, nil)
+				m.EXPECT().EnableInternalLoadBalancer(stack.NewEnvStackConfig(&deploy.CreateEnvironmentInput{
+					AppName: "phonetool",
+					Name:    "test",
+					AdjustVPCConfig: &deploy.AdjustVPCConfig{
+						CIDR:               "10.1.0.0/16",
+						PublicSubnetCIDRs:  []string{"10.1.0.0/24", "10.1.1.0/24"},
+						PrivateSubnetCIDRs: []string{"10.1.2.0/24", "10.1.3.0/24"},
+					},
+				}), testEnv.ExecutionRoleARN).Return(mockError)
+			},
+			mockEnvVPC: func(m *mocks.MockvpcDescriber) {
+				m.EXPECT().DescribeVPC("vpc-1").Return(&ec2.VPC{
+					CIDR: "10.1.0.0/16",
+					Tags: map[string]string{
+						"aws:cloudformation:stack-name": "phonetool-test",
+					},
+				}, nil)
+				m.EXPECT().SubnetCIDRs([]string{"subnet-1", "subnet-2"}).Return([]string{"10.1.0.0/24", "10.1.1.0/24"}, nil)
+				m.EXPECT().SubnetCIDRs([]string{"subnet-3", "subnet-4"}).Return([]string{"10.1.2.0/24", "10.1.3.0/24"}, nil)
+			},
+			mockSpinner: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(gomock.Any())
+				m.EXPECT().Stop(gomock.Any())
+			},
+			wantErr: fmt.Errorf("enable internal load balancer in environment test: %w", mockError),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockInternalLB := mocks.NewMockinternalLoadBalancerEnabler(ctrl)
+			mockEnvVPC := mocks.NewMockvpcDescriber(ctrl)
+			mockSpinner := mocks.NewMockprogress(ctrl)
+			tc.mockInternalLB(mockInternalLB)
+			tc.mockEnvVPC(mockEnvVPC)
+			tc.mockSpinner(mockSpinner)
+
+			mft := manifest.NewBackendService(manifest.BackendServiceProps{
+				ServiceProps: manifest.ServiceProps{
+					Name:       "api",
+					Dockerfile: "api/Dockerfile",
+				},
+			})
+			if tc.inHTTP {
+				mft.HTTP.Path = aws.String("/api")
+			}
+
+			opts := deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					Name:       "api",
+				},
+				internalLB:        mockInternalLB,
+				envVPC:            mockEnvVPC,
+				spinner:           mockSpinner,
+				targetEnvironment: testEnv,
+			}
+
+			gotErr := opts.enableInternalLoadBalancer(mft)
+
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcExecAppNamePrompt     = "Which application is the service in?"
+	svcExecAppNameHelpPrompt = "An application groups all of your services together."
+	svcExecNamePrompt        = "Which service would you like to run a command in?"
+	svcExecNameHelpPrompt    = "The command runs in a container of one of the service's running tasks."
+
+	execTaskPrompt          = "Which task would you like to run the command in?"
+	execTaskHelpPrompt      = "The ID of a running task."
+	execContainerPrompt     = "Which container would you like to run the command in?"
+	execContainerHelpPrompt = "The command runs in the selected container of the task."
+
+	defaultExecCommand = "/bin/sh"
+)
+
+type execVars struct {
+	*GlobalOpts
+	name          string
+	envName       string
+	taskID        string
+	containerName string
+	command       string
+}
+
+type svcExecOpts struct {
+	execVars
+
+	store   store
+	sel     deploySelector
+	taskSel runningTaskSelector
+
+	tasksGetter runningTasksGetter
+	executor    commandExecutor
+	ssmPlugin   ssmSessionStarter
+	initClients func(*svcExecOpts) error // Overriden in tests.
+}
+
+func newSvcExecOpts(vars execVars) (*svcExecOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcExecOpts{
+		execVars: vars,
+		store:    configStore,
+		sel:      selector.NewDeploySelect(vars.prompt, configStore, deployStore),
+		taskSel:  selector.NewTaskSelect(vars.prompt),
+		initClients: func(o *svcExecOpts) error {
+			env, err := o.store.GetEnvironment(o.AppName(), o.envName)
+			if err != nil {
+				return fmt.Errorf("get environment %s: %w", o.envName, err)
+			}
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("assuming environment manager role: %w", err)
+			}
+			d, err := describe.NewServiceStatus(&describe.NewServiceStatusConfig{
+				App:         o.AppName(),
+				Env:         o.envName,
+				Svc:         o.name,
+				ConfigStore: o.store,
+			})
+			if err != nil {
+				return fmt.Errorf("create status describer for service %s: %w", o.name, err)
+			}
+			o.tasksGetter = d
+			o.configureExecClients(sess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcExecOpts) Validate() error {
+	if o.AppName() != "" {
+		if _, err := o.store.GetApplication(o.AppName()); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if _, err := o.store.GetService(o.AppName(), o.name); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.AppName(), o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcExecOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	return o.askSvcEnvName()
+}
+
+// Execute starts an interactive session running the command in a container of one of the service's tasks.
+func (o *svcExecOpts) Execute() error {
+	if err := o.initClients(o); err != nil {
+		return err
+	}
+	if err := o.ssmPlugin.ValidateBinary(); err != nil {
+		return err
+	}
+	tasks, err := o.tasksGetter.RunningTasks()
+	if err != nil {
+		return fmt.Errorf("get running tasks of service %s: %w", o.name, err)
+	}
+	task, err := o.taskSel.RunningTask(execTaskPrompt, execTaskHelpPrompt, tasks, o.taskID)
+	if err != nil {
+		return fmt.Errorf("select running task of service %s: %w", o.name, err)
+	}
+	if !aws.BoolValue(task.EnableExecuteCommand) {
+		return fmt.Errorf(`execute command is not enabled for service %s, set %s in its manifest and redeploy it`,
+			o.name, color.HighlightCode("exec: true"))
+	}
+	return startExecSession(execSessionInput{
+		task:      task,
+		container: o.containerName,
+		command:   o.command,
+		sel:       o.taskSel,
+		executor:  o.executor,
+		ssmPlugin: o.ssmPlugin,
+	})
+}
+
+func (o *svcExecOpts) askApp() error {
+	if o.AppName() != "" {
+		return nil
+	}
+	app, err := o.sel.Application(svcExecAppNamePrompt, svcExecAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcExecOpts) askSvcEnvName() error {
+	deployedService, err := o.sel.DeployedService(svcExecNamePrompt, svcExecNameHelpPrompt, o.AppName(), selector.WithEnv(o.envName), selector.WithSvc(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.AppName(), err)
+	}
+	o.name = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+func (o *svcExecOpts) configureExecClients(sess *session.Session) {
+	o.executor = ecs.New(sess)
+	o.ssmPlugin = exec.NewSSMPluginCommand(sess)
+}
+
+type execSessionInput struct {
+	task      *ecs.Task
+	container string
+	command   string
+
+	sel       runningTaskSelector
+	executor  commandExecutor
+	ssmPlugin ssmSessionStarter
+}
+
+// startExecSession runs the command in a container of the task, and connects the terminal to it until the command exits.
+func startExecSession(in execSessionInput) error {
+	container := in.container
+	if container == "" {
+		var err error
+		container, err = in.sel.Container(execContainerPrompt, execContainerHelpPrompt, in.task)
+		if err != nil {
+			return err
+		}
+	}
+	ssmSess, err := in.executor.ExecuteCommand(ecs.ExecuteCommandInput{
+		Cluster:   aws.StringValue(in.task.ClusterArn),
+		Task:      aws.StringValue(in.task.TaskArn),
+		Container: container,
+		Command:   in.command,
+	})
+	if err != nil {
+		return err
+	}
+	log.Infof("Starting an interactive session running %s in container %s.\n",
+		color.HighlightCode(in.command), color.HighlightUserInput(container))
+	return in.ssmPlugin.StartSession(ssmSess)
+}
+
+// BuildSvcExecCmd builds the command for running a command in a container of a running service.
+func BuildSvcExecCmd() *cobra.Command {
+	vars := execVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "exec",
+		Short: "Execute a command in a running container of a service.",
+		Long: `Execute a command in a running container of a service.
+The service must enable "exec" in its manifest, and the Session Manager plugin for the AWS CLI must be installed.`,
+
+		Example: `
+  Start an interactive shell in a task of the service "my-svc" in the "test" environment.
+  /code $ copilot svc exec -n my-svc -e test
+  Run "ls" in the "nginx" container of a specific task.
+  /code $ copilot svc exec -n my-svc -e test --task-id 8c38184 --container nginx --command "ls"`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcExecOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", taskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", containerFlagDescription)
+	cmd.Flags().StringVar(&vars.command, commandFlag, defaultExecCommand, execCommandFlagDescription)
+	return cmd
+}
@@ -0,0 +1,230 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type execMocks struct {
+	tasksGetter *mocks.MockrunningTasksGetter
+	taskSel     *mocks.MockrunningTaskSelector
+	executor    *mocks.MockcommandExecutor
+	ssmPlugin   *mocks.MockssmSessionStarter
+}
+
+func TestSvcExecOpts_Ask(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inputApp     string
+		mockSelector func(m *mocks.MockdeploySelector)
+
+		wantedError error
+		wantedSvc   string
+		wantedEnv   string
+	}{
+		"errors if failed to select application": {
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().Application(svcExecAppNamePrompt, svcExecAppNameHelpPrompt).Return("", mockError)
+			},
+
+			wantedError: fmt.Errorf("select application: some error"),
+		},
+		"errors if failed to select deployed service": {
+			inputApp: "mockApp",
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService(svcExecNamePrompt, svcExecNameHelpPrompt, "mockApp", gomock.Any(), gomock.Any()).
+					Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("select deployed service for application mockApp: some error"),
+		},
+		"success": {
+			inputApp: "mockApp",
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService(svcExecNamePrompt, svcExecNameHelpPrompt, "mockApp", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env: "mockEnv",
+						Svc: "mockSvc",
+					}, nil)
+			},
+
+			wantedSvc: "mockSvc",
+			wantedEnv: "mockEnv",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSelector := mocks.NewMockdeploySelector(ctrl)
+			tc.mockSelector(mockSelector)
+			opts := &svcExecOpts{
+				execVars: execVars{
+					GlobalOpts: &GlobalOpts{
+						appName: tc.inputApp,
+					},
+				},
+				sel: mockSelector,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSvc, opts.name)
+				require.Equal(t, tc.wantedEnv, opts.envName)
+			}
+		})
+	}
+}
+
+func TestSvcExecOpts_Execute(t *testing.T) {
+	const (
+		mockClusterARN = "arn:aws:ecs:us-west-2:123456789:cluster/mockCluster"
+		mockTaskARN    = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/4082490ee6c245e09d2145010aa1ba8d"
+	)
+	mockError := errors.New("some error")
+	mockTasks := []*ecs.Task{
+		{
+			ClusterArn:           aws.String(mockClusterARN),
+			TaskArn:              aws.String(mockTaskARN),
+			EnableExecuteCommand: aws.Bool(true),
+		},
+	}
+	mockSession := &ecs.Session{
+		ID: "mockSessionID",
+	}
+	testCases := map[string]struct {
+		inContainer string
+		setupMocks  func(m execMocks)
+
+		wantedError error
+	}{
+		"errors if the Session Manager plugin is not installed": {
+			setupMocks: func(m execMocks) {
+				m.ssmPlugin.EXPECT().ValidateBinary().Return(mockError)
+			},
+
+			wantedError: mockError,
+		},
+		"errors if failed to get running tasks": {
+			setupMocks: func(m execMocks) {
+				m.ssmPlugin.EXPECT().ValidateBinary().Return(nil)
+				m.tasksGetter.EXPECT().RunningTasks().Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("get running tasks of service mockSvc: some error"),
+		},
+		"errors if failed to select a running task": {
+			setupMocks: func(m execMocks) {
+				m.ssmPlugin.EXPECT().ValidateBinary().Return(nil)
+				m.tasksGetter.EXPECT().RunningTasks().Return(mockTasks, nil)
+				m.taskSel.EXPECT().RunningTask(execTaskPrompt, execTaskHelpPrompt, mockTasks, "").Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("select running task of service mockSvc: some error"),
+		},
+		"errors if execute command is not enabled": {
+			setupMocks: func(m execMocks) {
+				m.ssmPlugin.EXPECT().ValidateBinary().Return(nil)
+				m.tasksGetter.EXPECT().RunningTasks().Return(mockTasks, nil)
+				m.taskSel.EXPECT().RunningTask(execTaskPrompt, execTaskHelpPrompt, mockTasks, "").Return(&ecs.Task{
+					TaskArn: aws.String(mockTaskARN),
+				}, nil)
+			},
+
+			wantedError: errors.New("execute command is not enabled for service mockSvc, set `exec: true` in its manifest and redeploy it"),
+		},
+		"errors if failed to execute the command": {
+			inContainer: "mockSvc",
+			setupMocks: func(m execMocks) {
+				m.ssmPlugin.EXPECT().ValidateBinary().Return(nil)
+				m.tasksGetter.EXPECT().RunningTasks().Return(mockTasks, nil)
+				m.taskSel.EXPECT().RunningTask(execTaskPrompt, execTaskHelpPrompt, mockTasks, "").Return(mockTasks[0], nil)
+				m.executor.EXPECT().ExecuteCommand(ecs.ExecuteCommandInput{
+					Cluster:   mockClusterARN,
+					Task:      mockTaskARN,
+					Container: "mockSvc",
+					Command:   "/bin/sh",
+				}).Return(nil, mockError)
+			},
+
+			wantedError: mockError,
+		},
+		"starts a session in the selected container": {
+			setupMocks: func(m execMocks) {
+				m.ssmPlugin.EXPECT().ValidateBinary().Return(nil)
+				m.tasksGetter.EXPECT().RunningTasks().Return(mockTasks, nil)
+				m.taskSel.EXPECT().RunningTask(execTaskPrompt, execTaskHelpPrompt, mockTasks, "").Return(mockTasks[0], nil)
+				m.taskSel.EXPECT().Container(execContainerPrompt, execContainerHelpPrompt, mockTasks[0]).Return("nginx", nil)
+				m.executor.EXPECT().ExecuteCommand(ecs.ExecuteCommandInput{
+					Cluster:   mockClusterARN,
+					Task:      mockTaskARN,
+					Container: "nginx",
+					Command:   "/bin/sh",
+				}).Return(mockSession, nil)
+				m.ssmPlugin.EXPECT().StartSession(mockSession).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := execMocks{
+				tasksGetter: mocks.NewMockrunningTasksGetter(ctrl),
+				taskSel:     mocks.NewMockrunningTaskSelector(ctrl),
+				executor:    mocks.NewMockcommandExecutor(ctrl),
+				ssmPlugin:   mocks.NewMockssmSessionStarter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcExecOpts{
+				execVars: execVars{
+					GlobalOpts: &GlobalOpts{
+						appName: "mockApp",
+					},
+					name:          "mockSvc",
+					envName:       "mockEnv",
+					containerName: tc.inContainer,
+					command:       "/bin/sh",
+				},
+				taskSel: m.taskSel,
+				initClients: func(o *svcExecOpts) error {
+					o.tasksGetter = m.tasksGetter
+					o.executor = m.executor
+					o.ssmPlugin = m.ssmPlugin
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -29,7 +29,11 @@ var (
 To learn more see: https://git.io/JfIpv
 
 A %s is a private, non internet-facing service.
-To learn more see: https://git.io/JfIpT`
+To learn more see: https://git.io/JfIpT
+
+A %s is a task that runs to completion on a recurring schedule.
+
+A %s is a private, non internet-facing service that processes messages from a queue.`
 
 	fmtSvcInitSvcNamePrompt     = "What do you want to %s this %s?"
 	fmtSvcInitSvcNameHelpPrompt = `The name will uniquely identify this service within your app %s.
@@ -41,6 +45,10 @@ Deployed resources (such as your service, logs) will contain this service's name
 	svcInitSvcPortPrompt     = "Which %s do you want customer traffic sent to?"
 	svcInitSvcPortHelpPrompt = `The port will be used by the load balancer to route incoming traffic to this service.
 You should set this to the port which your Dockerfile uses to communicate with the internet.`
+
+	svcInitSchedulePrompt     = "How would you like to %s this job?"
+	svcInitScheduleHelpPrompt = `The schedule determines how often the job is triggered. Examples:
+"@daily", "@every 30m", "0 9 * * MON-FRI" or "rate(1 hour)".`
 )
 
 const (
@@ -51,6 +59,7 @@ const (
 
 const (
 	defaultSvcPortString = "80"
+	defaultJobSchedule   = "@daily"
 )
 
 type initSvcVars struct {
@@ -59,6 +68,7 @@ type initSvcVars struct {
 	Name           string
 	DockerfilePath string
 	Port           uint16
+	Schedule       string
 }
 
 type initSvcOpts struct {
@@ -136,6 +146,11 @@ func (o *initSvcOpts) Validate() error {
 			return err
 		}
 	}
+	if o.Schedule != "" {
+		if err := validateSchedule(o.Schedule); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -150,6 +165,13 @@ func (o *initSvcOpts) Ask() error {
 	if err := o.askDockerfile(); err != nil {
 		return err
 	}
+	switch o.ServiceType {
+	case manifest.ScheduledJobType:
+		return o.askSchedule()
+	case manifest.WorkerServiceType:
+		// Worker services don't receive traffic so they don't need a port.
+		return nil
+	}
 	if err := o.askSvcPort(); err != nil {
 		return err
 	}
@@ -188,12 +210,12 @@ func (o *initSvcOpts) Execute() error {
 }
 
 func (o *initSvcOpts) createManifest() (string, error) {
-	manifest, err := o.newManifest()
+	mft, err := o.newManifest()
 	if err != nil {
 		return "", err
 	}
 	var manifestExists bool
-	manifestPath, err := o.ws.WriteServiceManifest(manifest, o.Name)
+	manifestPath, err := o.ws.WriteServiceManifest(mft, o.Name)
 	if err != nil {
 		e, ok := err.(*workspace.ErrFileExists)
 		if !ok {
@@ -212,7 +234,14 @@ func (o *initSvcOpts) createManifest() (string, error) {
 		manifestMsgFmt = "Manifest file for service %s already exists at %s, skipping writing it.\n"
 	}
 	log.Successf(manifestMsgFmt, color.HighlightUserInput(o.Name), color.HighlightResource(manifestPath))
-	log.Infoln(color.Help(fmt.Sprintf("Your manifest contains configurations like your container size and port (:%d).", o.Port)))
+	switch o.ServiceType {
+	case manifest.ScheduledJobType:
+		log.Infoln(color.Help(fmt.Sprintf("Your manifest contains configurations like your container size and schedule (%s).", o.Schedule)))
+	case manifest.WorkerServiceType:
+		log.Infoln(color.Help("Your manifest contains configurations like your container size and queue."))
+	default:
+		log.Infoln(color.Help(fmt.Sprintf("Your manifest contains configurations like your container size and port (:%d).", o.Port)))
+	}
 	log.Infoln()
 
 	return manifestPath, nil
@@ -224,6 +253,10 @@ func (o *initSvcOpts) newManifest() (encoding.BinaryMarshaler, error) {
 		return o.newLoadBalancedWebServiceManifest()
 	case manifest.BackendServiceType:
 		return o.newBackendServiceManifest()
+	case manifest.ScheduledJobType:
+		return o.newScheduledJobManifest(), nil
+	case manifest.WorkerServiceType:
+		return o.newWorkerServiceManifest(), nil
 	default:
 		return nil, fmt.Errorf("service type %s doesn't have a manifest", o.ServiceType)
 	}
@@ -268,6 +301,25 @@ func (o *initSvcOpts) newBackendServiceManifest() (*manifest.BackendService, err
 	}), nil
 }
 
+func (o *initSvcOpts) newScheduledJobManifest() *manifest.ScheduledJob {
+	return manifest.NewScheduledJob(&manifest.ScheduledJobProps{
+		ServiceProps: &manifest.ServiceProps{
+			Name:       o.Name,
+			Dockerfile: o.DockerfilePath,
+		},
+		Schedule: o.Schedule,
+	})
+}
+
+func (o *initSvcOpts) newWorkerServiceManifest() *manifest.WorkerService {
+	return manifest.NewWorkerService(&manifest.WorkerServiceProps{
+		ServiceProps: &manifest.ServiceProps{
+			Name:       o.Name,
+			Dockerfile: o.DockerfilePath,
+		},
+	})
+}
+
 func (o *initSvcOpts) askSvcType() error {
 	if o.ServiceType != "" {
 		return nil
@@ -276,6 +328,8 @@ func (o *initSvcOpts) askSvcType() error {
 	help := fmt.Sprintf(fmtSvcInitSvcTypeHelpPrompt,
 		manifest.LoadBalancedWebServiceType,
 		manifest.BackendServiceType,
+		manifest.ScheduledJobType,
+		manifest.WorkerServiceType,
 	)
 	msg := fmt.Sprintf(fmtSvcInitSvcTypePrompt, color.Emphasize("service type"))
 	t, err := o.prompt.SelectOne(msg, help, manifest.ServiceTypes, prompt.WithFinalMessage("Service type:"))
@@ -376,6 +430,25 @@ func (o *initSvcOpts) askSvcPort() error {
 	return nil
 }
 
+func (o *initSvcOpts) askSchedule() error {
+	if o.Schedule != "" {
+		return nil
+	}
+
+	schedule, err := o.prompt.Get(
+		fmt.Sprintf(svcInitSchedulePrompt, color.Emphasize("schedule")),
+		svcInitScheduleHelpPrompt,
+		validateSchedule,
+		prompt.WithDefaultInput(defaultJobSchedule),
+		prompt.WithFinalMessage("Schedule:"),
+	)
+	if err != nil {
+		return fmt.Errorf("get schedule: %w", err)
+	}
+	o.Schedule = schedule
+	return nil
+}
+
 func (o *initSvcOpts) parseHealthCheck() (*manifest.ContainerHealthCheck, error) {
 	o.setupParser(o)
 	hc, err := o.df.GetHealthCheck()
@@ -419,7 +492,13 @@ This command is also run as part of "copilot init".`,
   /code $ copilot svc init --name frontend --svc-type "Load Balanced Web Service" --dockerfile ./frontend/Dockerfile
 
   Create a "subscribers" backend service.
-  /code $ copilot svc init --name subscribers --svc-type "Backend Service"`,
+  /code $ copilot svc init --name subscribers --svc-type "Backend Service"
+
+  Create a "reports" scheduled job that runs every weekday morning.
+  /code $ copilot svc init --name reports --svc-type "Scheduled Job" --schedule "0 9 * * MON-FRI"
+
+  Create an "orders" worker service that processes messages from a queue.
+  /code $ copilot svc init --name orders --svc-type "Worker Service" --dockerfile ./orders/Dockerfile`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitSvcOpts(vars)
 			if err != nil {
@@ -446,6 +525,7 @@ This command is also run as part of "copilot init".`,
 	cmd.Flags().StringVarP(&vars.ServiceType, svcTypeFlag, svcTypeFlagShort, "", svcTypeFlagDescription)
 	cmd.Flags().StringVarP(&vars.DockerfilePath, dockerFileFlag, dockerFileFlagShort, "", dockerFileFlagDescription)
 	cmd.Flags().Uint16Var(&vars.Port, svcPortFlag, 0, svcPortFlagDescription)
+	cmd.Flags().StringVar(&vars.Schedule, scheduleFlag, "", scheduleFlagDescription)
 
 	// Bucket flags by service type.
 	requiredFlags := pflag.NewFlagSet("Required Flags", pflag.ContinueOnError)
@@ -459,12 +539,17 @@ This command is also run as part of "copilot init".`,
 	backendSvcFlags := pflag.NewFlagSet(manifest.BackendServiceType, pflag.ContinueOnError)
 	backendSvcFlags.AddFlag(cmd.Flags().Lookup(svcPortFlag))
 
+	scheduledJobFlags := pflag.NewFlagSet(manifest.ScheduledJobType, pflag.ContinueOnError)
+	scheduledJobFlags.AddFlag(cmd.Flags().Lookup(scheduleFlag))
+
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
-		"sections":                          fmt.Sprintf(`Required,%s`, strings.Join(manifest.ServiceTypes, ",")),
+		// Worker services don't have any type-specific flags.
+		"sections":                          fmt.Sprintf(`Required,%s`, strings.Join([]string{manifest.LoadBalancedWebServiceType, manifest.BackendServiceType, manifest.ScheduledJobType}, ",")),
 		"Required":                          requiredFlags.FlagUsages(),
 		manifest.LoadBalancedWebServiceType: lbWebSvcFlags.FlagUsages(),
 		manifest.BackendServiceType:         lbWebSvcFlags.FlagUsages(),
+		manifest.ScheduledJobType:           scheduledJobFlags.FlagUsages(),
 	}
 	cmd.SetUsageTemplate(`{{h1 "Usage"}}{{if .Runnable}}
   {{.UseLine}}{{end}}{{$annotations := .Annotations}}{{$sections := split .Annotations.sections ","}}{{if gt (len $sections) 0}}
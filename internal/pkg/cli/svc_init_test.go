@@ -26,6 +26,7 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 		inDockerfilePath string
 		inAppName        string
 		inSvcPort        uint16
+		inSchedule       string
 
 		mockFileSystem func(mockFS afero.Fs)
 		wantedErr      error
@@ -33,7 +34,13 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 		"invalid service type": {
 			inAppName: "phonetool",
 			inSvcType: "TestSvcType",
-			wantedErr: errors.New(`invalid service type TestSvcType: must be one of "Load Balanced Web Service", "Backend Service"`),
+			wantedErr: errors.New(`invalid service type TestSvcType: must be one of "Load Balanced Web Service", "Backend Service", "Scheduled Job", "Worker Service"`),
+		},
+		"invalid schedule": {
+			inAppName:  "phonetool",
+			inSvcType:  manifest.ScheduledJobType,
+			inSchedule: "every day",
+			wantedErr:  errors.New("schedule every day is invalid: cron expression must have 5 fields, got 2"),
 		},
 		"invalid service name": {
 			inAppName: "phonetool",
@@ -71,6 +78,7 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 					Name:           tc.inSvcName,
 					DockerfilePath: tc.inDockerfilePath,
 					Port:           tc.inSvcPort,
+					Schedule:       tc.inSchedule,
 					GlobalOpts:     &GlobalOpts{appName: tc.inAppName},
 				},
 				fs: &afero.Afero{Fs: afero.NewMemMapFs()},
@@ -0,0 +1,132 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+type lintSvcVars struct {
+	*GlobalOpts
+	name    string
+	envName string
+}
+
+type lintSvcOpts struct {
+	lintSvcVars
+
+	ws        wsSvcReader
+	unmarshal func(in []byte, opts ...manifest.UnmarshalOption) (interface{}, error)
+}
+
+func newLintSvcOpts(vars lintSvcVars) (*lintSvcOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	return &lintSvcOpts{
+		lintSvcVars: vars,
+		ws:          ws,
+		unmarshal:   manifest.UnmarshalService,
+	}, nil
+}
+
+// Validate returns an error if the service to lint is not in the workspace.
+func (o *lintSvcOpts) Validate() error {
+	if o.name == "" {
+		return nil
+	}
+	names, err := o.ws.ServiceNames()
+	if err != nil {
+		return fmt.Errorf("list services in the workspace: %w", err)
+	}
+	for _, name := range names {
+		if o.name == name {
+			return nil
+		}
+	}
+	return fmt.Errorf("service %s not found in the workspace", color.HighlightUserInput(o.name))
+}
+
+// Execute validates the manifest and the environment overrides of the service, or of every service in the workspace.
+// It returns an error if any of the manifests is invalid.
+func (o *lintSvcOpts) Execute() error {
+	names := []string{o.name}
+	if o.name == "" {
+		var err error
+		names, err = o.ws.ServiceNames()
+		if err != nil {
+			return fmt.Errorf("list services in the workspace: %w", err)
+		}
+	}
+	var invalid int
+	for _, name := range names {
+		if err := o.lint(name); err != nil {
+			log.Errorf("Manifest of %s is invalid: %v\n", color.HighlightUserInput(name), err)
+			invalid++
+			continue
+		}
+		log.Successf("Manifest of %s is valid.\n", color.HighlightUserInput(name))
+	}
+	if invalid > 0 {
+		return fmt.Errorf("%d of %d manifests are invalid", invalid, len(names))
+	}
+	return nil
+}
+
+func (o *lintSvcOpts) lint(name string) error {
+	raw, err := o.ws.ReadServiceManifest(name)
+	if err != nil {
+		return fmt.Errorf("read manifest file: %w", err)
+	}
+	opts, err := svcManifestOpts(o.ws, o.AppName(), o.envName)
+	if err != nil {
+		return err
+	}
+	if _, err := o.unmarshal(raw, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// BuildSvcLintCmd builds the command for validating service manifests.
+func BuildSvcLintCmd() *cobra.Command {
+	vars := lintSvcVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "lint",
+		Short: "Validate the manifests of the services in your workspace.",
+		Long: `Validate the manifests of the services in your workspace.
+Unknown fields and values that can't be deployed, such as a CPU and memory combination that Fargate doesn't support,
+are reported for the manifest and each of its environment overrides without calling AWS.`,
+
+		Example: `
+  Validate the manifests of all the services and jobs in the workspace.
+  /code $ copilot svc lint
+  Validate the manifest of the service "frontend".
+  /code $ copilot svc lint -n frontend
+  Validate the manifest of the service "frontend" with the variables of the "test" environment.
+  /code $ copilot svc lint -n frontend -e test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newLintSvcOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", lintEnvFlagDescription)
+	return cmd
+}
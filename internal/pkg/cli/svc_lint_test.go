@@ -0,0 +1,212 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintSvcOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inName string
+
+		setupMocks func(m *mocks.MockwsSvcReader)
+
+		wantedErr error
+	}{
+		"does not list services if no name is provided": {
+			setupMocks: func(m *mocks.MockwsSvcReader) {},
+		},
+		"error if fail to list services": {
+			inName: "frontend",
+			setupMocks: func(m *mocks.MockwsSvcReader) {
+				m.EXPECT().ServiceNames().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list services in the workspace: some error"),
+		},
+		"error if the service is not in the workspace": {
+			inName: "frontend",
+			setupMocks: func(m *mocks.MockwsSvcReader) {
+				m.EXPECT().ServiceNames().Return([]string{"backend"}, nil)
+			},
+			wantedErr: errors.New("service frontend not found in the workspace"),
+		},
+		"valid": {
+			inName: "frontend",
+			setupMocks: func(m *mocks.MockwsSvcReader) {
+				m.EXPECT().ServiceNames().Return([]string{"backend", "frontend"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWs := mocks.NewMockwsSvcReader(ctrl)
+			tc.setupMocks(mockWs)
+
+			opts := &lintSvcOpts{
+				lintSvcVars: lintSvcVars{
+					name: tc.inName,
+				},
+				ws: mockWs,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLintSvcOpts_Execute(t *testing.T) {
+	const (
+		validManifest = `name: frontend
+type: Load Balanced Web Service
+image:
+  build: frontend/Dockerfile
+  port: 80
+http:
+  path: '/'
+cpu: 256
+memory: 512
+`
+		overrideManifest = `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+cpu: 256
+memory: 512
+environments:
+  prod:
+    memory: 4096
+`
+	)
+	testCases := map[string]struct {
+		inName    string
+		inEnvName string
+
+		setupMocks func(m *mocks.MockwsSvcReader)
+
+		wantedErr error
+	}{
+		"error if fail to list services": {
+			setupMocks: func(m *mocks.MockwsSvcReader) {
+				m.EXPECT().ServiceNames().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list services in the workspace: some error"),
+		},
+		"lints a single service": {
+			inName: "frontend",
+			setupMocks: func(m *mocks.MockwsSvcReader) {
+				m.EXPECT().ReadServiceManifest("frontend").Return([]byte(validManifest), nil)
+				m.EXPECT().ReadServiceDefaults().Return(nil, nil)
+			},
+		},
+		"lints every service and counts the invalid manifests": {
+			setupMocks: func(m *mocks.MockwsSvcReader) {
+				m.EXPECT().ServiceNames().Return([]string{"frontend", "api", "worker"}, nil)
+				m.EXPECT().ReadServiceManifest("frontend").Return([]byte(validManifest), nil)
+				m.EXPECT().ReadServiceDefaults().Return(nil, nil)
+				m.EXPECT().ReadServiceManifest("api").Return([]byte(overrideManifest), nil)
+				m.EXPECT().ReadServiceDefaults().Return(nil, nil)
+				m.EXPECT().ReadServiceManifest("worker").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("2 of 3 manifests are invalid"),
+		},
+		"interpolates the built-in variables": {
+			inName:    "frontend",
+			inEnvName: "test",
+			setupMocks: func(m *mocks.MockwsSvcReader) {
+				m.EXPECT().ReadServiceManifest("frontend").Return([]byte(`name: frontend
+type: Backend Service
+image:
+  location: ${COPILOT_APPLICATION_NAME}/${COPILOT_ENVIRONMENT_NAME}/frontend
+`), nil)
+				m.EXPECT().ReadServiceDefaults().Return(nil, nil)
+			},
+		},
+		"merges the workspace defaults": {
+			inName: "api",
+			setupMocks: func(m *mocks.MockwsSvcReader) {
+				m.EXPECT().ReadServiceManifest("api").Return([]byte(overrideManifest), nil)
+				m.EXPECT().ReadServiceDefaults().Return([]byte(`environments:
+  prod:
+    cpu: 1024
+`), nil)
+			},
+		},
+		"error if the workspace defaults are invalid": {
+			inName: "api",
+			setupMocks: func(m *mocks.MockwsSvcReader) {
+				m.EXPECT().ReadServiceManifest("api").Return([]byte(overrideManifest), nil)
+				m.EXPECT().ReadServiceDefaults().Return([]byte(`name: api`), nil)
+			},
+			wantedErr: errors.New("1 of 1 manifests are invalid"),
+		},
+		"leaves the environment name unresolved without an environment": {
+			inName: "frontend",
+			setupMocks: func(m *mocks.MockwsSvcReader) {
+				m.EXPECT().ReadServiceManifest("frontend").Return([]byte(`name: frontend
+type: Backend Service
+image:
+  location: ${COPILOT_ENVIRONMENT_NAME}/frontend
+`), nil)
+				m.EXPECT().ReadServiceDefaults().Return(nil, nil)
+			},
+		},
+		"error if a variable is not defined": {
+			inName: "frontend",
+			setupMocks: func(m *mocks.MockwsSvcReader) {
+				m.EXPECT().ReadServiceManifest("frontend").Return([]byte(`name: frontend
+type: Backend Service
+image:
+  location: ${COPILOT_LINT_UNDEFINED_VARIABLE}/frontend
+`), nil)
+				m.EXPECT().ReadServiceDefaults().Return(nil, nil)
+			},
+			wantedErr: errors.New("1 of 1 manifests are invalid"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWs := mocks.NewMockwsSvcReader(ctrl)
+			tc.setupMocks(mockWs)
+
+			opts := &lintSvcOpts{
+				lintSvcVars: lintSvcVars{
+					GlobalOpts: &GlobalOpts{appName: "phonetool"},
+					name:       tc.inName,
+					envName:    tc.inEnvName,
+				},
+				ws:        mockWs,
+				unmarshal: manifest.UnmarshalService,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
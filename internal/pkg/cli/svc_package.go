@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -11,9 +12,12 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
@@ -53,9 +57,11 @@ type packageSvcOpts struct {
 	// Interfaces to interact with dependencies.
 	addonsSvc       templater
 	initAddonsSvc   func(*packageSvcOpts) error // Overriden in tests.
-	ws              wsSvcReader
+	ws              wsSvcDirReader
 	store           store
 	appCFN          appResourcesGetter
+	sessProvider    regionalSessionProvider
+	newUploader     func(sess *session.Session) artifactUploader
 	stackWriter     io.Writer
 	paramsWriter    io.Writer
 	addonsWriter    io.Writer
@@ -92,6 +98,10 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 		paramsWriter:   ioutil.Discard,
 		addonsWriter:   ioutil.Discard,
 		fs:             &afero.Afero{Fs: afero.NewOsFs()},
+		sessProvider:   p,
+		newUploader: func(sess *session.Session) artifactUploader {
+			return s3.New(sess)
+		},
 	}
 
 	opts.stackSerializer = func(mft interface{}, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) (stackSerializer, error) {
@@ -99,7 +109,11 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 		switch v := mft.(type) {
 		case *manifest.LoadBalancedWebService:
 			if app.RequiresDNSDelegation() {
-				serializer, err = stack.NewHTTPSLoadBalancedWebService(v, env.Name, app.Name, rc)
+				serializer, err = stack.NewHTTPSLoadBalancedWebService(v, env.Name, deploy.AppInformation{
+					Name:      app.Name,
+					AccountID: app.AccountID,
+					DNSName:   app.Domain,
+				}, rc)
 				if err != nil {
 					return nil, fmt.Errorf("init https load balanced web service stack serializer: %w", err)
 				}
@@ -114,6 +128,16 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 			if err != nil {
 				return nil, fmt.Errorf("init backend service stack serializer: %w", err)
 			}
+		case *manifest.ScheduledJob:
+			serializer, err = stack.NewScheduledJob(v, env.Name, app.Name, rc)
+			if err != nil {
+				return nil, fmt.Errorf("init scheduled job stack serializer: %w", err)
+			}
+		case *manifest.WorkerService:
+			serializer, err = stack.NewWorkerService(v, env.Name, app.Name, rc)
+			if err != nil {
+				return nil, fmt.Errorf("init worker service stack serializer: %w", err)
+			}
 		default:
 			return nil, fmt.Errorf("create stack serializer for manifest of type %T", v)
 		}
@@ -261,7 +285,11 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 	if err != nil {
 		return nil, err
 	}
-	mft, err := manifest.UnmarshalService(raw)
+	opts, err := svcManifestOpts(o.ws, o.AppName(), env.Name)
+	if err != nil {
+		return nil, err
+	}
+	mft, err := manifest.UnmarshalService(raw, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -275,17 +303,27 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 		return nil, err
 	}
 
+	location, err := envImageLocation(mft, env.Name)
+	if err != nil {
+		return nil, err
+	}
 	repoURL, ok := resources.RepositoryURLs[o.Name]
-	if !ok {
+	if !ok && location == "" {
 		return nil, &errRepoNotFound{
 			svcName:      o.Name,
 			envRegion:    env.Region,
 			appAccountID: app.AccountID,
 		}
 	}
+	envFileURL, err := o.uploadEnvFile(mft, env, resources.S3Bucket)
+	if err != nil {
+		return nil, err
+	}
 	serializer, err := o.stackSerializer(mft, env, app, stack.RuntimeConfig{
 		ImageRepoURL:   repoURL,
 		ImageTag:       o.Tag,
+		ImageLocation:  location,
+		EnvFileURL:     envFileURL,
 		AdditionalTags: app.Tags,
 	})
 	if err != nil {
@@ -302,6 +340,32 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 	return &svcCfnTemplates{stack: tpl, configuration: params}, nil
 }
 
+// uploadEnvFile uploads the environment file of the service in the environment to the application's bucket,
+// since the template references the S3 object instead of the local file.
+// If the service doesn't have an environment file, it returns the empty string and no errors.
+func (o *packageSvcOpts) uploadEnvFile(mft interface{}, env *config.Environment, bucket string) (string, error) {
+	path, err := envFilePath(mft, env.Name)
+	if err != nil {
+		return "", err
+	}
+	if path == "" {
+		return "", nil
+	}
+	content, err := readEnvFile(o.ws, o.fs, path)
+	if err != nil {
+		return "", err
+	}
+	sess, err := o.sessProvider.DefaultWithRegion(env.Region)
+	if err != nil {
+		return "", fmt.Errorf("create session for environment %s: %w", env.Name, err)
+	}
+	url, err := o.newUploader(sess).PutArtifact(bucket, fmt.Sprintf(config.EnvFileNameFormat, o.Name), bytes.NewReader(content))
+	if err != nil {
+		return "", fmt.Errorf("put environment file %s to bucket %s: %w", path, bucket, err)
+	}
+	return url, nil
+}
+
 // setOutputFileWriters creates the output directory, and updates the template and param writers to file writers in the directory.
 func (o *packageSvcOpts) setOutputFileWriters() error {
 	if err := o.fs.MkdirAll(o.OutputDir, 0755); err != nil {
@@ -376,7 +440,8 @@ func BuildSvcPackageCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "package",
 		Short: "Prints the AWS CloudFormation template of a service.",
-		Long:  `Prints the CloudFormation template used to deploy a service to an environment.`,
+		Long: `Prints the CloudFormation template used to deploy a service to an environment.
+If the service has an env_file, the file is uploaded to the application's S3 bucket so that the template can reference it.`,
 		Example: `
   Print the CloudFormation template for the "frontend" service parametrized for the "test" environment.
   /code $ copilot svc package -n frontend -e test
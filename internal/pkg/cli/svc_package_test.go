@@ -8,17 +8,19 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 )
 
 func TestPackageSvcOpts_Validate(t *testing.T) {
 	var (
-		mockWorkspace *mocks.MockwsSvcReader
+		mockWorkspace *mocks.MockwsSvcDirReader
 		mockStore     *mocks.Mockstore
 	)
 
@@ -83,7 +85,7 @@ func TestPackageSvcOpts_Validate(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			mockWorkspace = mocks.NewMockwsSvcReader(ctrl)
+			mockWorkspace = mocks.NewMockwsSvcDirReader(ctrl)
 			mockStore = mocks.NewMockstore(ctrl)
 
 			tc.setupMocks()
@@ -283,7 +285,7 @@ func TestPackageSvcOpts_Execute(t *testing.T) {
 					GetApplication("ecs-kudos").
 					Return(mockApp, nil)
 
-				mockWs := mocks.NewMockwsSvcReader(ctrl)
+				mockWs := mocks.NewMockwsSvcDirReader(ctrl)
 				mockWs.EXPECT().
 					ReadServiceManifest("api").
 					Return([]byte(`name: api
@@ -296,6 +298,7 @@ http:
 cpu: 256
 memory: 512
 count: 1`), nil)
+				mockWs.EXPECT().ReadServiceDefaults().Return(nil, nil)
 
 				mockCfn := mocks.NewMockappResourcesGetter(ctrl)
 				mockCfn.EXPECT().
@@ -325,6 +328,161 @@ count: 1`), nil)
 				}
 			},
 
+			wantedStack:  "mystack",
+			wantedParams: "myparams",
+		},
+		"writes service template that deploys an existing image without a repository": {
+			inVars: packageSvcVars{
+				GlobalOpts: &GlobalOpts{
+					appName: "ecs-kudos",
+				},
+				Name:    "api",
+				EnvName: "test",
+				Tag:     "1234",
+			},
+			mockDependencies: func(ctrl *gomock.Controller, opts *packageSvcOpts) {
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().
+					GetEnvironment("ecs-kudos", "test").
+					Return(&config.Environment{
+						App:       "ecs-kudos",
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "1111",
+					}, nil)
+				mockApp := &config.Application{
+					Name:      "ecs-kudos",
+					AccountID: "1112",
+				}
+				mockStore.EXPECT().
+					GetApplication("ecs-kudos").
+					Return(mockApp, nil)
+
+				mockWs := mocks.NewMockwsSvcDirReader(ctrl)
+				mockWs.EXPECT().
+					ReadServiceManifest("api").
+					Return([]byte(`name: api
+type: Backend Service
+image:
+  build: ./Dockerfile
+  port: 80
+environments:
+  test:
+    image:
+      location: nginx:latest`), nil)
+				mockWs.EXPECT().ReadServiceDefaults().Return(nil, nil)
+
+				mockCfn := mocks.NewMockappResourcesGetter(ctrl)
+				mockCfn.EXPECT().
+					GetAppResourcesByRegion(mockApp, "us-west-2").
+					Return(&stack.AppRegionalResources{}, nil)
+
+				mockAddons := mocks.NewMocktemplater(ctrl)
+				mockAddons.EXPECT().Template().
+					Return("", &addon.ErrDirNotExist{})
+
+				opts.store = mockStore
+				opts.ws = mockWs
+				opts.appCFN = mockCfn
+				opts.initAddonsSvc = func(opts *packageSvcOpts) error {
+					opts.addonsSvc = mockAddons
+					return nil
+				}
+				opts.stackSerializer = func(_ interface{}, _ *config.Environment, _ *config.Application, rc stack.RuntimeConfig) (stackSerializer, error) {
+					require.Equal(t, "nginx:latest", rc.ImageLocation)
+					mockStackSerializer := mocks.NewMockstackSerializer(ctrl)
+					mockStackSerializer.EXPECT().Template().Return("mystack", nil)
+					mockStackSerializer.EXPECT().SerializedParameters().Return("myparams", nil)
+					return mockStackSerializer, nil
+				}
+			},
+
+			wantedStack:  "mystack",
+			wantedParams: "myparams",
+		},
+		"uploads the environment file of the service": {
+			inVars: packageSvcVars{
+				GlobalOpts: &GlobalOpts{
+					appName: "ecs-kudos",
+				},
+				Name:    "api",
+				EnvName: "test",
+				Tag:     "1234",
+			},
+			mockDependencies: func(ctrl *gomock.Controller, opts *packageSvcOpts) {
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().
+					GetEnvironment("ecs-kudos", "test").
+					Return(&config.Environment{
+						App:       "ecs-kudos",
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "1111",
+					}, nil)
+				mockApp := &config.Application{
+					Name:      "ecs-kudos",
+					AccountID: "1112",
+				}
+				mockStore.EXPECT().
+					GetApplication("ecs-kudos").
+					Return(mockApp, nil)
+
+				mockWs := mocks.NewMockwsSvcDirReader(ctrl)
+				mockWs.EXPECT().
+					ReadServiceManifest("api").
+					Return([]byte(`name: api
+type: Backend Service
+image:
+  build: ./Dockerfile
+  port: 80
+env_file: api.env`), nil)
+				mockWs.EXPECT().ReadServiceDefaults().Return(nil, nil)
+				mockWs.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+
+				mockCfn := mocks.NewMockappResourcesGetter(ctrl)
+				mockCfn.EXPECT().
+					GetAppResourcesByRegion(mockApp, "us-west-2").
+					Return(&stack.AppRegionalResources{
+						RepositoryURLs: map[string]string{
+							"api": "some url",
+						},
+						S3Bucket: "mockBucket",
+					}, nil)
+
+				mockSess := mocks.NewMockregionalSessionProvider(ctrl)
+				mockSess.EXPECT().DefaultWithRegion("us-west-2").Return(&session.Session{}, nil)
+				mockUploader := mocks.NewMockartifactUploader(ctrl)
+				mockUploader.EXPECT().PutArtifact("mockBucket", "api.env", gomock.Any()).
+					Return("https://mockBucket.s3.us-west-2.amazonaws.com/manual/1/api.env", nil)
+
+				fs := afero.NewMemMapFs()
+				require.NoError(t, afero.WriteFile(fs, "/ws/api.env", []byte("LOG_LEVEL=info"), 0644))
+
+				mockAddons := mocks.NewMocktemplater(ctrl)
+				mockAddons.EXPECT().Template().
+					Return("", &addon.ErrDirNotExist{})
+
+				opts.store = mockStore
+				opts.ws = mockWs
+				opts.appCFN = mockCfn
+				opts.fs = fs
+				opts.sessProvider = mockSess
+				opts.newUploader = func(sess *session.Session) artifactUploader {
+					return mockUploader
+				}
+				opts.initAddonsSvc = func(opts *packageSvcOpts) error {
+					opts.addonsSvc = mockAddons
+					return nil
+				}
+				opts.stackSerializer = func(_ interface{}, _ *config.Environment, _ *config.Application, rc stack.RuntimeConfig) (stackSerializer, error) {
+					require.Equal(t, "https://mockBucket.s3.us-west-2.amazonaws.com/manual/1/api.env", rc.EnvFileURL)
+					mockStackSerializer := mocks.NewMockstackSerializer(ctrl)
+					mockStackSerializer.EXPECT().Template().Return("mystack", nil)
+					mockStackSerializer.EXPECT().SerializedParameters().Return("myparams", nil)
+					return mockStackSerializer, nil
+				}
+			},
+
 			wantedStack:  "mystack",
 			wantedParams: "myparams",
 		},
@@ -0,0 +1,339 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcRollbackAppNamePrompt      = "Which application is the service in?"
+	svcRollbackAppNameHelpPrompt  = "An application groups all of your services together."
+	svcRollbackNamePrompt         = "Which service would you like to roll back?"
+	svcRollbackNameHelpPrompt     = "A previous revision of the service will be redeployed to its environment."
+	fmtSvcRollbackRevisionPrompt  = "Which revision of %s would you like to redeploy?"
+	svcRollbackRevisionHelpPrompt = "The template and parameters that the revision was deployed with are redeployed."
+
+	// fmtSvcRevisionKey is the key of the S3 object that stores a revision of a service, in the application's bucket.
+	fmtSvcRevisionKey = "revisions/%s/%s/%s/%d.json"
+)
+
+var errNoPreviousRevision = errors.New("no previous successful deployment to roll back to")
+
+type rollbackSvcVars struct {
+	*GlobalOpts
+	svcName  string
+	envName  string
+	revision int
+}
+
+type rollbackSvcOpts struct {
+	rollbackSvcVars
+
+	store         store
+	sel           deploySelector
+	spinner       progress
+	svcCFN        svcRollbacker
+	taskDefGetter taskDefinitionGetter
+	appCFN        appResourcesGetter
+	s3            artifactStore
+	initClients   func(*rollbackSvcOpts) error // Overriden in tests.
+
+	// cached variables
+	targetEnv      *config.Environment
+	targetRevision *deploy.ServiceRevision
+}
+
+func newRollbackSvcOpts(vars rollbackSvcVars) (*rollbackSvcOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &rollbackSvcOpts{
+		rollbackSvcVars: vars,
+		store:           configStore,
+		sel:             selector.NewDeploySelect(vars.prompt, configStore, deployStore),
+		spinner:         termprogress.NewSpinner(),
+		initClients: func(o *rollbackSvcOpts) error {
+			provider := sessions.NewProvider()
+			sess, err := provider.FromRole(o.targetEnv.ManagerRoleARN, o.targetEnv.Region)
+			if err != nil {
+				return fmt.Errorf("assuming environment manager role: %w", err)
+			}
+			o.svcCFN = cloudformation.New(sess)
+			o.taskDefGetter = ecs.New(sess)
+
+			// The revisions are stored in the application's bucket in the region of the environment.
+			defaultSessEnvRegion, err := provider.DefaultWithRegion(o.targetEnv.Region)
+			if err != nil {
+				return fmt.Errorf("create default session with region %s: %w", o.targetEnv.Region, err)
+			}
+			o.s3 = s3.New(defaultSessEnvRegion)
+			defaultSess, err := provider.Default()
+			if err != nil {
+				return fmt.Errorf("create default session: %w", err)
+			}
+			o.appCFN = cloudformation.New(defaultSess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *rollbackSvcOpts) Validate() error {
+	if o.AppName() != "" {
+		if _, err := o.store.GetApplication(o.AppName()); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.AppName(), o.svcName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.AppName(), o.envName); err != nil {
+			return err
+		}
+	}
+	if o.revision < 0 {
+		return fmt.Errorf("revision %d must be a positive number", o.revision)
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *rollbackSvcOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	if err := o.askSvcEnvName(); err != nil {
+		return err
+	}
+	return o.askRevision()
+}
+
+// Execute redeploys the template and parameters of the selected revision to the service stack.
+func (o *rollbackSvcOpts) Execute() error {
+	app, err := o.store.GetApplication(o.AppName())
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.AppName(), err)
+	}
+	resources, err := o.appCFN.GetAppResourcesByRegion(app, o.targetEnv.Region)
+	if err != nil {
+		return fmt.Errorf("get application %s resources from region %s: %w", app.Name, o.targetEnv.Region, err)
+	}
+	content, err := o.s3.Download(resources.S3Bucket, fmt.Sprintf(fmtSvcRevisionKey, o.AppName(), o.envName, o.svcName, o.revision))
+	if err != nil {
+		var errNotFound *s3.ErrObjectNotFound
+		if errors.As(err, &errNotFound) {
+			return fmt.Errorf("revision %d of service %s was deployed before its template and parameters were saved, so it can't be rolled back to", o.revision, o.svcName)
+		}
+		return fmt.Errorf("download revision %d of service %s: %w", o.revision, o.svcName, err)
+	}
+	var revision stack.SvcRevision
+	if err := json.Unmarshal(content, &revision); err != nil {
+		return fmt.Errorf("unmarshal revision %d of service %s: %w", o.revision, o.svcName, err)
+	}
+	conf := stack.NewSvcRollback(stack.NameForService(o.AppName(), o.envName, o.svcName), revision)
+
+	o.spinner.Start(fmt.Sprintf("Rolling back %s in %s to revision %d.",
+		color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName), o.revision))
+	if err := o.svcCFN.DeployService(conf, awscloudformation.WithRoleARN(o.targetEnv.ExecutionRoleARN)); err != nil {
+		o.spinner.Stop(log.Serrorf("Failed to roll back service.\n"))
+		return fmt.Errorf("deploy service: %w", err)
+	}
+	o.spinner.Stop(log.Ssuccessf("Rolled back service %s in environment %s to revision %d.\n",
+		color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName), o.revision))
+	if err := saveSvcRevision(o.svcCFN, o.s3, resources.S3Bucket, o.AppName(), o.envName, o.svcName, &revision); err != nil {
+		log.Warningf("Couldn't save the new revision of service %s, so it can't be rolled back to: %v\n", o.svcName, err)
+	}
+	return nil
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *rollbackSvcOpts) RecommendedActions() []string {
+	return []string{
+		fmt.Sprintf("Fix the issue in your workspace and run %s to deploy a new revision.",
+			color.HighlightCode(fmt.Sprintf("copilot svc deploy -n %s -e %s", o.svcName, o.envName))),
+	}
+}
+
+func (o *rollbackSvcOpts) askApp() error {
+	if o.AppName() != "" {
+		return nil
+	}
+	app, err := o.sel.Application(svcRollbackAppNamePrompt, svcRollbackAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *rollbackSvcOpts) askSvcEnvName() error {
+	deployedService, err := o.sel.DeployedService(svcRollbackNamePrompt, svcRollbackNameHelpPrompt, o.AppName(), selector.WithEnv(o.envName), selector.WithSvc(o.svcName))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.AppName(), err)
+	}
+	o.svcName = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+func (o *rollbackSvcOpts) askRevision() error {
+	env, err := o.store.GetEnvironment(o.AppName(), o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	o.targetEnv = env
+	if err := o.initClients(o); err != nil {
+		return err
+	}
+	revisions, err := o.svcCFN.ServiceRevisions(o.AppName(), o.envName, o.svcName)
+	if err != nil {
+		return fmt.Errorf("list revisions of service %s: %w", o.svcName, err)
+	}
+	if len(revisions) < 2 {
+		return errNoPreviousRevision
+	}
+	current, previous := revisions[0], revisions[1:]
+
+	if o.revision != 0 {
+		for _, rev := range previous {
+			if taskDefRevision(rev.TaskDefinition) == o.revision {
+				rev := rev
+				o.targetRevision = &rev
+				return nil
+			}
+		}
+		if taskDefRevision(current.TaskDefinition) == o.revision {
+			return fmt.Errorf("revision %d is already deployed", o.revision)
+		}
+		return fmt.Errorf("revision %d is not a previous successful deployment of service %s", o.revision, o.svcName)
+	}
+
+	var options []string
+	optionToRevision := make(map[string]deploy.ServiceRevision)
+	for _, rev := range previous {
+		td, err := o.taskDefGetter.TaskDefinition(rev.TaskDefinition)
+		if err != nil {
+			return err
+		}
+		image, err := td.ContainerImage(o.svcName)
+		if err != nil {
+			return err
+		}
+		option := fmt.Sprintf("%d (deployed %s) %s", taskDefRevision(rev.TaskDefinition), rev.DeployedAt.Format(time.RFC3339), image)
+		options = append(options, option)
+		optionToRevision[option] = rev
+	}
+	option, err := o.prompt.SelectOne(fmt.Sprintf(fmtSvcRollbackRevisionPrompt, color.HighlightUserInput(o.svcName)), svcRollbackRevisionHelpPrompt, options)
+	if err != nil {
+		return fmt.Errorf("select revision: %w", err)
+	}
+	rev := optionToRevision[option]
+	o.targetRevision = &rev
+	o.revision = taskDefRevision(rev.TaskDefinition)
+	return nil
+}
+
+// saveSvcRevision stores the template, parameters and tags of the revision that was just deployed to the service stack
+// in the application's bucket, so that a later rollback can redeploy them.
+func saveSvcRevision(lister svcRevisionLister, uploader artifactUploader, bucket, app, env, svc string, revision *stack.SvcRevision) error {
+	revisions, err := lister.ServiceRevisions(app, env, svc)
+	if err != nil {
+		return fmt.Errorf("list revisions of service %s: %w", svc, err)
+	}
+	if len(revisions) == 0 {
+		return fmt.Errorf("no revision of service %s is deployed", svc)
+	}
+	content, err := json.Marshal(revision)
+	if err != nil {
+		return fmt.Errorf("marshal revision of service %s: %w", svc, err)
+	}
+	key := fmt.Sprintf(fmtSvcRevisionKey, app, env, svc, taskDefRevision(revisions[0].TaskDefinition))
+	if _, err := uploader.Upload(bucket, key, bytes.NewReader(content)); err != nil {
+		return fmt.Errorf("upload revision of service %s: %w", svc, err)
+	}
+	return nil
+}
+
+// taskDefRevision returns the revision number of a task definition ARN, or 0 if the ARN has no revision.
+// For example: arn:aws:ecs:us-west-2:1234567890:task-definition/app-env-svc:3 returns 3.
+func taskDefRevision(taskDefARN string) int {
+	parts := strings.Split(taskDefARN, ":")
+	rev, err := strconv.Atoi(parts[len(parts)-1])
+	if err != nil {
+		return 0
+	}
+	return rev
+}
+
+// BuildSvcRollbackCmd builds the command for rolling back a service to a previous revision.
+func BuildSvcRollbackCmd() *cobra.Command {
+	vars := rollbackSvcVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Redeploys a previous successful revision of a service.",
+		Long: `Redeploys a previous successful revision of a service.
+The template and parameters that the revision was deployed with by "svc deploy" are redeployed.`,
+
+		Example: `
+  Select a previous revision of the service "my-svc" in the "test" environment to redeploy.
+  /code $ copilot svc rollback -n my-svc -e test
+  Redeploy revision 3 of the service "my-svc" in the "test" environment.
+  /code $ copilot svc rollback -n my-svc -e test --revision 3`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRollbackSvcOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			log.Infoln("Recommended follow-up actions:")
+			for _, followup := range opts.RecommendedActions() {
+				log.Infof("- %s\n", followup)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().IntVar(&vars.revision, revisionFlag, 0, revisionFlagDescription)
+	return cmd
+}
@@ -0,0 +1,417 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcRollbackMocks struct {
+	store         *mocks.Mockstore
+	sel           *mocks.MockdeploySelector
+	prompt        *mocks.Mockprompter
+	svcCFN        *mocks.MocksvcRollbacker
+	taskDefGetter *mocks.MocktaskDefinitionGetter
+	appCFN        *mocks.MockappResourcesGetter
+	s3            *mocks.MockartifactStore
+	spinner       *mocks.Mockprogress
+}
+
+func TestSvcRollback_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp      string
+		inputSvc      string
+		inputEnv      string
+		inputRevision int
+		setupMocks    func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"invalid service name": {
+			inputApp: "my-app",
+			inputSvc: "my-svc",
+
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetService("my-app", "my-svc").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"negative revision": {
+			inputApp:      "my-app",
+			inputRevision: -1,
+
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+
+			wantedError: errors.New("revision -1 must be a positive number"),
+		},
+		"success": {
+			inputApp:      "my-app",
+			inputSvc:      "my-svc",
+			inputEnv:      "test",
+			inputRevision: 3,
+
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetService("my-app", "my-svc").Return(&config.Service{Name: "my-svc"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+			opts := &rollbackSvcOpts{
+				rollbackSvcVars: rollbackSvcVars{
+					svcName:  tc.inputSvc,
+					envName:  tc.inputEnv,
+					revision: tc.inputRevision,
+					GlobalOpts: &GlobalOpts{
+						appName: tc.inputApp,
+					},
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcRollback_Ask(t *testing.T) {
+	mockErr := errors.New("some error")
+	deployedAt := time.Date(2020, 8, 1, 10, 0, 0, 0, time.UTC)
+	revisions := []deploy.ServiceRevision{
+		{
+			TaskDefinition: "arn:aws:ecs:us-west-2:1234567890:task-definition/my-app-test-my-svc:3",
+			DeployedAt:     deployedAt.Add(2 * time.Hour),
+		},
+		{
+			TaskDefinition: "arn:aws:ecs:us-west-2:1234567890:task-definition/my-app-test-my-svc:2",
+			DeployedAt:     deployedAt.Add(time.Hour),
+		},
+		{
+			TaskDefinition: "arn:aws:ecs:us-west-2:1234567890:task-definition/my-app-test-my-svc:1",
+			DeployedAt:     deployedAt,
+		},
+	}
+	taskDef := func(tag string) *ecs.TaskDefinition {
+		return &ecs.TaskDefinition{
+			ContainerDefinitions: []*sdkecs.ContainerDefinition{
+				{
+					Name:  aws.String("my-svc"),
+					Image: aws.String("my-svc:" + tag),
+				},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		inputApp      string
+		inputSvc      string
+		inputEnv      string
+		inputRevision int
+		setupMocks    func(m svcRollbackMocks)
+
+		wantedRevision       int
+		wantedTaskDefinition string
+		wantedError          error
+	}{
+		"errors if failed to select deployed service": {
+			inputApp: "my-app",
+
+			setupMocks: func(m svcRollbackMocks) {
+				m.sel.EXPECT().DeployedService(svcRollbackNamePrompt, svcRollbackNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(nil, mockErr)
+			},
+
+			wantedError: fmt.Errorf("select deployed services for application my-app: some error"),
+		},
+		"errors if there is no previous revision": {
+			inputApp: "my-app",
+			inputSvc: "my-svc",
+			inputEnv: "test",
+
+			setupMocks: func(m svcRollbackMocks) {
+				m.sel.EXPECT().DeployedService(svcRollbackNamePrompt, svcRollbackNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Env: "test", Svc: "my-svc"}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.svcCFN.EXPECT().ServiceRevisions("my-app", "test", "my-svc").Return(revisions[:1], nil)
+			},
+
+			wantedError: errNoPreviousRevision,
+		},
+		"errors if the revision flag is the current revision": {
+			inputApp:      "my-app",
+			inputSvc:      "my-svc",
+			inputEnv:      "test",
+			inputRevision: 3,
+
+			setupMocks: func(m svcRollbackMocks) {
+				m.sel.EXPECT().DeployedService(svcRollbackNamePrompt, svcRollbackNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Env: "test", Svc: "my-svc"}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.svcCFN.EXPECT().ServiceRevisions("my-app", "test", "my-svc").Return(revisions, nil)
+			},
+
+			wantedError: errors.New("revision 3 is already deployed"),
+		},
+		"errors if the revision flag is not a previous deployment": {
+			inputApp:      "my-app",
+			inputSvc:      "my-svc",
+			inputEnv:      "test",
+			inputRevision: 7,
+
+			setupMocks: func(m svcRollbackMocks) {
+				m.sel.EXPECT().DeployedService(svcRollbackNamePrompt, svcRollbackNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Env: "test", Svc: "my-svc"}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.svcCFN.EXPECT().ServiceRevisions("my-app", "test", "my-svc").Return(revisions, nil)
+			},
+
+			wantedError: errors.New("revision 7 is not a previous successful deployment of service my-svc"),
+		},
+		"uses the revision flag": {
+			inputApp:      "my-app",
+			inputSvc:      "my-svc",
+			inputEnv:      "test",
+			inputRevision: 1,
+
+			setupMocks: func(m svcRollbackMocks) {
+				m.sel.EXPECT().DeployedService(svcRollbackNamePrompt, svcRollbackNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Env: "test", Svc: "my-svc"}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.svcCFN.EXPECT().ServiceRevisions("my-app", "test", "my-svc").Return(revisions, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+
+			wantedRevision:       1,
+			wantedTaskDefinition: revisions[2].TaskDefinition,
+		},
+		"prompts for a previous revision": {
+			inputApp: "my-app",
+			inputSvc: "my-svc",
+			inputEnv: "test",
+
+			setupMocks: func(m svcRollbackMocks) {
+				m.sel.EXPECT().DeployedService(svcRollbackNamePrompt, svcRollbackNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Env: "test", Svc: "my-svc"}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.svcCFN.EXPECT().ServiceRevisions("my-app", "test", "my-svc").Return(revisions, nil)
+				m.taskDefGetter.EXPECT().TaskDefinition(revisions[1].TaskDefinition).Return(taskDef("v2"), nil)
+				m.taskDefGetter.EXPECT().TaskDefinition(revisions[2].TaskDefinition).Return(taskDef("v1"), nil)
+				m.prompt.EXPECT().SelectOne(fmt.Sprintf(fmtSvcRollbackRevisionPrompt, "my-svc"), svcRollbackRevisionHelpPrompt, []string{
+					"2 (deployed 2020-08-01T11:00:00Z) my-svc:v2",
+					"1 (deployed 2020-08-01T10:00:00Z) my-svc:v1",
+				}).Return("2 (deployed 2020-08-01T11:00:00Z) my-svc:v2", nil)
+			},
+
+			wantedRevision:       2,
+			wantedTaskDefinition: revisions[1].TaskDefinition,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := svcRollbackMocks{
+				store:         mocks.NewMockstore(ctrl),
+				sel:           mocks.NewMockdeploySelector(ctrl),
+				prompt:        mocks.NewMockprompter(ctrl),
+				svcCFN:        mocks.NewMocksvcRollbacker(ctrl),
+				taskDefGetter: mocks.NewMocktaskDefinitionGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &rollbackSvcOpts{
+				rollbackSvcVars: rollbackSvcVars{
+					svcName:  tc.inputSvc,
+					envName:  tc.inputEnv,
+					revision: tc.inputRevision,
+					GlobalOpts: &GlobalOpts{
+						appName: tc.inputApp,
+						prompt:  m.prompt,
+					},
+				},
+				store: m.store,
+				sel:   m.sel,
+				initClients: func(o *rollbackSvcOpts) error {
+					o.svcCFN = m.svcCFN
+					o.taskDefGetter = m.taskDefGetter
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedRevision, opts.revision)
+			require.Equal(t, tc.wantedTaskDefinition, opts.targetRevision.TaskDefinition)
+		})
+	}
+}
+
+func TestSvcRollback_Execute(t *testing.T) {
+	mockErr := errors.New("some error")
+	mockApp := &config.Application{Name: "my-app"}
+	const (
+		mockBucket      = "my-bucket"
+		mockRevisionKey = "revisions/my-app/test/my-svc/2.json"
+		mockRevision    = `{"template":"template","parameters":[{"ParameterKey":"ContainerImage","ParameterValue":"my-svc:v2"}],"tags":null}`
+	)
+	testCases := map[string]struct {
+		setupMocks func(m svcRollbackMocks)
+
+		wantedError error
+	}{
+		"errors if failed to get the application": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(nil, mockErr)
+			},
+
+			wantedError: fmt.Errorf("get application my-app: some error"),
+		},
+		"errors if the revision wasn't saved": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(mockApp, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: mockBucket}, nil)
+				m.s3.EXPECT().Download(mockBucket, mockRevisionKey).Return(nil, &s3.ErrObjectNotFound{Bucket: mockBucket, Key: mockRevisionKey})
+			},
+
+			wantedError: fmt.Errorf("revision 2 of service my-svc was deployed before its template and parameters were saved, so it can't be rolled back to"),
+		},
+		"errors if failed to download the revision": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(mockApp, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: mockBucket}, nil)
+				m.s3.EXPECT().Download(mockBucket, mockRevisionKey).Return(nil, mockErr)
+			},
+
+			wantedError: fmt.Errorf("download revision 2 of service my-svc: some error"),
+		},
+		"errors if failed to deploy": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(mockApp, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: mockBucket}, nil)
+				m.s3.EXPECT().Download(mockBucket, mockRevisionKey).Return([]byte(mockRevision), nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.svcCFN.EXPECT().DeployService(gomock.Any(), gomock.Any()).Return(mockErr)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+
+			wantedError: fmt.Errorf("deploy service: some error"),
+		},
+		"redeploys the template and parameters of the revision and saves them as the new revision": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(mockApp, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: mockBucket}, nil)
+				m.s3.EXPECT().Download(mockBucket, mockRevisionKey).Return([]byte(mockRevision), nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.svcCFN.EXPECT().DeployService(gomock.Any(), gomock.Any()).DoAndReturn(func(conf deploycfn.StackConfiguration, _ ...awscloudformation.StackOption) error {
+					tpl, err := conf.Template()
+					require.NoError(t, err)
+					require.Equal(t, "template", tpl)
+					params, err := conf.Parameters()
+					require.NoError(t, err)
+					require.Equal(t, "my-svc:v2", aws.StringValue(params[0].ParameterValue))
+					require.Equal(t, "my-app-test-my-svc", conf.StackName())
+					return nil
+				})
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.svcCFN.EXPECT().ServiceRevisions("my-app", "test", "my-svc").Return([]deploy.ServiceRevision{
+					{TaskDefinition: "arn:aws:ecs:us-west-2:1234567890:task-definition/my-app-test-my-svc:4"},
+				}, nil)
+				m.s3.EXPECT().Upload(mockBucket, "revisions/my-app/test/my-svc/4.json", gomock.Any()).Return("", nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := svcRollbackMocks{
+				store:   mocks.NewMockstore(ctrl),
+				svcCFN:  mocks.NewMocksvcRollbacker(ctrl),
+				appCFN:  mocks.NewMockappResourcesGetter(ctrl),
+				s3:      mocks.NewMockartifactStore(ctrl),
+				spinner: mocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &rollbackSvcOpts{
+				rollbackSvcVars: rollbackSvcVars{
+					svcName:  "my-svc",
+					envName:  "test",
+					revision: 2,
+					GlobalOpts: &GlobalOpts{
+						appName: "my-app",
+					},
+				},
+				store:   m.store,
+				spinner: m.spinner,
+				svcCFN:  m.svcCFN,
+				appCFN:  m.appCFN,
+				s3:      m.s3,
+				targetEnv: &config.Environment{
+					Name:             "test",
+					Region:           "us-west-2",
+					ExecutionRoleARN: "execution-role",
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,526 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/docker"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcRunLocalNamePrompt    = "Which service would you like to run locally?"
+	svcRunLocalEnvPrompt     = "Which environment's configuration would you like to run the service with?"
+	svcRunLocalEnvHelpPrompt = "The service runs with the variables, secrets and addons outputs of its deployment in the environment."
+
+	localImageTag             = "local"
+	fmtLocalNetworkName       = "copilot-%s-%s" // Network of an application's environment, such as "copilot-phonetool-test".
+	fmtLocalContainerName     = "%s-%s-%s"      // Container of a service, such as "phonetool-test-frontend".
+	fmtLocalSidecarName       = "%s-%s"         // Sidecar container of a service, such as "phonetool-test-frontend-nginx".
+	fmtServiceDiscoveryAlias  = "%s.%s.local"   // Service discovery name of a service, such as "frontend.phonetool.local".
+	fmtServiceDiscoveryDomain = "%s.local"      // Service discovery namespace of an application, such as "phonetool.local".
+	sidecarNetworkPrefix      = "container:"    // Sidecars share the network stack of the service container, like in a Fargate task.
+)
+
+type runLocalSvcVars struct {
+	*GlobalOpts
+	name    string
+	envName string
+}
+
+type runLocalSvcOpts struct {
+	runLocalSvcVars
+
+	store     store
+	ws        wsSvcDirReader
+	fs        afero.Fs
+	sel       wsSelector
+	unmarshal func(in []byte, opts ...manifest.UnmarshalOption) (interface{}, error)
+
+	docker      localContainerRunner
+	addons      templater
+	addonsOuts  addonsOutputsGetter
+	params      parameterValueGetter
+	secrets     secretValueGetter
+	initClients func(*runLocalSvcOpts) error // Overriden in tests.
+}
+
+// localSvcConfig holds the configuration of a service's containers with the environment overrides applied.
+type localSvcConfig struct {
+	buildArgs *manifest.DockerBuildArgs
+	location  *string
+	port      *uint16
+	task      manifest.TaskConfig
+	sidecars  map[string]*manifest.SidecarConfig
+}
+
+func newRunLocalSvcOpts(vars runLocalSvcVars) (*runLocalSvcOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	return &runLocalSvcOpts{
+		runLocalSvcVars: vars,
+		store:           store,
+		ws:              ws,
+		fs:              afero.NewOsFs(),
+		sel:             selector.NewWorkspaceSelect(vars.prompt, store, ws),
+		unmarshal:       manifest.UnmarshalService,
+		docker:          docker.New(),
+		initClients: func(o *runLocalSvcOpts) error {
+			env, err := o.store.GetEnvironment(o.AppName(), o.envName)
+			if err != nil {
+				return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+			}
+			sessProvider := sessions.NewProvider()
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("assuming environment manager role: %w", err)
+			}
+			// The environment manager role can only read the secrets tagged with the application and environment,
+			// while manifests can reference any secret in AWS Secrets Manager.
+			defaultSess, err := sessProvider.DefaultWithRegion(env.Region)
+			if err != nil {
+				return fmt.Errorf("create default session with region %s: %w", env.Region, err)
+			}
+			addons, err := addon.New(o.name)
+			if err != nil {
+				return fmt.Errorf("initiate addons service: %w", err)
+			}
+			d, err := describe.NewServiceDescriber(describe.NewServiceConfig{
+				App:         o.AppName(),
+				Env:         o.envName,
+				Svc:         o.name,
+				ConfigStore: o.store,
+			})
+			if err != nil {
+				return fmt.Errorf("create describer for service %s: %w", o.name, err)
+			}
+			o.addons = addons
+			o.addonsOuts = d
+			o.params = ssm.New(sess)
+			o.secrets = secretsmanager.NewWithSession(defaultSess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *runLocalSvcOpts) Validate() error {
+	if o.AppName() == "" {
+		return errNoAppInWorkspace
+	}
+	if o.name != "" {
+		if err := o.validateSvcName(); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.AppName(), o.envName); err != nil {
+			return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+		}
+	}
+	return nil
+}
+
+// Ask prompts the user for any required fields that are not provided.
+func (o *runLocalSvcOpts) Ask() error {
+	if o.name == "" {
+		name, err := o.sel.Service(svcRunLocalNamePrompt, "")
+		if err != nil {
+			return fmt.Errorf("select service: %w", err)
+		}
+		o.name = name
+	}
+	if o.envName == "" {
+		env, err := o.sel.Environment(svcRunLocalEnvPrompt, svcRunLocalEnvHelpPrompt, o.AppName())
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.envName = env
+	}
+	return nil
+}
+
+// Execute builds the service's image and runs its containers locally with the configuration of the environment,
+// until the service container stops or the user interrupts it.
+func (o *runLocalSvcOpts) Execute() (err error) {
+	if err := o.initClients(o); err != nil {
+		return err
+	}
+	conf, err := o.localConfig()
+	if err != nil {
+		return err
+	}
+	envVars, err := o.envVars(conf.task)
+	if err != nil {
+		return err
+	}
+	image, err := o.image(conf)
+	if err != nil {
+		return err
+	}
+	network := fmt.Sprintf(fmtLocalNetworkName, o.AppName(), o.envName)
+	if err := o.docker.CreateNetwork(network); err != nil {
+		return err
+	}
+
+	svcContainer := fmt.Sprintf(fmtLocalContainerName, o.AppName(), o.envName, o.name)
+	if err := o.docker.RunContainer(&docker.RunArguments{
+		Name:    svcContainer,
+		Image:   image,
+		Network: network,
+		Aliases: []string{fmt.Sprintf(fmtServiceDiscoveryAlias, o.name, o.AppName()), o.name},
+		Ports:   conf.ports(),
+		EnvVars: envVars,
+	}); err != nil {
+		return err
+	}
+	containers := []string{svcContainer}
+	defer func() {
+		if rmErr := o.docker.RemoveContainers(containers...); rmErr != nil && err == nil {
+			err = rmErr
+		}
+	}()
+	for _, name := range conf.sidecarNames() {
+		sidecarContainer := fmt.Sprintf(fmtLocalSidecarName, svcContainer, name)
+		sidecarVars, err := o.sidecarEnvVars(conf.sidecars[name])
+		if err != nil {
+			return fmt.Errorf("sidecar %s: %w", name, err)
+		}
+		if err := o.docker.RunContainer(&docker.RunArguments{
+			Name:    sidecarContainer,
+			Image:   aws.StringValue(conf.sidecars[name].Image),
+			Network: sidecarNetworkPrefix + svcContainer,
+			EnvVars: sidecarVars,
+		}); err != nil {
+			return err
+		}
+		containers = append(containers, sidecarContainer)
+	}
+
+	log.Successf("Running service %s locally with the configuration of environment %s, press Ctrl-C to stop it.\n",
+		color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName))
+	// The interrupt also stops following the logs, after which the containers are removed.
+	interrupted := make(chan os.Signal, 1)
+	signal.Notify(interrupted, os.Interrupt)
+	defer signal.Stop(interrupted)
+	if err := o.docker.FollowLogs(svcContainer); err != nil {
+		select {
+		case <-interrupted:
+			return nil
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *runLocalSvcOpts) validateSvcName() error {
+	names, err := o.ws.ServiceNames()
+	if err != nil {
+		return fmt.Errorf("list services in the workspace: %w", err)
+	}
+	for _, name := range names {
+		if o.name == name {
+			return nil
+		}
+	}
+	return fmt.Errorf("service %s not found in the workspace", color.HighlightUserInput(o.name))
+}
+
+// localConfig reads the service's manifest and returns its configuration with the environment overrides applied.
+func (o *runLocalSvcOpts) localConfig() (*localSvcConfig, error) {
+	raw, err := o.ws.ReadServiceManifest(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("read service %s manifest from workspace: %w", o.name, err)
+	}
+	opts, err := svcManifestOpts(o.ws, o.AppName(), o.envName)
+	if err != nil {
+		return nil, err
+	}
+	mft, err := o.unmarshal(raw, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal service %s manifest: %w", o.name, err)
+	}
+	copilotDir, err := o.ws.CopilotDirPath()
+	if err != nil {
+		return nil, fmt.Errorf("get copilot directory: %w", err)
+	}
+	wsRoot := filepath.Dir(copilotDir)
+
+	w, ok := mft.(envWorkload)
+	if !ok {
+		return nil, fmt.Errorf("unknown manifest type %T to run locally", mft)
+	}
+	conf, err := w.EnvWorkload(o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("apply environment %s override: %w", o.envName, err)
+	}
+	return &localSvcConfig{
+		buildArgs: conf.Image.BuildConfig(wsRoot),
+		location:  conf.Image.Location,
+		port:      conf.Port,
+		task:      conf.Task,
+		sidecars:  conf.Sidecars,
+	}, nil
+}
+
+// image returns the image to run for the service container.
+// Services that deploy an existing image run it as is, otherwise the image is built locally.
+func (o *runLocalSvcOpts) image(conf *localSvcConfig) (string, error) {
+	if conf.location != nil {
+		return aws.StringValue(conf.location), nil
+	}
+	repo := fmt.Sprintf("%s/%s", o.AppName(), o.name)
+	if err := o.docker.Build(&docker.BuildArguments{
+		URI:        repo,
+		ImageTag:   localImageTag,
+		Dockerfile: aws.StringValue(conf.buildArgs.Dockerfile),
+		Context:    aws.StringValue(conf.buildArgs.Context),
+		Args:       conf.buildArgs.Args,
+	}); err != nil {
+		return "", fmt.Errorf("build image for service %s: %w", o.name, err)
+	}
+	return fmt.Sprintf("%s:%s", repo, localImageTag), nil
+}
+
+// envVars returns the environment variables that the service container has when it's deployed to the environment,
+// with the values of its secrets resolved.
+func (o *runLocalSvcOpts) envVars(task manifest.TaskConfig) (map[string]string, error) {
+	vars := map[string]string{
+		"COPILOT_APPLICATION_NAME":           o.AppName(),
+		"COPILOT_SERVICE_DISCOVERY_ENDPOINT": fmt.Sprintf(fmtServiceDiscoveryDomain, o.AppName()),
+		"COPILOT_ENVIRONMENT_NAME":           o.envName,
+		"COPILOT_SERVICE_NAME":               o.name,
+	}
+	if path := aws.StringValue(task.EnvFile); path != "" {
+		content, err := readEnvFile(o.ws, o.fs, path)
+		if err != nil {
+			return nil, err
+		}
+		fileVars, err := parseEnvFile(content)
+		if err != nil {
+			return nil, fmt.Errorf("parse environment file %s: %w", path, err)
+		}
+		// Like in ECS, the variables in the manifest take precedence over the ones in the file.
+		for name, value := range fileVars {
+			vars[name] = value
+		}
+	}
+	for name, value := range task.Variables {
+		vars[name] = value
+	}
+	for name, secret := range task.Secrets {
+		value, err := o.secretValue(secret)
+		if err != nil {
+			return nil, fmt.Errorf("get value of secret %s: %w", name, err)
+		}
+		vars[name] = value
+	}
+	addonsVars, err := o.addonsEnvVars()
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range addonsVars {
+		vars[name] = value
+	}
+	return vars, nil
+}
+
+// parseEnvFile returns the variables of an environment file following the format that ECS supports:
+// one "VARIABLE=VALUE" per line, where blank lines and lines starting with "#" are ignored.
+func parseEnvFile(content []byte) (map[string]string, error) {
+	vars := make(map[string]string)
+	for i, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf(`line %d must be in the format "VARIABLE=VALUE"`, i+1)
+		}
+		vars[parts[0]] = parts[1]
+	}
+	return vars, nil
+}
+
+// secretValue returns the value of a secret stored as an SSM parameter or in AWS Secrets Manager.
+// If the secret specifies a JSON key, only the value of the key is returned.
+func (o *runLocalSvcOpts) secretValue(secret manifest.Secret) (string, error) {
+	if !secret.IsSecretsManager() {
+		return o.params.ParameterValue(secret.Value())
+	}
+	id, jsonKey, versionStage, versionID := secret.SecretsManagerID()
+	if versionStage != "" || versionID != "" {
+		return "", fmt.Errorf("retrieve secret %s: version stages and version IDs are not supported when running locally", id)
+	}
+	value, err := o.secrets.SecretValue(id)
+	if err != nil {
+		return "", err
+	}
+	if jsonKey == "" {
+		return value, nil
+	}
+	var keys map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &keys); err != nil {
+		return "", fmt.Errorf("unmarshal secret %s as JSON: %w", id, err)
+	}
+	keyValue, ok := keys[jsonKey]
+	if !ok {
+		return "", fmt.Errorf("key %s does not exist in secret %s", jsonKey, id)
+	}
+	if str, ok := keyValue.(string); ok {
+		return str, nil
+	}
+	raw, err := json.Marshal(keyValue)
+	if err != nil {
+		return "", fmt.Errorf("marshal key %s of secret %s: %w", jsonKey, id, err)
+	}
+	return string(raw), nil
+}
+
+// sidecarEnvVars returns the environment variables of a sidecar container with the values of its secrets resolved.
+func (o *runLocalSvcOpts) sidecarEnvVars(sidecar *manifest.SidecarConfig) (map[string]string, error) {
+	if len(sidecar.Variables) == 0 && len(sidecar.Secrets) == 0 {
+		return nil, nil
+	}
+	vars := make(map[string]string)
+	for name, value := range sidecar.Variables {
+		vars[name] = value
+	}
+	for name, secret := range sidecar.Secrets {
+		value, err := o.secretValue(secret)
+		if err != nil {
+			return nil, fmt.Errorf("get value of secret %s: %w", name, err)
+		}
+		vars[name] = value
+	}
+	return vars, nil
+}
+
+// addonsEnvVars returns the environment variables injected from the outputs of the service's addons.
+// If the service doesn't have any addons, it returns an empty map and no errors.
+func (o *runLocalSvcOpts) addonsEnvVars() (map[string]string, error) {
+	vars := make(map[string]string)
+	tpl, err := o.addons.Template()
+	if err != nil {
+		var notExistErr *addon.ErrDirNotExist
+		if errors.As(err, &notExistErr) {
+			return vars, nil
+		}
+		return nil, fmt.Errorf("retrieve addons template: %w", err)
+	}
+	outputs, err := addon.Outputs(tpl)
+	if err != nil {
+		return nil, err
+	}
+	deployed, err := o.addonsOuts.AddonsOutputs()
+	if err != nil {
+		return nil, fmt.Errorf("get addons outputs of service %s in environment %s: %w", o.name, o.envName, err)
+	}
+	for _, out := range outputs {
+		if out.IsManagedPolicy {
+			continue
+		}
+		value, ok := deployed[out.Name]
+		if !ok {
+			log.Warningf("Addons output %s is not deployed to environment %s yet, skipping it.\n", out.Name, o.envName)
+			continue
+		}
+		if out.IsSecret {
+			value, err = o.secrets.SecretValue(value)
+			if err != nil {
+				return nil, fmt.Errorf("get value of addons output %s: %w", out.Name, err)
+			}
+		}
+		vars[template.ToSnakeCaseFunc(out.Name)] = value
+	}
+	return vars, nil
+}
+
+// ports returns the ports of the service and its sidecars to publish to the host.
+func (c *localSvcConfig) ports() []string {
+	var ports []string
+	if c.port != nil {
+		ports = append(ports, fmt.Sprintf("%d", aws.Uint16Value(c.port)))
+	}
+	for _, name := range c.sidecarNames() {
+		if port := c.sidecars[name].Port; port != nil {
+			ports = append(ports, aws.StringValue(port))
+		}
+	}
+	return ports
+}
+
+func (c *localSvcConfig) sidecarNames() []string {
+	var names []string
+	for name := range c.sidecars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// BuildSvcRunLocalCmd builds the command for running a service locally with the configuration of an environment.
+func BuildSvcRunLocalCmd() *cobra.Command {
+	vars := runLocalSvcVars{
+		GlobalOpts: NewGlobalOpts(),
+	}
+	cmd := &cobra.Command{
+		Use:   "run-local",
+		Short: "Run a service locally with the configuration of an environment.",
+		Long: `Run a service locally with the configuration of an environment.
+The service's image is built and started together with its sidecars on a local Docker network,
+with the variables, secrets and addons outputs that it has in the environment.
+Secrets in AWS Secrets Manager are read with your default credentials.`,
+
+		Example: `
+  Run the service "frontend" locally with the configuration of the "test" environment.
+  /code $ copilot svc run-local -n frontend -e test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRunLocalSvcOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	return cmd
+}
@@ -0,0 +1,486 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/docker"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+type runLocalSvcMocks struct {
+	ws         *mocks.MockwsSvcDirReader
+	docker     *mocks.MocklocalContainerRunner
+	addons     *mocks.Mocktemplater
+	addonsOuts *mocks.MockaddonsOutputsGetter
+	params     *mocks.MockparameterValueGetter
+	secrets    *mocks.MocksecretValueGetter
+}
+
+func TestRunLocalSvcOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		inSvcName  string
+		setupMocks func(m *mocks.MockwsSvcDirReader)
+
+		wantedError error
+	}{
+		"errors if not in a workspace with an application": {
+			setupMocks: func(m *mocks.MockwsSvcDirReader) {},
+
+			wantedError: errNoAppInWorkspace,
+		},
+		"errors if the service is not in the workspace": {
+			inAppName: "phonetool",
+			inSvcName: "frontend",
+			setupMocks: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ServiceNames().Return([]string{"backend"}, nil)
+			},
+
+			wantedError: errors.New("service frontend not found in the workspace"),
+		},
+		"success": {
+			inAppName: "phonetool",
+			inSvcName: "frontend",
+			setupMocks: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ServiceNames().Return([]string{"frontend"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWs := mocks.NewMockwsSvcDirReader(ctrl)
+			tc.setupMocks(mockWs)
+			opts := &runLocalSvcOpts{
+				runLocalSvcVars: runLocalSvcVars{
+					GlobalOpts: &GlobalOpts{
+						appName: tc.inAppName,
+					},
+					name: tc.inSvcName,
+				},
+				ws: mockWs,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRunLocalSvcOpts_Execute(t *testing.T) {
+	const mockAddonsTemplate = `Resources:
+  MyTable:
+    Type: AWS::DynamoDB::Table
+  MyDBSecret:
+    Type: AWS::SecretsManager::Secret
+  MyTablePolicy:
+    Type: AWS::IAM::ManagedPolicy
+Outputs:
+  MyTableName:
+    Value: !Ref MyTable
+  MyDBSecret:
+    Value: !Ref MyDBSecret
+  MyTablePolicy:
+    Value: !Ref MyTablePolicy`
+	mockError := errors.New("some error")
+	mockManifest := &manifest.LoadBalancedWebService{
+		LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+			Image: manifest.ServiceImageWithPort{
+				ServiceImage: manifest.ServiceImage{
+					Build: manifest.BuildArgsOrString{
+						BuildString: aws.String("frontend/Dockerfile"),
+					},
+				},
+				Port: aws.Uint16(8080),
+			},
+			TaskConfig: manifest.TaskConfig{
+				Variables: map[string]string{
+					"LOG_LEVEL": "DEBUG",
+				},
+				Secrets: map[string]manifest.Secret{
+					"GITHUB_TOKEN": {From: aws.String("GH_TOKEN_SECRET")},
+				},
+			},
+			Sidecar: manifest.Sidecar{
+				Sidecars: map[string]*manifest.SidecarConfig{
+					"xray": {
+						Image: aws.String("amazon/aws-xray-daemon"),
+						Variables: map[string]string{
+							"AWS_REGION": "us-west-2",
+						},
+						Port: aws.String("2000/udp"),
+					},
+				},
+			},
+		},
+		Environments: map[string]*manifest.LoadBalancedWebServiceConfig{
+			"test": {
+				TaskConfig: manifest.TaskConfig{
+					Variables: map[string]string{
+						"LOG_LEVEL": "INFO",
+					},
+				},
+			},
+		},
+	}
+	mockBuildArgs := &docker.BuildArguments{
+		URI:        "phonetool/frontend",
+		ImageTag:   "local",
+		Dockerfile: "/ws/frontend/Dockerfile",
+		Context:    "/ws/frontend",
+	}
+	wantedEnvVars := map[string]string{
+		"COPILOT_APPLICATION_NAME":           "phonetool",
+		"COPILOT_SERVICE_DISCOVERY_ENDPOINT": "phonetool.local",
+		"COPILOT_ENVIRONMENT_NAME":           "test",
+		"COPILOT_SERVICE_NAME":               "frontend",
+		"LOG_LEVEL":                          "INFO",
+		"GITHUB_TOKEN":                       "gh-s3cr3t",
+		"MY_TABLE_NAME":                      "phonetool-test-frontend-MyTable",
+		"MY_DB_SECRET":                       `{"password":"db-s3cr3t"}`,
+	}
+	withManifest := func(m runLocalSvcMocks) {
+		m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte("frontend"), nil)
+		m.ws.EXPECT().ReadServiceDefaults().Return(nil, nil)
+		m.ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+	}
+	withEnvVars := func(m runLocalSvcMocks) {
+		m.params.EXPECT().ParameterValue("GH_TOKEN_SECRET").Return("gh-s3cr3t", nil)
+		m.addons.EXPECT().Template().Return(mockAddonsTemplate, nil)
+		m.addonsOuts.EXPECT().AddonsOutputs().Return(map[string]string{
+			"MyTableName":   "phonetool-test-frontend-MyTable",
+			"MyDBSecret":    "arn:aws:secretsmanager:us-west-2:123456789012:secret:MyDBSecret",
+			"MyTablePolicy": "arn:aws:iam::123456789012:policy/MyTablePolicy",
+		}, nil)
+		m.secrets.EXPECT().SecretValue("arn:aws:secretsmanager:us-west-2:123456789012:secret:MyDBSecret").Return(`{"password":"db-s3cr3t"}`, nil)
+	}
+	testCases := map[string]struct {
+		setupMocks func(m runLocalSvcMocks)
+
+		wantedError error
+	}{
+		"errors if failed to get the value of a secret": {
+			setupMocks: func(m runLocalSvcMocks) {
+				withManifest(m)
+				m.params.EXPECT().ParameterValue("GH_TOKEN_SECRET").Return("", mockError)
+			},
+
+			wantedError: fmt.Errorf("get value of secret GITHUB_TOKEN: some error"),
+		},
+		"errors if failed to get the addons outputs": {
+			setupMocks: func(m runLocalSvcMocks) {
+				withManifest(m)
+				m.params.EXPECT().ParameterValue("GH_TOKEN_SECRET").Return("gh-s3cr3t", nil)
+				m.addons.EXPECT().Template().Return(mockAddonsTemplate, nil)
+				m.addonsOuts.EXPECT().AddonsOutputs().Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("get addons outputs of service frontend in environment test: some error"),
+		},
+		"errors if failed to build the image": {
+			setupMocks: func(m runLocalSvcMocks) {
+				withManifest(m)
+				withEnvVars(m)
+				m.docker.EXPECT().Build(mockBuildArgs).Return(mockError)
+			},
+
+			wantedError: fmt.Errorf("build image for service frontend: some error"),
+		},
+		"removes the service container if a sidecar fails to start": {
+			setupMocks: func(m runLocalSvcMocks) {
+				withManifest(m)
+				withEnvVars(m)
+				m.docker.EXPECT().Build(mockBuildArgs).Return(nil)
+				m.docker.EXPECT().CreateNetwork("copilot-phonetool-test").Return(nil)
+				m.docker.EXPECT().RunContainer(gomock.Any()).Return(nil)
+				m.docker.EXPECT().RunContainer(gomock.Any()).Return(mockError)
+				m.docker.EXPECT().RemoveContainers("phonetool-test-frontend").Return(nil)
+			},
+
+			wantedError: mockError,
+		},
+		"runs the service with its sidecars without addons": {
+			setupMocks: func(m runLocalSvcMocks) {
+				withManifest(m)
+				m.params.EXPECT().ParameterValue("GH_TOKEN_SECRET").Return("gh-s3cr3t", nil)
+				m.addons.EXPECT().Template().Return("", &addon.ErrDirNotExist{})
+				gomock.InOrder(
+					m.docker.EXPECT().Build(mockBuildArgs).Return(nil),
+					m.docker.EXPECT().CreateNetwork("copilot-phonetool-test").Return(nil),
+					m.docker.EXPECT().RunContainer(&docker.RunArguments{
+						Name:    "phonetool-test-frontend",
+						Image:   "phonetool/frontend:local",
+						Network: "copilot-phonetool-test",
+						Aliases: []string{"frontend.phonetool.local", "frontend"},
+						Ports:   []string{"8080", "2000/udp"},
+						EnvVars: map[string]string{
+							"COPILOT_APPLICATION_NAME":           "phonetool",
+							"COPILOT_SERVICE_DISCOVERY_ENDPOINT": "phonetool.local",
+							"COPILOT_ENVIRONMENT_NAME":           "test",
+							"COPILOT_SERVICE_NAME":               "frontend",
+							"LOG_LEVEL":                          "INFO",
+							"GITHUB_TOKEN":                       "gh-s3cr3t",
+						},
+					}).Return(nil),
+					m.docker.EXPECT().RunContainer(&docker.RunArguments{
+						Name:    "phonetool-test-frontend-xray",
+						Image:   "amazon/aws-xray-daemon",
+						Network: "container:phonetool-test-frontend",
+						EnvVars: map[string]string{
+							"AWS_REGION": "us-west-2",
+						},
+					}).Return(nil),
+					m.docker.EXPECT().FollowLogs("phonetool-test-frontend").Return(nil),
+					m.docker.EXPECT().RemoveContainers("phonetool-test-frontend", "phonetool-test-frontend-xray").Return(nil),
+				)
+			},
+		},
+		"runs the service with the addons outputs": {
+			setupMocks: func(m runLocalSvcMocks) {
+				withManifest(m)
+				withEnvVars(m)
+				m.docker.EXPECT().Build(mockBuildArgs).Return(nil)
+				m.docker.EXPECT().CreateNetwork("copilot-phonetool-test").Return(nil)
+				m.docker.EXPECT().RunContainer(&docker.RunArguments{
+					Name:    "phonetool-test-frontend",
+					Image:   "phonetool/frontend:local",
+					Network: "copilot-phonetool-test",
+					Aliases: []string{"frontend.phonetool.local", "frontend"},
+					Ports:   []string{"8080", "2000/udp"},
+					EnvVars: wantedEnvVars,
+				}).Return(nil)
+				m.docker.EXPECT().RunContainer(gomock.Any()).Return(nil)
+				m.docker.EXPECT().FollowLogs("phonetool-test-frontend").Return(nil)
+				m.docker.EXPECT().RemoveContainers("phonetool-test-frontend", "phonetool-test-frontend-xray").Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := runLocalSvcMocks{
+				ws:         mocks.NewMockwsSvcDirReader(ctrl),
+				docker:     mocks.NewMocklocalContainerRunner(ctrl),
+				addons:     mocks.NewMocktemplater(ctrl),
+				addonsOuts: mocks.NewMockaddonsOutputsGetter(ctrl),
+				params:     mocks.NewMockparameterValueGetter(ctrl),
+				secrets:    mocks.NewMocksecretValueGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &runLocalSvcOpts{
+				runLocalSvcVars: runLocalSvcVars{
+					GlobalOpts: &GlobalOpts{
+						appName: "phonetool",
+					},
+					name:    "frontend",
+					envName: "test",
+				},
+				ws: m.ws,
+				unmarshal: func(in []byte, opts ...manifest.UnmarshalOption) (interface{}, error) {
+					return mockManifest, nil
+				},
+				docker: m.docker,
+				initClients: func(o *runLocalSvcOpts) error {
+					o.addons = m.addons
+					o.addonsOuts = m.addonsOuts
+					o.params = m.params
+					o.secrets = m.secrets
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRunLocalSvcOpts_envVars(t *testing.T) {
+	testCases := map[string]struct {
+		inTask manifest.TaskConfig
+
+		wantedVars  map[string]string
+		wantedError error
+	}{
+		"loads the variables of the environment file": {
+			inTask: manifest.TaskConfig{
+				EnvFile: aws.String("frontend.env"),
+				Variables: map[string]string{
+					"LOG_LEVEL": "INFO",
+				},
+			},
+
+			wantedVars: map[string]string{
+				"COPILOT_APPLICATION_NAME":           "phonetool",
+				"COPILOT_SERVICE_DISCOVERY_ENDPOINT": "phonetool.local",
+				"COPILOT_ENVIRONMENT_NAME":           "test",
+				"COPILOT_SERVICE_NAME":               "frontend",
+				"LOG_LEVEL":                          "INFO",
+				"GREETING":                           "hello = world",
+			},
+		},
+		"errors if the environment file does not exist": {
+			inTask: manifest.TaskConfig{
+				EnvFile: aws.String("missing.env"),
+			},
+
+			wantedError: fmt.Errorf("read environment file missing.env: open %s: file does not exist", filepath.FromSlash("/ws/missing.env")),
+		},
+		"errors if a line of the environment file is malformed": {
+			inTask: manifest.TaskConfig{
+				EnvFile: aws.String("bad.env"),
+			},
+
+			wantedError: errors.New(`parse environment file bad.env: line 2 must be in the format "VARIABLE=VALUE"`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockWs := mocks.NewMockwsSvcDirReader(ctrl)
+			mockWs.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+			mockAddons := mocks.NewMocktemplater(ctrl)
+			mockAddons.EXPECT().Template().Return("", &addon.ErrDirNotExist{}).AnyTimes()
+
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "/ws/frontend.env", []byte("# Overridden by the manifest.\nLOG_LEVEL=DEBUG\n\nGREETING=hello = world\n"), 0644))
+			require.NoError(t, afero.WriteFile(fs, "/ws/bad.env", []byte("LOG_LEVEL=DEBUG\nGREETING\n"), 0644))
+
+			opts := &runLocalSvcOpts{
+				runLocalSvcVars: runLocalSvcVars{
+					GlobalOpts: &GlobalOpts{
+						appName: "phonetool",
+					},
+					name:    "frontend",
+					envName: "test",
+				},
+				ws:     mockWs,
+				fs:     fs,
+				addons: mockAddons,
+			}
+
+			// WHEN
+			vars, err := opts.envVars(tc.inTask)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedVars, vars)
+		})
+	}
+}
+
+func TestRunLocalSvcOpts_secretValue(t *testing.T) {
+	testCases := map[string]struct {
+		in         manifest.Secret
+		setupMocks func(m runLocalSvcMocks)
+
+		wanted      string
+		wantedError error
+	}{
+		"returns the value of an SSM parameter": {
+			in: manifest.Secret{From: aws.String("GH_TOKEN_SECRET")},
+			setupMocks: func(m runLocalSvcMocks) {
+				m.params.EXPECT().ParameterValue("GH_TOKEN_SECRET").Return("gh-s3cr3t", nil)
+			},
+
+			wanted: "gh-s3cr3t",
+		},
+		"returns the value of a Secrets Manager secret": {
+			in: manifest.Secret{From: aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:db-AbCdEf")},
+			setupMocks: func(m runLocalSvcMocks) {
+				m.secrets.EXPECT().SecretValue("arn:aws:secretsmanager:us-west-2:123456789012:secret:db-AbCdEf").Return(`{"password":"db-s3cr3t"}`, nil)
+			},
+
+			wanted: `{"password":"db-s3cr3t"}`,
+		},
+		"returns the value of a JSON key": {
+			in: manifest.Secret{FromSecretsManager: manifest.SecretsManagerSecret{Name: aws.String("db:password::")}},
+			setupMocks: func(m runLocalSvcMocks) {
+				m.secrets.EXPECT().SecretValue("db").Return(`{"password":"db-s3cr3t","port":5432}`, nil)
+			},
+
+			wanted: "db-s3cr3t",
+		},
+		"errors if the JSON key does not exist": {
+			in: manifest.Secret{FromSecretsManager: manifest.SecretsManagerSecret{Name: aws.String("db:username")}},
+			setupMocks: func(m runLocalSvcMocks) {
+				m.secrets.EXPECT().SecretValue("db").Return(`{"password":"db-s3cr3t"}`, nil)
+			},
+
+			wantedError: errors.New("key username does not exist in secret db"),
+		},
+		"errors if a version stage is specified": {
+			in:         manifest.Secret{FromSecretsManager: manifest.SecretsManagerSecret{Name: aws.String("db:password:AWSPREVIOUS")}},
+			setupMocks: func(m runLocalSvcMocks) {},
+
+			wantedError: errors.New("retrieve secret db: version stages and version IDs are not supported when running locally"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := runLocalSvcMocks{
+				params:  mocks.NewMockparameterValueGetter(ctrl),
+				secrets: mocks.NewMocksecretValueGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &runLocalSvcOpts{
+				params:  m.params,
+				secrets: m.secrets,
+			}
+
+			// WHEN
+			got, err := opts.secretValue(tc.in)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -0,0 +1,273 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	taskExecGroupNamePrompt     = "What is the group name of the task you would like to run a command in?"
+	taskExecGroupNameHelpPrompt = `The name you passed to "copilot task run" with --task-group-name, or your working directory's name.`
+
+	clusterResourceType = "ecs:cluster"
+)
+
+var (
+	taskExecAppPrompt = fmt.Sprintf("In which %s are the %s running?", color.Emphasize("application"), color.Emphasize("tasks"))
+	taskExecEnvPrompt = fmt.Sprintf("In which %s are the %s running?", color.Emphasize("environment"), color.Emphasize("tasks"))
+
+	taskExecAppPromptHelp = fmt.Sprintf(`Select %s if the tasks run in the default cluster instead of an application.`, color.Emphasize(appEnvOptionNone))
+	taskExecEnvPromptHelp = fmt.Sprintf(`Select %s if the tasks run in the default cluster instead of an environment.`, color.Emphasize(appEnvOptionNone))
+)
+
+type taskExecVars struct {
+	execVars
+	useDefault bool
+}
+
+type taskExecOpts struct {
+	taskExecVars
+
+	store   store
+	sel     appEnvSelector
+	taskSel runningTaskSelector
+
+	tasksLister taskFamilyLister
+	clusters    resourcesByTagsGetter
+	executor    commandExecutor
+	ssmPlugin   ssmSessionStarter
+	initClients func(*taskExecOpts) error // Overriden in tests.
+}
+
+func newTaskExecOpts(vars taskExecVars) (*taskExecOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	return &taskExecOpts{
+		taskExecVars: vars,
+		store:        store,
+		sel:          selector.NewSelect(vars.prompt, store),
+		taskSel:      selector.NewTaskSelect(vars.prompt),
+		initClients: func(o *taskExecOpts) error {
+			sess, err := o.session()
+			if err != nil {
+				return err
+			}
+			o.tasksLister = ecs.New(sess)
+			o.clusters = resourcegroups.New(sess)
+			o.executor = ecs.New(sess)
+			o.ssmPlugin = exec.NewSSMPluginCommand(sess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *taskExecOpts) Validate() error {
+	if o.useDefault && o.AppName() != "" {
+		return errors.New("cannot specify both `--app` and `--default`")
+	}
+	if o.useDefault && o.envName != "" {
+		return errors.New("cannot specify both `--env` and `--default`")
+	}
+	if o.name != "" {
+		if err := basicNameValidation(o.name); err != nil {
+			return err
+		}
+	}
+	if o.AppName() != "" {
+		if _, err := o.store.GetApplication(o.AppName()); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.AppName(), o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *taskExecOpts) Ask() error {
+	if o.name == "" {
+		name, err := o.prompt.Get(taskExecGroupNamePrompt, taskExecGroupNameHelpPrompt, basicNameValidation)
+		if err != nil {
+			return fmt.Errorf("get task group name: %w", err)
+		}
+		o.name = name
+	}
+	if o.useDefault {
+		return nil
+	}
+	if err := o.askAppName(); err != nil {
+		return err
+	}
+	return o.askEnvName()
+}
+
+// Execute starts an interactive session running the command in a container of one of the group's running tasks.
+func (o *taskExecOpts) Execute() error {
+	if err := o.initClients(o); err != nil {
+		return err
+	}
+	if err := o.ssmPlugin.ValidateBinary(); err != nil {
+		return err
+	}
+	cluster, err := o.cluster()
+	if err != nil {
+		return err
+	}
+	tasks, err := o.tasksLister.RunningTasksInFamily(cluster, task.FamilyName(o.name))
+	if err != nil {
+		return fmt.Errorf("get running tasks in group %s: %w", o.name, err)
+	}
+	selected, err := o.taskSel.RunningTask(execTaskPrompt, execTaskHelpPrompt, tasks, o.taskID)
+	if err != nil {
+		return fmt.Errorf("select running task in group %s: %w", o.name, err)
+	}
+	if !aws.BoolValue(selected.EnableExecuteCommand) {
+		return fmt.Errorf("execute command is not enabled for the task, run the task with %s", color.HighlightCode("copilot task run --exec"))
+	}
+	return startExecSession(execSessionInput{
+		task:      selected,
+		container: o.containerName,
+		command:   o.command,
+		sel:       o.taskSel,
+		executor:  o.executor,
+		ssmPlugin: o.ssmPlugin,
+	})
+}
+
+func (o *taskExecOpts) askAppName() error {
+	if o.AppName() != "" {
+		return nil
+	}
+	app, err := o.sel.Application(taskExecAppPrompt, taskExecAppPromptHelp, appEnvOptionNone)
+	if err != nil {
+		return fmt.Errorf("ask for application: %w", err)
+	}
+	if app == appEnvOptionNone {
+		o.useDefault = true
+		return nil
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *taskExecOpts) askEnvName() error {
+	if o.envName != "" || o.useDefault {
+		return nil
+	}
+	env, err := o.sel.Environment(taskExecEnvPrompt, taskExecEnvPromptHelp, o.AppName(), appEnvOptionNone)
+	if err != nil {
+		return fmt.Errorf("ask for environment: %w", err)
+	}
+	if env == appEnvOptionNone {
+		o.useDefault = true
+		return nil
+	}
+	o.envName = env
+	return nil
+}
+
+func (o *taskExecOpts) session() (*session.Session, error) {
+	provider := sessions.NewProvider()
+	if o.useDefault {
+		sess, err := provider.Default()
+		if err != nil {
+			return nil, fmt.Errorf("get default session: %w", err)
+		}
+		return sess, nil
+	}
+	env, err := o.store.GetEnvironment(o.AppName(), o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	sess, err := provider.FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("get session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return sess, nil
+}
+
+// cluster returns the default cluster, or the cluster of the environment, that the tasks run in.
+func (o *taskExecOpts) cluster() (string, error) {
+	if o.useDefault {
+		cluster, err := o.tasksLister.DefaultCluster()
+		if err != nil {
+			return "", err
+		}
+		return cluster, nil
+	}
+	clusters, err := o.clusters.GetResourcesByTags(clusterResourceType, map[string]string{
+		deploy.AppTagKey: o.AppName(),
+		deploy.EnvTagKey: o.envName,
+	})
+	if err != nil {
+		return "", fmt.Errorf("get cluster of environment %s: %w", o.envName, err)
+	}
+	if len(clusters) == 0 {
+		return "", fmt.Errorf("no cluster found in environment %s", o.envName)
+	}
+	return clusters[0].ARN, nil
+}
+
+// BuildTaskExecCmd builds the command for running a command in a container of a running task.
+func BuildTaskExecCmd() *cobra.Command {
+	vars := taskExecVars{
+		execVars: execVars{
+			GlobalOpts: NewGlobalOpts(),
+		},
+	}
+	cmd := &cobra.Command{
+		Use:   "exec",
+		Short: "Execute a command in a running container of a task.",
+		Long: `Execute a command in a running container of a task.
+The task must be run with --exec, and the Session Manager plugin for the AWS CLI must be installed.`,
+
+		Example: `
+  Start an interactive shell in a task of the group "db-migrate" in the "test" environment.
+  /code $ copilot task exec -n db-migrate --env test
+  Run "ls" in a task of the group "db-migrate" running in the default cluster.
+  /code $ copilot task exec -n db-migrate --default --command "ls"`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newTaskExecOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, taskGroupNameFlag, nameFlagShort, "", taskExecGroupFlagDescription)
+	cmd.Flags().StringVar(&vars.appName, appFlag, "", appFlagDescription)
+	cmd.Flags().StringVar(&vars.envName, envFlag, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.useDefault, taskDefaultFlag, false, taskExecDefaultFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", taskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", containerFlagDescription)
+	cmd.Flags().StringVar(&vars.command, commandFlag, defaultExecCommand, execCommandFlagDescription)
+	return cmd
+}
@@ -0,0 +1,221 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskExecOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inApp      string
+		inEnv      string
+		inName     string
+		useDefault bool
+
+		wantedError error
+	}{
+		"errors if both --app and --default are specified": {
+			inApp:      "my-app",
+			useDefault: true,
+
+			wantedError: errors.New("cannot specify both `--app` and `--default`"),
+		},
+		"errors if both --env and --default are specified": {
+			inEnv:      "test",
+			useDefault: true,
+
+			wantedError: errors.New("cannot specify both `--env` and `--default`"),
+		},
+		"errors if the group name is invalid": {
+			inName:     "123-my-task",
+			useDefault: true,
+
+			wantedError: errValueBadFormat,
+		},
+		"success with the default cluster": {
+			inName:     "db-migrate",
+			useDefault: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &taskExecOpts{
+				taskExecVars: taskExecVars{
+					execVars: execVars{
+						GlobalOpts: &GlobalOpts{
+							appName: tc.inApp,
+						},
+						name:    tc.inName,
+						envName: tc.inEnv,
+					},
+					useDefault: tc.useDefault,
+				},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskExecOpts_Execute(t *testing.T) {
+	const (
+		mockClusterARN = "arn:aws:ecs:us-west-2:123456789:cluster/mockCluster"
+		mockTaskARN    = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/4082490ee6c245e09d2145010aa1ba8d"
+	)
+	mockError := errors.New("some error")
+	mockTasks := []*ecs.Task{
+		{
+			ClusterArn:           aws.String(mockClusterARN),
+			TaskArn:              aws.String(mockTaskARN),
+			EnableExecuteCommand: aws.Bool(true),
+		},
+	}
+	mockSession := &ecs.Session{
+		ID: "mockSessionID",
+	}
+	type taskExecMocks struct {
+		tasksLister *mocks.MocktaskFamilyLister
+		clusters    *mocks.MockresourcesByTagsGetter
+		taskSel     *mocks.MockrunningTaskSelector
+		executor    *mocks.MockcommandExecutor
+		ssmPlugin   *mocks.MockssmSessionStarter
+	}
+	testCases := map[string]struct {
+		useDefault bool
+		setupMocks func(m taskExecMocks)
+
+		wantedError error
+	}{
+		"errors if the Session Manager plugin is not installed": {
+			setupMocks: func(m taskExecMocks) {
+				m.ssmPlugin.EXPECT().ValidateBinary().Return(mockError)
+			},
+
+			wantedError: mockError,
+		},
+		"errors if no cluster is found in the environment": {
+			setupMocks: func(m taskExecMocks) {
+				m.ssmPlugin.EXPECT().ValidateBinary().Return(nil)
+				m.clusters.EXPECT().GetResourcesByTags(clusterResourceType, map[string]string{
+					deploy.AppTagKey: "my-app",
+					deploy.EnvTagKey: "test",
+				}).Return([]*resourcegroups.Resource{}, nil)
+			},
+
+			wantedError: errors.New("no cluster found in environment test"),
+		},
+		"errors if failed to get running tasks in the default cluster": {
+			useDefault: true,
+			setupMocks: func(m taskExecMocks) {
+				m.ssmPlugin.EXPECT().ValidateBinary().Return(nil)
+				m.tasksLister.EXPECT().DefaultCluster().Return(mockClusterARN, nil)
+				m.tasksLister.EXPECT().RunningTasksInFamily(mockClusterARN, "copilot-db-migrate").Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("get running tasks in group db-migrate: some error"),
+		},
+		"errors if execute command is not enabled": {
+			useDefault: true,
+			setupMocks: func(m taskExecMocks) {
+				m.ssmPlugin.EXPECT().ValidateBinary().Return(nil)
+				m.tasksLister.EXPECT().DefaultCluster().Return(mockClusterARN, nil)
+				m.tasksLister.EXPECT().RunningTasksInFamily(mockClusterARN, "copilot-db-migrate").Return(mockTasks, nil)
+				m.taskSel.EXPECT().RunningTask(execTaskPrompt, execTaskHelpPrompt, mockTasks, "").Return(&ecs.Task{
+					TaskArn: aws.String(mockTaskARN),
+				}, nil)
+			},
+
+			wantedError: errors.New("execute command is not enabled for the task, run the task with `copilot task run --exec`"),
+		},
+		"starts a session in a task of the environment": {
+			setupMocks: func(m taskExecMocks) {
+				m.ssmPlugin.EXPECT().ValidateBinary().Return(nil)
+				m.clusters.EXPECT().GetResourcesByTags(clusterResourceType, map[string]string{
+					deploy.AppTagKey: "my-app",
+					deploy.EnvTagKey: "test",
+				}).Return([]*resourcegroups.Resource{{ARN: mockClusterARN}}, nil)
+				m.tasksLister.EXPECT().RunningTasksInFamily(mockClusterARN, "copilot-db-migrate").Return(mockTasks, nil)
+				m.taskSel.EXPECT().RunningTask(execTaskPrompt, execTaskHelpPrompt, mockTasks, "").Return(mockTasks[0], nil)
+				m.taskSel.EXPECT().Container(execContainerPrompt, execContainerHelpPrompt, mockTasks[0]).Return("db-migrate", nil)
+				m.executor.EXPECT().ExecuteCommand(ecs.ExecuteCommandInput{
+					Cluster:   mockClusterARN,
+					Task:      mockTaskARN,
+					Container: "db-migrate",
+					Command:   "/bin/sh",
+				}).Return(mockSession, nil)
+				m.ssmPlugin.EXPECT().StartSession(mockSession).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := taskExecMocks{
+				tasksLister: mocks.NewMocktaskFamilyLister(ctrl),
+				clusters:    mocks.NewMockresourcesByTagsGetter(ctrl),
+				taskSel:     mocks.NewMockrunningTaskSelector(ctrl),
+				executor:    mocks.NewMockcommandExecutor(ctrl),
+				ssmPlugin:   mocks.NewMockssmSessionStarter(ctrl),
+			}
+			tc.setupMocks(m)
+			vars := taskExecVars{
+				execVars: execVars{
+					GlobalOpts: &GlobalOpts{},
+					name:       "db-migrate",
+					command:    "/bin/sh",
+				},
+				useDefault: tc.useDefault,
+			}
+			if !tc.useDefault {
+				vars.appName = "my-app"
+				vars.envName = "test"
+			}
+			opts := &taskExecOpts{
+				taskExecVars: vars,
+				taskSel:      m.taskSel,
+				initClients: func(o *taskExecOpts) error {
+					o.tasksLister = m.tasksLister
+					o.clusters = m.clusters
+					o.executor = m.executor
+					o.ssmPlugin = m.ssmPlugin
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
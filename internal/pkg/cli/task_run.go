@@ -21,8 +21,10 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/docker"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/repository"
 	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -87,7 +89,10 @@ type runTaskVars struct {
 	command      string
 	resourceTags map[string]string
 
-	follow bool
+	follow     bool
+	enableExec bool
+	spot       bool
+	platform   string
 }
 
 type runTaskOpts struct {
@@ -176,6 +181,9 @@ func (o *runTaskOpts) configureRunner() taskRunner {
 			App: o.AppName(),
 			Env: o.env,
 
+			EnableExec: o.enableExec,
+			Spot:       o.spot,
+
 			VPCGetter:     vpcGetter,
 			ClusterGetter: resourcegroups.New(o.sess),
 			Starter:       ecsService,
@@ -189,6 +197,9 @@ func (o *runTaskOpts) configureRunner() taskRunner {
 		Subnets:        o.subnets,
 		SecurityGroups: o.securityGroups,
 
+		EnableExec: o.enableExec,
+		Spot:       o.spot,
+
 		VPCGetter:     vpcGetter,
 		ClusterGetter: ecsService,
 		Starter:       ecsService,
@@ -239,6 +250,12 @@ func (o *runTaskOpts) Validate() error {
 		return errMemNotPositive
 	}
 
+	if o.platform != "" {
+		if err := o.validatePlatform(); err != nil {
+			return err
+		}
+	}
+
 	if o.groupName != "" {
 		if err := basicNameValidation(o.groupName); err != nil {
 			return err
@@ -282,6 +299,20 @@ func (o *runTaskOpts) Validate() error {
 	return nil
 }
 
+func (o *runTaskOpts) validatePlatform() error {
+	platform, err := manifest.ParsePlatform(o.platform)
+	if err != nil {
+		return err
+	}
+	if err := manifest.ValidateFargateTaskSize(o.cpu, o.memory); err != nil {
+		return err
+	}
+	if o.spot && platform.Arch == template.ArchARM64 {
+		return fmt.Errorf("cannot specify both `--%s` and an arm64 `--%s`", spotFlag, platformFlag)
+	}
+	return nil
+}
+
 func (o *runTaskOpts) validateFlagsWithDefaultCluster() error {
 	if !o.useDefaultSubnets {
 		return nil
@@ -463,6 +494,7 @@ func (o *runTaskOpts) buildAndPushImage() error {
 		Context:        filepath.Dir(o.dockerfilePath),
 		ImageTag:       imageTagLatest,
 		AdditionalTags: additionalTags,
+		Platform:       o.platform,
 	}); err != nil {
 		return fmt.Errorf("build and push image: %w", err)
 	}
@@ -503,6 +535,8 @@ func (o *runTaskOpts) deploy() error {
 		ExecutionRole:  o.executionRole,
 		Command:        o.command,
 		EnvVars:        o.envVars,
+		EnableExec:     o.enableExec,
+		Platform:       o.platform,
 		App:            o.AppName(),
 		Env:            o.env,
 		AdditionalTags: o.resourceTags,
@@ -600,7 +634,13 @@ Run a task with environment variables.
 Run a task using the current workspace with specific subnets and security groups.
 /code $ copilot task run --subnets subnet-123,subnet-456 --security-groups sg-123,sg-456
 Run a task with a command.
-/code $ copilot task run --command "python migrate-script.py"`,
+/code $ copilot task run --command "python migrate-script.py"
+Run a task that you can run commands in with "copilot task exec".
+/code $ copilot task run -n db-migrate --env test --exec
+Run a task on Fargate Spot capacity.
+/code $ copilot task run -n db-migrate --env test --spot
+Run a task on ARM64 Fargate capacity.
+/code $ copilot task run -n db-migrate --env test --platform linux/arm64`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newTaskRunOpts(vars)
 			if err != nil {
@@ -650,5 +690,8 @@ Run a task with a command.
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 
 	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
+	cmd.Flags().BoolVar(&vars.enableExec, execFlag, false, taskExecFlagDescription)
+	cmd.Flags().BoolVar(&vars.spot, spotFlag, false, taskSpotFlagDescription)
+	cmd.Flags().StringVar(&vars.platform, platformFlag, "", taskPlatformFlagDescription)
 	return cmd
 }
@@ -61,6 +61,9 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 
 		inDefault bool
 
+		inSpot     bool
+		inPlatform string
+
 		appName string
 		isDockerfileSet bool
 
@@ -150,6 +153,33 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 			},
 			wantedError: errMemNotPositive,
 		},
+		"valid platform": {
+			basicOpts:  defaultOpts,
+			inPlatform: "linux/arm64",
+		},
+		"invalid platform": {
+			basicOpts:  defaultOpts,
+			inPlatform: "windows/amd64",
+
+			wantedError: errors.New("platform windows/amd64 must be one of linux/amd64, linux/x86_64, linux/arm64"),
+		},
+		"cpu and memory not supported by Fargate for the platform": {
+			basicOpts: basicOpts{
+				inCount:  1,
+				inCPU:    256,
+				inMemory: 4096,
+			},
+			inPlatform: "linux/arm64",
+
+			wantedError: errors.New("memory 4096 is not supported by Fargate for cpu 256"),
+		},
+		"arm64 platform on Fargate Spot": {
+			basicOpts:  defaultOpts,
+			inSpot:     true,
+			inPlatform: "linux/arm64",
+
+			wantedError: errors.New("cannot specify both `--spot` and an arm64 `--platform`"),
+		},
 		"both dockerfile and image name specified": {
 			basicOpts: defaultOpts,
 
@@ -300,6 +330,8 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 					envVars:           tc.inEnvVars,
 					command:           tc.inCommand,
 					useDefaultSubnets: tc.inDefault,
+					spot:              tc.inSpot,
+					platform:          tc.inPlatform,
 				},
 				isDockerfileSet: tc.isDockerfileSet,
 
@@ -12,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 )
@@ -34,6 +35,9 @@ var (
 	errDDBAttributeBadFormat              = errors.New("value must be of the form <name>:<T> where T is one of S, N, or B")
 	errTooManyLSIKeys                     = errors.New("number of specified LSI sort keys must be 5 or less")
 	errDomainInvalid                      = errors.New("value must contain at least one '.' character")
+	errSecretNameBadFormat                = errors.New("value must start with a letter and contain only alphanumeric characters and _")
+	errEFSValueTooLong                    = errors.New("value must not exceed 128 characters")
+	errEFSValueBadFormat                  = errors.New("value must start with a letter and contain only alphanumeric characters and _-")
 )
 
 var (
@@ -70,6 +74,10 @@ var (
 	)
 
 	domainNameRegexp = regexp.MustCompile(`\.`) //check for at least one dot in domain name
+
+	secretNameRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`) // secrets are injected as environment variables.
+
+	efsNameRegExp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9\-_]*$`) // the name is part of the logical IDs of the file system's resources.
 )
 
 const regexpFindAllMatches = -1
@@ -110,6 +118,20 @@ func validateSvcType(val interface{}) error {
 	return fmt.Errorf("invalid service type %s: must be one of %s", svcType, prettify(manifest.ServiceTypes))
 }
 
+func validateSchedule(val interface{}) error {
+	schedule, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if strings.TrimSpace(schedule) == "" {
+		return errValueEmpty
+	}
+	if err := stack.ValidateSchedule(schedule); err != nil {
+		return fmt.Errorf("schedule %s is invalid: %w", schedule, err)
+	}
+	return nil
+}
+
 func validateDomainName(val interface{}) error {
 	domainName, ok := val.(string)
 	if !ok {
@@ -122,6 +144,23 @@ func validateDomainName(val interface{}) error {
 	return nil
 }
 
+func validateSecretName(val interface{}) error {
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if s == "" {
+		return errValueEmpty
+	}
+	if len(s) > 255 {
+		return errValueTooLong
+	}
+	if !secretNameRegexp.MatchString(s) {
+		return errSecretNameBadFormat
+	}
+	return nil
+}
+
 func validateStorageType(val interface{}) error {
 	storageType, ok := val.(string)
 	if !ok {
@@ -264,6 +303,26 @@ func dynamoTableNameValidation(val interface{}) error {
 	return nil
 }
 
+func efsFileSystemNameValidation(val interface{}) error {
+	// The name is appended to the "${App}-${Env}-${Name}-" prefix of the file system's Name tag, which can't exceed 256 characters.
+	const maxEFSFileSystemNameLength = 128
+
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if s == "" {
+		return errValueEmpty
+	}
+	if len(s) > maxEFSFileSystemNameLength {
+		return errEFSValueTooLong
+	}
+	if !efsNameRegExp.MatchString(s) {
+		return errEFSValueBadFormat
+	}
+	return nil
+}
+
 func validateKey(val interface{}) error {
 	s, ok := val.(string)
 	if !ok {
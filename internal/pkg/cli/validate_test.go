@@ -102,6 +102,39 @@ func TestValidateEnvironmentName(t *testing.T) {
 	}
 }
 
+func TestValidateSecretName(t *testing.T) {
+	testCases := map[string]testCase{
+		"good case": {
+			input: "GITHUB_TOKEN",
+			want:  nil,
+		},
+		"not a string": {
+			input: 123,
+			want:  errValueNotAString,
+		},
+		"empty": {
+			input: "",
+			want:  errValueEmpty,
+		},
+		"starts with a number": {
+			input: "1_TOKEN",
+			want:  errSecretNameBadFormat,
+		},
+		"contains a path separator": {
+			input: "db/password",
+			want:  errSecretNameBadFormat,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateSecretName(tc.input)
+
+			require.True(t, errors.Is(got, tc.want))
+		})
+	}
+}
+
 func TestValidateS3Name(t *testing.T) {
 	testCases := map[string]testCase{
 		"good case": {
@@ -191,6 +224,43 @@ func TestValidateDDBName(t *testing.T) {
 	}
 }
 
+func TestValidateEFSName(t *testing.T) {
+	testCases := map[string]testCase{
+		"good case": {
+			input: "my-fs_1",
+			want:  nil,
+		},
+		"not a string": {
+			input: 123,
+			want:  errValueNotAString,
+		},
+		"empty": {
+			input: "",
+			want:  errValueEmpty,
+		},
+		"too long": {
+			input: strings.Repeat("a", 129),
+			want:  errEFSValueTooLong,
+		},
+		"starts with a number": {
+			input: "1fs",
+			want:  errEFSValueBadFormat,
+		},
+		"contains a period": {
+			input: "my.fs",
+			want:  errEFSValueBadFormat,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := efsFileSystemNameValidation(tc.input)
+
+			require.True(t, errors.Is(got, tc.want))
+		})
+	}
+}
+
 func TestValidateStorageType(t *testing.T) {
 	testCases := map[string]struct {
 		input string
@@ -23,6 +23,9 @@ const (
 	// AddonsCfnTemplateNameFormat is the addons output file name when `service package`
 	// is called.
 	AddonsCfnTemplateNameFormat = "%s.addons.stack.yml"
+	// EnvFileNameFormat is the name of the environment file of a service uploaded to S3
+	// when `service deploy` is called.
+	EnvFileNameFormat = "%s.env"
 )
 
 // Service represents a deployable long running service or task.
@@ -13,3 +13,10 @@ type CreateAppInput struct {
 	DomainName            string            // DNS Name used for this application.
 	AdditionalTags        map[string]string // AdditionalTags are labels applied to resources under the application.
 }
+
+// AppInformation holds information about the application that workload stacks need to reference.
+type AppInformation struct {
+	Name      string // Name of the application.
+	AccountID string // AWS account ID that administrates the application and owns its hosted zone.
+	DNSName   string // DNS Name used for this application. Empty if the application isn't associated with a domain.
+}
@@ -5,6 +5,11 @@
 package cloudformation
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
@@ -47,6 +52,74 @@ func (cf CloudFormation) DeleteEnvironment(appName, envName string) error {
 	return cf.cfnClient.DeleteAndWait(conf.StackName())
 }
 
+// InternalLoadBalancerEnabled returns true if the environment stack includes an internal load balancer.
+func (cf CloudFormation) InternalLoadBalancerEnabled(appName, envName string) (bool, error) {
+	descr, err := cf.cfnClient.Describe(stack.NameForEnv(appName, envName))
+	if err != nil {
+		return false, err
+	}
+	for _, param := range descr.Parameters {
+		if aws.StringValue(param.ParameterKey) == stack.EnvParamIncludeInternalLBKey {
+			return aws.StringValue(param.ParameterValue) == "true", nil
+		}
+	}
+	return false, nil
+}
+
+// EnvironmentVPC returns the IDs of the VPC and subnets of the environment from the outputs of its stack.
+func (cf CloudFormation) EnvironmentVPC(appName, envName string) (*deploy.ImportVPCConfig, error) {
+	descr, err := cf.cfnClient.Describe(stack.NameForEnv(appName, envName))
+	if err != nil {
+		return nil, err
+	}
+	outputs := make(map[string]string)
+	for _, output := range descr.Outputs {
+		outputs[aws.StringValue(output.OutputKey)] = aws.StringValue(output.OutputValue)
+	}
+	return &deploy.ImportVPCConfig{
+		ID:               outputs[stack.EnvOutputVPCID],
+		PublicSubnetIDs:  splitOutput(outputs[stack.EnvOutputPublicSubnets]),
+		PrivateSubnetIDs: splitOutput(outputs[stack.EnvOutputPrivateSubnets]),
+	}, nil
+}
+
+// EnableInternalLoadBalancer updates the environment stack with the latest environment template so that it includes
+// an internal load balancer. The values of the stack's other parameters and its tags are kept.
+func (cf CloudFormation) EnableInternalLoadBalancer(env StackConfiguration, roleARN string) error {
+	descr, err := cf.cfnClient.Describe(env.StackName())
+	if err != nil {
+		return err
+	}
+	template, err := env.Template()
+	if err != nil {
+		return fmt.Errorf("generate template for stack %s: %w", env.StackName(), err)
+	}
+	params := make(map[string]string)
+	for _, param := range descr.Parameters {
+		params[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+	}
+	params[stack.EnvParamIncludeInternalLBKey] = "true"
+	tags := make(map[string]string)
+	for _, tag := range descr.Tags {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return cf.cfnClient.UpdateAndWait(cloudformation.NewStack(env.StackName(), template,
+		cloudformation.WithParameters(params),
+		cloudformation.WithTags(tags),
+		cloudformation.WithRoleARN(roleARN)))
+}
+
+// splitOutput returns the values of a comma-separated stack output.
+func splitOutput(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
 // streamEnvironmentResponse sends a CreateEnvironmentResponse to the response channel once the stack creation halts.
 // The done channel is closed once this method exits to notify other streams that they should stop working.
 func (cf CloudFormation) streamEnvironmentResponse(done chan struct{}, resp chan deploy.CreateEnvironmentResponse, stack *stack.EnvStackConfig) {
@@ -0,0 +1,241 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudFormation_InternalLoadBalancerEnabled(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedEnabled bool
+		wantedErr     error
+	}{
+		"returns error if the stack can't be described": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"returns false if the stack was created before internal load balancers were supported": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test").Return(&cloudformation.StackDescription{}, nil)
+				return m
+			},
+		},
+		"returns true if the stack includes an internal load balancer": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test").Return(&cloudformation.StackDescription{
+					Parameters: []*sdkcloudformation.Parameter{
+						{
+							ParameterKey:   aws.String("IncludeInternalLoadBalancer"),
+							ParameterValue: aws.String("true"),
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantedEnabled: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			enabled, err := c.InternalLoadBalancerEnabled("kudos", "test")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnabled, enabled)
+		})
+	}
+}
+
+func TestCloudFormation_EnvironmentVPC(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedVPC *deploy.ImportVPCConfig
+		wantedErr error
+	}{
+		"returns error if the stack can't be described": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"returns the VPC and subnet IDs from the stack outputs": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test").Return(&cloudformation.StackDescription{
+					Outputs: []*sdkcloudformation.Output{
+						{
+							OutputKey:   aws.String("VpcId"),
+							OutputValue: aws.String("vpc-1"),
+						},
+						{
+							OutputKey:   aws.String("PublicSubnets"),
+							OutputValue: aws.String("subnet-1,subnet-2"),
+						},
+						{
+							OutputKey:   aws.String("PrivateSubnets"),
+							OutputValue: aws.String("subnet-3,subnet-4"),
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantedVPC: &deploy.ImportVPCConfig{
+				ID:               "vpc-1",
+				PublicSubnetIDs:  []string{"subnet-1", "subnet-2"},
+				PrivateSubnetIDs: []string{"subnet-3", "subnet-4"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			vpc, err := c.EnvironmentVPC("kudos", "test")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedVPC, vpc)
+		})
+	}
+}
+
+func TestCloudFormation_EnableInternalLoadBalancer(t *testing.T) {
+	testCases := map[string]struct {
+		mockStackConfig func(m *mocks.MockStackConfiguration)
+		createMock      func(ctrl *gomock.Controller) cfnClient
+
+		wantedErr error
+	}{
+		"returns error if the stack can't be described": {
+			mockStackConfig: func(m *mocks.MockStackConfiguration) {
+				m.EXPECT().StackName().Return("kudos-test").AnyTimes()
+			},
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"returns error if the template can't be generated": {
+			mockStackConfig: func(m *mocks.MockStackConfiguration) {
+				m.EXPECT().StackName().Return("kudos-test").AnyTimes()
+				m.EXPECT().Template().Return("", errors.New("some error"))
+			},
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test").Return(&cloudformation.StackDescription{}, nil)
+				return m
+			},
+			wantedErr: errors.New("generate template for stack kudos-test: some error"),
+		},
+		"updates the stack with the latest template and the internal load balancer enabled": {
+			mockStackConfig: func(m *mocks.MockStackConfiguration) {
+				m.EXPECT().StackName().Return("kudos-test").AnyTimes()
+				m.EXPECT().Template().Return("template", nil)
+			},
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("kudos-test").Return(&cloudformation.StackDescription{
+					Parameters: []*sdkcloudformation.Parameter{
+						{
+							ParameterKey:   aws.String("AppName"),
+							ParameterValue: aws.String("kudos"),
+						},
+					},
+					Tags: []*sdkcloudformation.Tag{
+						{
+							Key:   aws.String("copilot-application"),
+							Value: aws.String("kudos"),
+						},
+					},
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any()).DoAndReturn(func(s *cloudformation.Stack) error {
+					require.Equal(t, "kudos-test", s.Name)
+					require.Equal(t, "template", s.Template)
+					require.Equal(t, "myrole", aws.StringValue(s.RoleARN))
+					params := make(map[string]string)
+					for _, param := range s.Parameters {
+						params[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+					}
+					require.Equal(t, map[string]string{
+						"AppName":                     "kudos",
+						"IncludeInternalLoadBalancer": "true",
+					}, params)
+					require.Equal(t, "kudos", aws.StringValue(s.Tags[0].Value))
+					return nil
+				})
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			conf := mocks.NewMockStackConfiguration(ctrl)
+			tc.mockStackConfig(conf)
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.EnableInternalLoadBalancer(conf, "myrole")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -7,8 +7,16 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+)
+
+const (
+	// taskDefinitionLogicalID is the logical ID of the ECS task definition in service stacks.
+	taskDefinitionLogicalID = "TaskDefinition"
 )
 
 // DeployService deploys a service stack and waits until the deployment is done.
@@ -39,3 +47,47 @@ func (cf CloudFormation) DeployService(conf StackConfiguration, opts ...cloudfor
 func (cf CloudFormation) DeleteService(in deploy.DeleteServiceInput) error {
 	return cf.cfnClient.DeleteAndWait(fmt.Sprintf("%s-%s-%s", in.AppName, in.EnvName, in.Name))
 }
+
+// ServiceRevisions returns the task definitions deployed by successful create or update operations
+// of a service stack, ordered from the most recent deployment to the oldest.
+// The first revision is the one currently deployed.
+func (cf CloudFormation) ServiceRevisions(app, env, svc string) ([]deploy.ServiceRevision, error) {
+	stackName := stack.NameForService(app, env, svc)
+	events, err := cf.cfnClient.Events(stackName)
+	if err != nil {
+		return nil, err
+	}
+	var revisions []deploy.ServiceRevision
+	var deployed, pending string
+	for _, event := range events {
+		logicalID, status := aws.StringValue(event.LogicalResourceId), aws.StringValue(event.ResourceStatus)
+		switch {
+		case logicalID == taskDefinitionLogicalID && status == sdkcloudformation.ResourceStatusCreateComplete:
+			// A new task definition only takes effect if the stack operation that created it succeeds.
+			pending = aws.StringValue(event.PhysicalResourceId)
+		case logicalID == stackName && status == sdkcloudformation.StackStatusUpdateRollbackComplete:
+			pending = ""
+		case logicalID == stackName && (status == sdkcloudformation.StackStatusCreateComplete || status == sdkcloudformation.StackStatusUpdateComplete):
+			if pending != "" {
+				deployed, pending = pending, ""
+			}
+			if deployed == "" {
+				continue
+			}
+			if len(revisions) > 0 && revisions[len(revisions)-1].TaskDefinition == deployed {
+				// The stack was updated without replacing the task definition.
+				continue
+			}
+			revisions = append(revisions, deploy.ServiceRevision{
+				TaskDefinition: deployed,
+				DeployedAt:     aws.TimeValue(event.Timestamp),
+			})
+		}
+	}
+	// Reverse the revisions so that the most recent deployment comes first.
+	for i := len(revisions)/2 - 1; i >= 0; i-- {
+		opp := len(revisions) - 1 - i
+		revisions[i], revisions[opp] = revisions[opp], revisions[i]
+	}
+	return revisions, nil
+}
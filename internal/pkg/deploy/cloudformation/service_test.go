@@ -4,7 +4,9 @@
 package cloudformation
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -148,3 +150,86 @@ func TestCloudFormation_DeleteService(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudFormation_ServiceRevisions(t *testing.T) {
+	const stackName = "kudos-test-webhook"
+	t1 := time.Date(2020, 8, 1, 10, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+	t3 := t2.Add(time.Hour)
+	t4 := t3.Add(time.Hour)
+	event := func(logicalID, physicalID, status string, ts time.Time) cloudformation.StackEvent {
+		return cloudformation.StackEvent{
+			LogicalResourceId:  aws.String(logicalID),
+			PhysicalResourceId: aws.String(physicalID),
+			ResourceStatus:     aws.String(status),
+			Timestamp:          aws.Time(ts),
+		}
+	}
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedRevisions []deploy.ServiceRevision
+		wantedErr       error
+	}{
+		"returns error if events can't be retrieved": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Events(stackName).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"skips failed updates and updates that don't replace the task definition": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Events(stackName).Return([]cloudformation.StackEvent{
+					event(stackName, "stackID", "CREATE_IN_PROGRESS", t1),
+					event("TaskDefinition", "td:1", "CREATE_COMPLETE", t1),
+					event(stackName, "stackID", "CREATE_COMPLETE", t1),
+					event(stackName, "stackID", "UPDATE_IN_PROGRESS", t2),
+					event("TaskDefinition", "td:2", "CREATE_COMPLETE", t2),
+					event(stackName, "stackID", "UPDATE_COMPLETE", t2),
+					event(stackName, "stackID", "UPDATE_IN_PROGRESS", t3),
+					event("TaskDefinition", "td:3", "CREATE_COMPLETE", t3),
+					event("Service", "svc", "UPDATE_FAILED", t3),
+					event(stackName, "stackID", "UPDATE_ROLLBACK_COMPLETE", t3),
+					event(stackName, "stackID", "UPDATE_IN_PROGRESS", t4),
+					event(stackName, "stackID", "UPDATE_COMPLETE", t4),
+				}, nil)
+				return m
+			},
+			wantedRevisions: []deploy.ServiceRevision{
+				{
+					TaskDefinition: "td:2",
+					DeployedAt:     t2,
+				},
+				{
+					TaskDefinition: "td:1",
+					DeployedAt:     t1,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			revisions, err := c.ServiceRevisions("kudos", "test", "webhook")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedRevisions, revisions)
+		})
+	}
+}
@@ -4,6 +4,7 @@
 package stack
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -17,8 +18,11 @@ import (
 // Parameter logical IDs for a backend service.
 const (
 	BackendServiceContainerPortParamKey = "ContainerPort"
+	BackendServiceRulePathParamKey      = "RulePath"
 )
 
+var errInternalLBWithoutPort = errors.New("a port is required to route requests from the internal load balancer")
+
 type backendSvcReadParser interface {
 	template.ReadParser
 	ParseBackendService(template.ServiceOpts) (*template.Content, error)
@@ -65,32 +69,113 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	sidecars, err := s.manifest.Sidecar.SidecarsOpts()
+	sidecars, err := s.manifest.Sidecar.SidecarsOpts(s.manifest.MainContainer())
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
+	dependsOn, err := s.manifest.Sidecar.MainContainerDependsOnOpts(s.manifest.MainContainer())
+	if err != nil {
+		return "", fmt.Errorf("convert the container dependencies for service %s: %w", s.name, err)
+	}
+	storage, err := s.manifest.Storage.StorageOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the storage configuration for service %s: %w", s.name, err)
+	}
+	permissions, err := s.manifest.Permissions.PermissionsOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the permissions for service %s: %w", s.name, err)
+	}
+	capacityProviders, err := s.manifest.Platform.CapacityProviderOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the platform configuration for service %s: %w", s.name, err)
+	}
+	runtimePlatform, err := s.manifest.RuntimePlatformOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the platform configuration for service %s: %w", s.name, err)
+	}
+	secrets, err := s.manifest.SecretOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the secrets for service %s: %w", s.name, err)
+	}
+	envFile, err := s.rc.envFileOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the environment file for service %s: %w", s.name, err)
+	}
+	autoscaling, err := s.autoscalingOpts()
+	if err != nil {
+		return "", err
+	}
+	if autoscaling != nil && autoscaling.Requests != nil {
+		return "", fmt.Errorf("service %s: %w", s.name, errRequestsPerTargetNotLBWS)
+	}
+	deploymentConfig, err := s.deploymentConfigOpts(s.manifest.Deployment)
+	if err != nil {
+		return "", err
+	}
+	var rulePriorityLambda string
+	var targetGroup template.TargetGroupOpts
+	if !s.manifest.HTTP.IsEmpty() {
+		if aws.Uint16Value(s.manifest.Image.Port) == 0 {
+			return "", fmt.Errorf("service %s: %w", s.name, errInternalLBWithoutPort)
+		}
+		lambda, err := s.parser.Read(lbWebSvcRulePriorityGeneratorPath)
+		if err != nil {
+			return "", err
+		}
+		rulePriorityLambda = lambda.String()
+		targetGroup, err = s.manifest.HTTP.TargetGroupOpts()
+		if err != nil {
+			return "", fmt.Errorf("convert the target group configuration for service %s: %w", s.name, err)
+		}
+	}
 	content, err := s.parser.ParseBackendService(template.ServiceOpts{
-		Variables:   s.manifest.BackendServiceConfig.Variables,
-		Secrets:     s.manifest.BackendServiceConfig.Secrets,
-		NestedStack: outputs,
-		Sidecars:    sidecars,
-		HealthCheck: s.manifest.BackendServiceConfig.Image.HealthCheckOpts(),
-		LogConfig:   s.manifest.LogConfigOpts(),
+		Variables:         s.manifest.BackendServiceConfig.Variables,
+		EnvFile:           envFile,
+		Secrets:           secrets,
+		NestedStack:       outputs,
+		Sidecars:          sidecars,
+		DependsOn:         dependsOn,
+		HealthCheck:       s.manifest.BackendServiceConfig.Image.HealthCheckOpts(),
+		LogConfig:         s.manifest.LogConfigOpts(),
+		Storage:           storage,
+		Permissions:       permissions,
+		CapacityProviders: capacityProviders,
+		RuntimePlatform:   runtimePlatform,
+		EnableExec:        aws.BoolValue(s.manifest.Exec),
+		Autoscaling:       autoscaling,
+		DeploymentConfig:  deploymentConfig,
+
+		InternalLoadBalancer: !s.manifest.HTTP.IsEmpty(),
+		RulePriorityLambda:   rulePriorityLambda,
+		TargetGroup:          targetGroup,
 	})
 	if err != nil {
 		return "", fmt.Errorf("parse backend service template: %w", err)
 	}
-	return content.String(), nil
+	tpl, err := applyCFNOverrides(content.String(), s.manifest.CFNOverrides)
+	if err != nil {
+		return "", fmt.Errorf("service %s: %w", s.name, err)
+	}
+	return tpl, nil
 }
 
 // Parameters returns the list of CloudFormation parameters used by the template.
 func (s *BackendService) Parameters() ([]*cloudformation.Parameter, error) {
-	return append(s.svc.Parameters(), []*cloudformation.Parameter{
-		{
-			ParameterKey:   aws.String(BackendServiceContainerPortParamKey),
-			ParameterValue: aws.String(strconv.FormatUint(uint64(aws.Uint16Value(s.manifest.BackendServiceConfig.Image.Port)), 10)),
-		},
-	}...), nil
+	svcParams, err := s.svc.Parameters()
+	if err != nil {
+		return nil, err
+	}
+	svcParams = append(svcParams, &cloudformation.Parameter{
+		ParameterKey:   aws.String(BackendServiceContainerPortParamKey),
+		ParameterValue: aws.String(strconv.FormatUint(uint64(aws.Uint16Value(s.manifest.BackendServiceConfig.Image.Port)), 10)),
+	})
+	if !s.manifest.HTTP.IsEmpty() {
+		svcParams = append(svcParams, &cloudformation.Parameter{
+			ParameterKey:   aws.String(BackendServiceRulePathParamKey),
+			ParameterValue: s.manifest.HTTP.Path,
+		})
+	}
+	return svcParams, nil
 }
 
 // SerializedParameters returns the CloudFormation stack's parameters serialized
@@ -19,6 +19,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 // Test settings for container healthchecks in the backend service manifest.
@@ -57,12 +58,33 @@ func TestBackendService_Template(t *testing.T) {
 			Port: aws.String("80/80/80"),
 		},
 	}}
+	// newTestManifest returns a backend service manifest listening on port 8080 after applying the mutation.
+	newTestManifest := func(mutate func(mft *manifest.BackendService)) *manifest.BackendService {
+		mft := manifest.NewBackendService(manifest.BackendServiceProps{
+			ServiceProps: manifest.ServiceProps{
+				Name:       "frontend",
+				Dockerfile: "./frontend/Dockerfile",
+			},
+			Port: 8080,
+		})
+		mutate(mft)
+		return mft
+	}
 	testCases := map[string]struct {
 		mockDependencies func(t *testing.T, ctrl *gomock.Controller, svc *BackendService)
 		manifest         *manifest.BackendService
+		inEnvFileURL     string
 		wantedTemplate   string
 		wantedErr        error
 	}{
+		"failed converting the environment file": {
+			manifest:     testBackendSvcManifest,
+			inEnvFileURL: "https://example.com/frontend.env",
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				svc.addons = mockTemplater{tpl: ""}
+			},
+			wantedErr: fmt.Errorf("convert the environment file for service frontend: %w", errors.New("cannot parse S3 object URL https://example.com/frontend.env")),
+		},
 		"unexpected addons parsing error": {
 			manifest: testBackendSvcManifest,
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
@@ -81,6 +103,70 @@ func TestBackendService_Template(t *testing.T) {
 			},
 			wantedErr: fmt.Errorf("convert the sidecar configuration for service frontend: %w", errors.New("cannot parse port mapping from 80/80/80")),
 		},
+		"autoscaling on requests isn't supported": {
+			manifest: newTestManifest(func(mft *manifest.BackendService) {
+				scalingRange := manifest.Range("1-10")
+				mft.Count.Autoscaling = manifest.Autoscaling{
+					Range:    &scalingRange,
+					Requests: aws.Int(1000),
+				}
+			}),
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				svc.addons = mockTemplater{tpl: ""}
+			},
+			wantedErr: fmt.Errorf("service frontend: %w", errRequestsPerTargetNotLBWS),
+		},
+		"failed converting the platform configuration": {
+			manifest: newTestManifest(func(mft *manifest.BackendService) {
+				mft.Platform = manifest.PlatformConfig{
+					PlatformArgs: manifest.PlatformArgs{
+						Capacity: []manifest.CapacityProviderStrategy{
+							{Provider: aws.String("EC2")},
+						},
+					},
+				}
+			}),
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				svc.addons = mockTemplater{tpl: ""}
+			},
+			wantedErr: fmt.Errorf("convert the platform configuration for service frontend: %w", errors.New("capacity provider EC2 must be one of FARGATE, FARGATE_SPOT")),
+		},
+		"failed converting the runtime platform": {
+			manifest: newTestManifest(func(mft *manifest.BackendService) {
+				mft.Platform = manifest.PlatformConfig{
+					PlatformString: aws.String("linux/arm64"),
+				}
+				mft.CPU = aws.Int(256)
+				mft.Memory = aws.Int(4096)
+			}),
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				svc.addons = mockTemplater{tpl: ""}
+			},
+			wantedErr: fmt.Errorf("convert the platform configuration for service frontend: %w", errors.New("memory 4096 is not supported by Fargate for cpu 256")),
+		},
+		"failed converting the permissions": {
+			manifest: newTestManifest(func(mft *manifest.BackendService) {
+				mft.Permissions = &manifest.Permissions{
+					ManagedPolicies: []string{"AmazonSQSFullAccess"},
+				}
+			}),
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				svc.addons = mockTemplater{tpl: ""}
+			},
+			wantedErr: fmt.Errorf("convert the permissions for service frontend: %w", errors.New(`managed policy "AmazonSQSFullAccess" must be the ARN of an IAM policy`)),
+		},
+		"internal load balancer requires a port": {
+			manifest: newTestManifest(func(mft *manifest.BackendService) {
+				mft.Image.Port = nil
+				mft.HTTP = manifest.InternalHTTPConfig{
+					Path: aws.String("api"),
+				}
+			}),
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				svc.addons = mockTemplater{tpl: ""}
+			},
+			wantedErr: fmt.Errorf("service frontend: %w", errInternalLBWithoutPort),
+		},
 		"failed parsing svc template": {
 			manifest: testBackendSvcManifest,
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
@@ -116,6 +202,155 @@ func TestBackendService_Template(t *testing.T) {
 				svc.parser = m
 				svc.addons = mockTemplater{
 					tpl: `Outputs:
+  Hello:
+    Value: hello`,
+				}
+			},
+			wantedTemplate: "template",
+		},
+		"render template with environment file": {
+			manifest:     testBackendSvcManifest,
+			inEnvFileURL: "https://stackset-bucket.s3.us-west-2.amazonaws.com/manual/1612345678/frontend.env",
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().ParseBackendService(template.ServiceOpts{
+					EnvFile: &template.EnvFileOpts{
+						Bucket: "stackset-bucket",
+						Key:    "manual/1612345678/frontend.env",
+					},
+					HealthCheck: &ecs.HealthCheck{
+						Command:     aws.StringSlice([]string{"CMD-SHELL", "curl -f http://localhost/ || exit 1"}),
+						Interval:    aws.Int64(5),
+						Retries:     aws.Int64(3),
+						StartPeriod: aws.Int64(0),
+						Timeout:     aws.Int64(10),
+					},
+					NestedStack: &template.ServiceNestedStackOpts{
+						StackName:       addon.StackName,
+						VariableOutputs: []string{"Hello"},
+					},
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{
+					tpl: `Outputs:
+  Hello:
+    Value: hello`,
+				}
+			},
+			wantedTemplate: "template",
+		},
+		"failed applying the cfn overrides": {
+			manifest: newTestManifest(func(mft *manifest.BackendService) {
+				mft.CFNOverrides = []manifest.CFNOverride{
+					{
+						Path:  "/Resources/Service/Properties/PlatformVersion",
+						Value: yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "1.4.0"},
+					},
+				}
+			}),
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().ParseBackendService(gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString(`Resources:
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+`)}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{tpl: ""}
+			},
+			wantedErr: fmt.Errorf("service frontend: %w", fmt.Errorf("apply cfn override /Resources/Service/Properties/PlatformVersion: %w",
+				errors.New("/Resources/Service does not exist in the template"))),
+		},
+		"render template with cfn overrides": {
+			manifest: newTestManifest(func(mft *manifest.BackendService) {
+				mft.CFNOverrides = []manifest.CFNOverride{
+					{
+						Op:    "add",
+						Path:  "/Resources/Service/Properties/PlatformVersion",
+						Value: yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "1.4.0"},
+					},
+				}
+			}),
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().ParseBackendService(gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString(`Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      LaunchType: FARGATE
+`)}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{tpl: ""}
+			},
+			wantedTemplate: `Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      LaunchType: FARGATE
+      PlatformVersion: 1.4.0
+`,
+		},
+		"render template with permissions": {
+			manifest: newTestManifest(func(mft *manifest.BackendService) {
+				mft.Permissions = &manifest.Permissions{
+					ManagedPolicies: []string{"arn:aws:iam::aws:policy/AmazonSQSFullAccess"},
+					Statements: []manifest.PolicyStatement{
+						{
+							Actions:   []string{"sqs:SendMessage"},
+							Resources: []string{"arn:aws:sqs:us-west-2:123456789012:orders"},
+						},
+					},
+				}
+			}),
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().ParseBackendService(gomock.Any()).DoAndReturn(func(opts template.ServiceOpts) (*template.Content, error) {
+					require.Equal(t, &template.PermissionsOpts{
+						ManagedPolicies: []string{"arn:aws:iam::aws:policy/AmazonSQSFullAccess"},
+						Statements: []*template.PolicyStatementOpts{
+							{
+								Effect:    "Allow",
+								Actions:   []string{"sqs:SendMessage"},
+								Resources: []string{"arn:aws:sqs:us-west-2:123456789012:orders"},
+							},
+						},
+					}, opts.Permissions)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+				svc.parser = m
+				svc.addons = mockTemplater{tpl: ""}
+			},
+			wantedTemplate: "template",
+		},
+		"render template with internal load balancer": {
+			manifest: newTestManifest(func(mft *manifest.BackendService) {
+				mft.HTTP = manifest.InternalHTTPConfig{
+					Path: aws.String("api"),
+					HealthCheck: manifest.HealthCheckArgsOrString{
+						HealthCheckPath: aws.String("/healthz"),
+					},
+					Stickiness: aws.Bool(true),
+				}
+			}),
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().ParseBackendService(template.ServiceOpts{
+					InternalLoadBalancer: true,
+					RulePriorityLambda:   "lambda",
+					TargetGroup: template.TargetGroupOpts{
+						HealthCheck: template.HTTPHealthCheckOpts{
+							HealthCheckPath: "/healthz",
+						},
+						Stickiness: true,
+					},
+					NestedStack: &template.ServiceNestedStackOpts{
+						StackName:       addon.StackName,
+						VariableOutputs: []string{"Hello"},
+					},
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{
+					tpl: `Outputs:
   Hello:
     Value: hello`,
 				}
@@ -134,9 +369,11 @@ func TestBackendService_Template(t *testing.T) {
 					name: aws.StringValue(testBackendSvcManifest.Name),
 					env:  testEnvName,
 					app:  testAppName,
+					tc:   tc.manifest.TaskConfig,
 					rc: RuntimeConfig{
 						ImageRepoURL: testImageRepoURL,
 						ImageTag:     testImageTag,
+						EnvFileURL:   tc.inEnvFileURL,
 					},
 				},
 				manifest: tc.manifest,
@@ -216,3 +453,40 @@ func TestBackendService_Parameters(t *testing.T) {
 		},
 	}, params)
 }
+
+func TestBackendService_Parameters_InternalLoadBalancer(t *testing.T) {
+	// GIVEN
+	mft := manifest.NewBackendService(manifest.BackendServiceProps{
+		ServiceProps: manifest.ServiceProps{
+			Name:       "frontend",
+			Dockerfile: "./frontend/Dockerfile",
+		},
+		Port: 8080,
+	})
+	mft.HTTP = manifest.InternalHTTPConfig{
+		Path: aws.String("api"),
+	}
+	conf := &BackendService{
+		svc: &svc{
+			name: aws.StringValue(mft.Name),
+			env:  testEnvName,
+			app:  testAppName,
+			tc:   mft.BackendServiceConfig.TaskConfig,
+			rc: RuntimeConfig{
+				ImageRepoURL: testImageRepoURL,
+				ImageTag:     testImageTag,
+			},
+		},
+		manifest: mft,
+	}
+
+	// WHEN
+	params, err := conf.Parameters()
+
+	// THEN
+	require.NoError(t, err)
+	require.Contains(t, params, &cloudformation.Parameter{
+		ParameterKey:   aws.String(BackendServiceRulePathParamKey),
+		ParameterValue: aws.String("api"),
+	})
+}
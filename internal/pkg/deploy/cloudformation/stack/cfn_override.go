@@ -0,0 +1,132 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"gopkg.in/yaml.v3"
+)
+
+const cfnOverrideAppendIndex = "-" // Last segment of a path that appends the value to a list.
+
+// applyCFNOverrides returns the template with the value of each override set at its path.
+// Every key or index of a path must exist in the template. Only overrides with the "add" operation can
+// add a new key to a map or insert an item in a list, and any override can append to a list with "-".
+// If there are no overrides, then the template is returned as is.
+func applyCFNOverrides(tpl string, overrides []manifest.CFNOverride) (string, error) {
+	if len(overrides) == 0 {
+		return tpl, nil
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(tpl), &doc); err != nil {
+		return "", fmt.Errorf("unmarshal template: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return "", errors.New("template is empty")
+	}
+	for _, override := range overrides {
+		if err := applyCFNOverride(doc.Content[0], override); err != nil {
+			return "", fmt.Errorf("apply cfn override %s: %w", override.Path, err)
+		}
+	}
+	out, err := template.EncodeYAML(&doc)
+	if err != nil {
+		return "", fmt.Errorf("marshal template: %w", err)
+	}
+	return string(out), nil
+}
+
+func applyCFNOverride(root *yaml.Node, override manifest.CFNOverride) error {
+	segments := override.Segments()
+	parent := root
+	for i, segment := range segments[:len(segments)-1] {
+		child, err := cfnOverrideChild(parent, segment)
+		if err != nil {
+			return err
+		}
+		if child == nil {
+			return fmt.Errorf("%s does not exist in the template", "/"+strings.Join(segments[:i+1], "/"))
+		}
+		parent = child
+	}
+	value := override.Value
+	last := segments[len(segments)-1]
+	path := "/" + strings.Join(segments, "/")
+	switch parent.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(parent.Content); i += 2 {
+			if parent.Content[i].Value != last {
+				continue
+			}
+			if override.Adds() {
+				return fmt.Errorf(`%s already exists in the template, remove "op: add" to replace it`, path)
+			}
+			parent.Content[i+1] = &value
+			return nil
+		}
+		if !override.Adds() {
+			return fmt.Errorf(`%s does not exist in the template, set "op: add" to add it`, path)
+		}
+		parent.Content = append(parent.Content, &yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Tag:   "!!str",
+			Value: last,
+		}, &value)
+		return nil
+	case yaml.SequenceNode:
+		if last == cfnOverrideAppendIndex {
+			parent.Content = append(parent.Content, &value)
+			return nil
+		}
+		index, err := cfnOverrideIndex(parent, last)
+		if err != nil {
+			return err
+		}
+		if override.Adds() {
+			parent.Content = append(parent.Content[:index], append([]*yaml.Node{&value}, parent.Content[index:]...)...)
+			return nil
+		}
+		parent.Content[index] = &value
+		return nil
+	default:
+		return fmt.Errorf("%s is neither a map nor a list", "/"+strings.Join(segments[:len(segments)-1], "/"))
+	}
+}
+
+func cfnOverrideChild(node *yaml.Node, segment string) (*yaml.Node, error) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == segment {
+				return node.Content[i+1], nil
+			}
+		}
+		return nil, nil
+	case yaml.SequenceNode:
+		index, err := cfnOverrideIndex(node, segment)
+		if err != nil {
+			return nil, err
+		}
+		return node.Content[index], nil
+	default:
+		return nil, nil
+	}
+}
+
+func cfnOverrideIndex(list *yaml.Node, segment string) (int, error) {
+	index, err := strconv.Atoi(segment)
+	if err != nil {
+		return 0, fmt.Errorf("index %s of a list must be an integer", segment)
+	}
+	if index < 0 || index >= len(list.Content) {
+		return 0, fmt.Errorf("index %d is out of range for a list of %d items", index, len(list.Content))
+	}
+	return index, nil
+}
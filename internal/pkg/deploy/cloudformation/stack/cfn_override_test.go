@@ -0,0 +1,170 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestApplyCFNOverrides(t *testing.T) {
+	const tpl = `Resources:
+  # The task definition.
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: !Ref TaskCPU
+      ContainerDefinitions:
+        - Name: !Ref WorkloadName
+          Essential: true
+`
+	testCases := map[string]struct {
+		inOverrides string
+
+		wantedTemplate string
+		wantedErr      error
+	}{
+		"returns the template as is without overrides": {
+			wantedTemplate: `Resources:
+  # The task definition.
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: !Ref TaskCPU
+      ContainerDefinitions:
+        - Name: !Ref WorkloadName
+          Essential: true
+`,
+		},
+		"replaces, adds and appends values": {
+			inOverrides: `
+- path: /Resources/TaskDefinition/Properties/Cpu
+  value: 1024
+- op: add
+  path: /Resources/TaskDefinition/Properties/ContainerDefinitions/0/Ulimits
+  value:
+    - Name: nofile
+      SoftLimit: 1024
+      HardLimit: 2048
+- path: /Resources/TaskDefinition/Properties/ContainerDefinitions/-
+  value:
+    Name: !Sub '${WorkloadName}-init'
+    Essential: false
+`,
+			wantedTemplate: `Resources:
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: 1024
+      ContainerDefinitions:
+        - Name: !Ref WorkloadName
+          Essential: true
+          Ulimits:
+            - Name: nofile
+              SoftLimit: 1024
+              HardLimit: 2048
+        - Name: !Sub '${WorkloadName}-init'
+          Essential: false
+`,
+		},
+		"inserts an item in a list": {
+			inOverrides: `
+- op: add
+  path: /Resources/TaskDefinition/Properties/ContainerDefinitions/0
+  value:
+    Name: init
+`,
+			wantedTemplate: `Resources:
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: !Ref TaskCPU
+      ContainerDefinitions:
+        - Name: init
+        - Name: !Ref WorkloadName
+          Essential: true
+`,
+		},
+		"unescapes slashes in keys": {
+			inOverrides: `
+- op: add
+  path: /Resources/TaskDefinition/Properties/a~1b
+  value: c
+`,
+			wantedTemplate: `Resources:
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Cpu: !Ref TaskCPU
+      ContainerDefinitions:
+        - Name: !Ref WorkloadName
+          Essential: true
+      a/b: c
+`,
+		},
+		"error if a key does not exist": {
+			inOverrides: `
+- path: /Resources/Service/Properties/PlatformVersion
+  value: LATEST
+`,
+			wantedErr: errors.New("apply cfn override /Resources/Service/Properties/PlatformVersion: /Resources/Service does not exist in the template"),
+		},
+		"error if the last key does not exist without the add operation": {
+			inOverrides: `
+- path: /Resources/TaskDefinition/Properties/Memroy
+  value: 2048
+`,
+			wantedErr: errors.New(`apply cfn override /Resources/TaskDefinition/Properties/Memroy: /Resources/TaskDefinition/Properties/Memroy does not exist in the template, set "op: add" to add it`),
+		},
+		"error if the last key already exists with the add operation": {
+			inOverrides: `
+- op: add
+  path: /Resources/TaskDefinition/Properties/Cpu
+  value: 1024
+`,
+			wantedErr: errors.New(`apply cfn override /Resources/TaskDefinition/Properties/Cpu: /Resources/TaskDefinition/Properties/Cpu already exists in the template, remove "op: add" to replace it`),
+		},
+		"error if an index is out of range": {
+			inOverrides: `
+- path: /Resources/TaskDefinition/Properties/ContainerDefinitions/1/Essential
+  value: false
+`,
+			wantedErr: errors.New("apply cfn override /Resources/TaskDefinition/Properties/ContainerDefinitions/1/Essential: index 1 is out of range for a list of 1 items"),
+		},
+		"error if an index is not an integer": {
+			inOverrides: `
+- path: /Resources/TaskDefinition/Properties/ContainerDefinitions/first
+  value: {}
+`,
+			wantedErr: errors.New("apply cfn override /Resources/TaskDefinition/Properties/ContainerDefinitions/first: index first of a list must be an integer"),
+		},
+		"error if the parent is a scalar": {
+			inOverrides: `
+- path: /Resources/TaskDefinition/Type/Name
+  value: foo
+`,
+			wantedErr: errors.New("apply cfn override /Resources/TaskDefinition/Type/Name: /Resources/TaskDefinition/Type is neither a map nor a list"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var overrides []manifest.CFNOverride
+			require.NoError(t, yaml.Unmarshal([]byte(tc.inOverrides), &overrides))
+
+			got, err := applyCFNOverrides(tpl, overrides)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTemplate, got)
+		})
+	}
+}
@@ -38,11 +38,18 @@ const (
 	envParamAppDNSKey                = "AppDNSName"
 	envParamAppDNSDelegationRoleKey  = "AppDNSDelegationRole"
 
+	// EnvParamIncludeInternalLBKey is the parameter key that toggles the internal load balancer.
+	EnvParamIncludeInternalLBKey = "IncludeInternalLoadBalancer"
+
 	// Output keys.
-	EnvOutputCFNExecutionRoleARN       = "CFNExecutionRoleARN"
-	EnvOutputManagerRoleKey            = "EnvironmentManagerRoleARN"
-	EnvOutputPublicLoadBalancerDNSName = "PublicLoadBalancerDNSName"
-	EnvOutputSubdomain                 = "EnvironmentSubdomain"
+	EnvOutputCFNExecutionRoleARN         = "CFNExecutionRoleARN"
+	EnvOutputManagerRoleKey              = "EnvironmentManagerRoleARN"
+	EnvOutputPublicLoadBalancerDNSName   = "PublicLoadBalancerDNSName"
+	EnvOutputInternalLoadBalancerDNSName = "InternalLoadBalancerDNSName"
+	EnvOutputSubdomain                   = "EnvironmentSubdomain"
+	EnvOutputVPCID                       = "VpcId"
+	EnvOutputPublicSubnets               = "PublicSubnets"
+	EnvOutputPrivateSubnets              = "PrivateSubnets"
 
 	// Default parameter values
 	DefaultVPCCIDR            = "10.0.0.0/16"
@@ -4,12 +4,15 @@
 package stack
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 )
@@ -17,6 +20,8 @@ import (
 // Template rendering configuration.
 const (
 	lbWebSvcRulePriorityGeneratorPath = "custom-resources/alb-rule-priority-generator.js"
+	lbWebSvcDNSCertValidatorPath      = "custom-resources/dns-cert-validator.js"
+	lbWebSvcCustomDomainPath          = "custom-resources/custom-domain.js"
 )
 
 // Parameter logical IDs for a load balanced web service.
@@ -27,6 +32,20 @@ const (
 	LBWebServiceHealthCheckPathParamKey = "HealthCheckPath"
 	LBWebServiceTargetContainerParamKey = "TargetContainer"
 	LBWebServiceTargetPortParamKey      = "TargetPort"
+	LBWebServiceDNSAliasParamKey        = "DNSAlias"
+)
+
+// Output keys for a load balanced web service.
+const (
+	LBWebServiceHTTPSAdditionalRoutesOutputKey = "HTTPSAdditionalRoutes" // Comma-separated URLs of the additional listener rules.
+	LBWebServiceHTTPAdditionalRoutesOutputKey  = "HTTPAdditionalRoutes"
+	LBWebServiceNLBEndpointOutputKey           = "NLBEndpoint" // Address of the network load balancer, such as "tls://svc-nlb.test.app.domain.com:8883".
+)
+
+var (
+	errAliasWithoutDomain    = errors.New("cannot specify http.alias when the application is not associated with a domain")
+	errNLBAliasWithoutDomain = errors.New("cannot specify nlb.alias when the application is not associated with a domain")
+	errNLBTLSWithoutDomain   = errors.New("cannot terminate TLS on the network load balancer when the application is not associated with a domain")
 )
 
 type loadBalancedWebSvcReadParser interface {
@@ -39,6 +58,7 @@ type LoadBalancedWebService struct {
 	*svc
 	manifest     *manifest.LoadBalancedWebService
 	httpsEnabled bool
+	app          deploy.AppInformation // Only set if the application is associated with a domain.
 
 	parser loadBalancedWebSvcReadParser
 }
@@ -74,12 +94,13 @@ func NewLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env, app st
 // NewHTTPSLoadBalancedWebService  creates a new LoadBalancedWebService stack from its manifest that needs to be deployed to
 // a environment within an application. It creates an HTTPS listener and assumes that the environment
 // it's being deployed into has an HTTPS configured listener.
-func NewHTTPSLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env, app string, rc RuntimeConfig) (*LoadBalancedWebService, error) {
-	webSvc, err := NewLoadBalancedWebService(mft, env, app, rc)
+func NewHTTPSLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env string, app deploy.AppInformation, rc RuntimeConfig) (*LoadBalancedWebService, error) {
+	webSvc, err := NewLoadBalancedWebService(mft, env, app.Name, rc)
 	if err != nil {
 		return nil, err
 	}
 	webSvc.httpsEnabled = true
+	webSvc.app = app
 	return webSvc, nil
 }
 
@@ -93,22 +114,197 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	sidecars, err := s.manifest.Sidecar.SidecarsOpts()
+	sidecars, err := s.manifest.Sidecar.SidecarsOpts(s.manifest.MainContainer())
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
+	dependsOn, err := s.manifest.Sidecar.MainContainerDependsOnOpts(s.manifest.MainContainer())
+	if err != nil {
+		return "", fmt.Errorf("convert the container dependencies for service %s: %w", s.name, err)
+	}
+	storage, err := s.manifest.Storage.StorageOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the storage configuration for service %s: %w", s.name, err)
+	}
+	permissions, err := s.manifest.Permissions.PermissionsOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the permissions for service %s: %w", s.name, err)
+	}
+	capacityProviders, err := s.manifest.Platform.CapacityProviderOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the platform configuration for service %s: %w", s.name, err)
+	}
+	runtimePlatform, err := s.manifest.RuntimePlatformOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the platform configuration for service %s: %w", s.name, err)
+	}
+	secrets, err := s.manifest.SecretOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the secrets for service %s: %w", s.name, err)
+	}
+	envFile, err := s.rc.envFileOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the environment file for service %s: %w", s.name, err)
+	}
+	targetGroup, err := s.manifest.TargetGroupOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the target group configuration for service %s: %w", s.name, err)
+	}
+	autoscaling, err := s.autoscalingOpts()
+	if err != nil {
+		return "", err
+	}
+	deploymentConfig, err := s.deploymentConfigOpts(s.manifest.Deployment)
+	if err != nil {
+		return "", err
+	}
+	alias, err := s.aliasOpts()
+	if err != nil {
+		return "", err
+	}
+	rules, err := s.manifest.RoutingRule.ListenerRulesOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the additional rules for service %s: %w", s.name, err)
+	}
+	nlb, err := s.nlbOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the network load balancer configuration for service %s: %w", s.name, err)
+	}
+	var certValidatorLambda, customDomainLambda string
+	if alias != nil {
+		certValidator, err := s.parser.Read(lbWebSvcDNSCertValidatorPath)
+		if err != nil {
+			return "", err
+		}
+		customDomain, err := s.parser.Read(lbWebSvcCustomDomainPath)
+		if err != nil {
+			return "", err
+		}
+		certValidatorLambda, customDomainLambda = certValidator.String(), customDomain.String()
+	}
 	content, err := s.parser.ParseLoadBalancedWebService(template.ServiceOpts{
-		Variables:          s.manifest.Variables,
-		Secrets:            s.manifest.Secrets,
-		NestedStack:        outputs,
-		Sidecars:           sidecars,
-		LogConfig:          s.manifest.LogConfigOpts(),
-		RulePriorityLambda: rulePriorityLambda.String(),
+		Variables:              s.manifest.Variables,
+		EnvFile:                envFile,
+		Secrets:                secrets,
+		NestedStack:            outputs,
+		Sidecars:               sidecars,
+		DependsOn:              dependsOn,
+		LogConfig:              s.manifest.LogConfigOpts(),
+		Storage:                storage,
+		Permissions:            permissions,
+		CapacityProviders:      capacityProviders,
+		RuntimePlatform:        runtimePlatform,
+		EnableExec:             aws.BoolValue(s.manifest.Exec),
+		Autoscaling:            autoscaling,
+		DeploymentConfig:       deploymentConfig,
+		RulePriorityLambda:     rulePriorityLambda.String(),
+		TargetGroup:            targetGroup,
+		Alias:                  alias,
+		ListenerRules:          rules,
+		NLB:                    nlb,
+		DNSCertValidatorLambda: certValidatorLambda,
+		CustomDomainLambda:     customDomainLambda,
 	})
 	if err != nil {
 		return "", err
 	}
-	return content.String(), nil
+	tpl, err := applyCFNOverrides(content.String(), s.manifest.CFNOverrides)
+	if err != nil {
+		return "", fmt.Errorf("service %s: %w", s.name, err)
+	}
+	return tpl, nil
+}
+
+// aliasOpts returns the configuration to route the alias in the manifest to the service, or nil if there is no alias.
+func (s *LoadBalancedWebService) aliasOpts() (*template.AliasOpts, error) {
+	alias := aws.StringValue(s.manifest.Alias)
+	if alias == "" {
+		return nil, nil
+	}
+	if !s.httpsEnabled {
+		return nil, errAliasWithoutDomain
+	}
+	// Copilot can only write records in the application's hosted zone, which its DNS delegation role has access to.
+	appDomain := fmt.Sprintf("%s.%s", s.app.Name, s.app.DNSName)
+	if alias != appDomain && !strings.HasSuffix(alias, "."+appDomain) {
+		return nil, fmt.Errorf("alias %s must be the application's domain %s or one of its subdomains", alias, appDomain)
+	}
+	envDomain := fmt.Sprintf("%s.%s", s.env, appDomain)
+	return &template.AliasOpts{
+		AppDomainName:         appDomain,
+		AppAccountID:          s.app.AccountID,
+		DNSDelegationRoleName: dnsDelegationRoleName(s.app.Name),
+		RequiresCert:          !isCoveredByEnvCert(alias, envDomain),
+	}, nil
+}
+
+// isCoveredByEnvCert returns true if the environment's certificate, issued for its domain and the wildcard one level
+// below it, already protects the alias.
+func isCoveredByEnvCert(alias, envDomain string) bool {
+	if alias == envDomain {
+		return true
+	}
+	label := strings.TrimSuffix(alias, "."+envDomain)
+	return label != alias && !strings.Contains(label, ".")
+}
+
+// nlbOpts returns the configuration of the service's network load balancer, or nil if the service doesn't have one.
+func (s *LoadBalancedWebService) nlbOpts() (*template.NetworkLoadBalancerOpts, error) {
+	nlb := s.manifest.NLBConfig
+	if nlb.IsEmpty() {
+		return nil, nil
+	}
+	port, protocol, err := nlb.Port.Parse()
+	if err != nil {
+		return nil, err
+	}
+	opts := &template.NetworkLoadBalancerOpts{
+		Port:            strconv.FormatUint(uint64(port), 10),
+		Protocol:        strings.ToUpper(protocol),
+		TargetContainer: s.name,
+		TargetPort:      strconv.FormatUint(uint64(port), 10),
+	}
+	if nlb.TargetPort != nil {
+		opts.TargetPort = strconv.FormatUint(uint64(aws.Uint16Value(nlb.TargetPort)), 10)
+	}
+	if name := aws.StringValue(nlb.TargetContainer); name != "" && name != s.name {
+		sidecar, ok := s.manifest.Sidecars[name]
+		if !ok {
+			return nil, fmt.Errorf("target container %s doesn't exist", name)
+		}
+		sidecarPort, err := sidecar.ExposedPort()
+		if err != nil {
+			return nil, err
+		}
+		if nlb.TargetPort != nil && opts.TargetPort != sidecarPort {
+			return nil, fmt.Errorf("target port %s must be the port %s exposed by sidecar %s", opts.TargetPort, sidecarPort, name)
+		}
+		opts.TargetContainer, opts.TargetPort = name, sidecarPort
+	} else {
+		// The main container only maps image.port, so the load balancer needs its own port mapping otherwise.
+		opts.ExposeTargetPort = opts.TargetPort != strconv.FormatUint(uint64(aws.Uint16Value(s.manifest.Image.Port)), 10)
+	}
+	if protocol == manifest.NLBProtocolTLS {
+		if !s.httpsEnabled {
+			return nil, errNLBTLSWithoutDomain
+		}
+		opts.SSLPolicy = nlb.SSLPolicy
+	}
+	if alias := aws.StringValue(nlb.Alias); alias != "" {
+		if !s.httpsEnabled {
+			return nil, errNLBAliasWithoutDomain
+		}
+		// Unlike http.alias, the record is created in the environment's hosted zone.
+		envDomain := fmt.Sprintf("%s.%s.%s", s.env, s.app.Name, s.app.DNSName)
+		if alias != envDomain && !strings.HasSuffix(alias, "."+envDomain) {
+			return nil, fmt.Errorf("alias %s must be the environment's domain %s or one of its subdomains", alias, envDomain)
+		}
+		if protocol == manifest.NLBProtocolTLS && !isCoveredByEnvCert(alias, envDomain) {
+			return nil, fmt.Errorf("alias %s must be covered by the environment's certificate for %s and *.%s", alias, envDomain, envDomain)
+		}
+		opts.Alias = aws.String(alias)
+	}
+	return opts, nil
 }
 
 func (s *LoadBalancedWebService) loadBalancerTarget() (targetContainer *string, targetPort *string, err error) {
@@ -132,11 +328,15 @@ func (s *LoadBalancedWebService) loadBalancerTarget() (targetContainer *string,
 
 // Parameters returns the list of CloudFormation parameters used by the template.
 func (s *LoadBalancedWebService) Parameters() ([]*cloudformation.Parameter, error) {
+	svcParams, err := s.svc.Parameters()
+	if err != nil {
+		return nil, err
+	}
 	targetContainer, targetPort, err := s.loadBalancerTarget()
 	if err != nil {
 		return nil, err
 	}
-	return append(s.svc.Parameters(), []*cloudformation.Parameter{
+	return append(svcParams, []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(LBWebServiceContainerPortParamKey),
 			ParameterValue: aws.String(strconv.FormatUint(uint64(aws.Uint16Value(s.manifest.Image.Port)), 10)),
@@ -147,7 +347,7 @@ func (s *LoadBalancedWebService) Parameters() ([]*cloudformation.Parameter, erro
 		},
 		{
 			ParameterKey:   aws.String(LBWebServiceHealthCheckPathParamKey),
-			ParameterValue: s.manifest.HealthCheckPath,
+			ParameterValue: aws.String(s.manifest.HealthCheck.Path()),
 		},
 		{
 			ParameterKey:   aws.String(LBWebServiceHTTPSParamKey),
@@ -161,6 +361,10 @@ func (s *LoadBalancedWebService) Parameters() ([]*cloudformation.Parameter, erro
 			ParameterKey:   aws.String(LBWebServiceTargetPortParamKey),
 			ParameterValue: targetPort,
 		},
+		{
+			ParameterKey:   aws.String(LBWebServiceDNSAliasParamKey),
+			ParameterValue: aws.String(aws.StringValue(s.manifest.Alias)),
+		},
 	}...), nil
 }
 
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -142,6 +143,9 @@ func TestLoadBalancedWebService_Template(t *testing.T) {
 				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
 				m.EXPECT().ParseLoadBalancedWebService(template.ServiceOpts{
 					RulePriorityLambda: "lambda",
+					TargetGroup: template.TargetGroupOpts{
+						HealthCheck: template.HTTPHealthCheckOpts{HealthCheckPath: "/"},
+					},
 				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
 
 				addons := mockTemplater{err: &addon.ErrDirNotExist{}}
@@ -151,6 +155,374 @@ func TestLoadBalancedWebService_Template(t *testing.T) {
 
 			wantedTemplate: "template",
 		},
+		"render template with autoscaling": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(template.ServiceOpts{
+					Autoscaling: &template.AutoscalingOpts{
+						MinCapacity: 2,
+						MaxCapacity: 8,
+						CPU:         aws.Int(70),
+						Requests:    aws.Int(1000),
+					},
+					RulePriorityLambda: "lambda",
+					TargetGroup: template.TargetGroupOpts{
+						HealthCheck: template.HTTPHealthCheckOpts{HealthCheckPath: "/"},
+					},
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+
+				scalingRange := manifest.Range("2-8")
+				c.svc.tc.Count.Autoscaling = manifest.Autoscaling{
+					Range:    &scalingRange,
+					CPU:      aws.Int(70),
+					Requests: aws.Int(1000),
+				}
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+
+			wantedTemplate: "template",
+		},
+		"invalid autoscaling percentage": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+
+				scalingRange := manifest.Range("2-8")
+				c.svc.tc.Count.Autoscaling = manifest.Autoscaling{
+					Range:  &scalingRange,
+					Memory: aws.Int(120),
+				}
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+
+			wantedError: errors.New(`"memory_percentage" for service frontend must be between 1 and 100`),
+		},
+		"render template with deployment configuration": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(template.ServiceOpts{
+					DeploymentConfig: &template.DeploymentConfigurationOpts{
+						MinHealthyPercent: 50,
+						MaxPercent:        200,
+						CircuitBreaker:    true,
+						RollbackAlarms:    []string{"frontend-5xx"},
+					},
+					RulePriorityLambda: "lambda",
+					TargetGroup: template.TargetGroupOpts{
+						HealthCheck: template.HTTPHealthCheckOpts{HealthCheckPath: "/"},
+					},
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+
+				mft := *testLBWebServiceManifest
+				mft.Deployment = manifest.DeploymentConfig{
+					CircuitBreaker:    aws.Bool(true),
+					MinHealthyPercent: aws.Int(50),
+					RollbackAlarms:    []string{"frontend-5xx"},
+				}
+				c.manifest = &mft
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+
+			wantedTemplate: "template",
+		},
+		"render template with target group configuration": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(template.ServiceOpts{
+					RulePriorityLambda: "lambda",
+					TargetGroup: template.TargetGroupOpts{
+						HealthCheck: template.HTTPHealthCheckOpts{
+							HealthCheckPath:  "/health",
+							SuccessCodes:     aws.String("200-299"),
+							HealthyThreshold: aws.Int64(3),
+							Interval:         aws.Int64(15),
+							GracePeriod:      aws.Int64(120),
+						},
+						Stickiness:          true,
+						DeregistrationDelay: aws.Int64(30),
+					},
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+
+				interval, gracePeriod, delay := 15*time.Second, 2*time.Minute, 30*time.Second
+				mft := *testLBWebServiceManifest
+				mft.HealthCheck = manifest.HealthCheckArgsOrString{
+					HealthCheckArgs: manifest.HTTPHealthCheckArgs{
+						Path:             aws.String("/health"),
+						SuccessCodes:     aws.String("200-299"),
+						HealthyThreshold: aws.Int64(3),
+						Interval:         &interval,
+						GracePeriod:      &gracePeriod,
+					},
+				}
+				mft.Stickiness = aws.Bool(true)
+				mft.DeregistrationDelay = &delay
+				c.manifest = &mft
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+
+			wantedTemplate: "template",
+		},
+		"render template with exec enabled": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(template.ServiceOpts{
+					EnableExec:         true,
+					RulePriorityLambda: "lambda",
+					TargetGroup: template.TargetGroupOpts{
+						HealthCheck: template.HTTPHealthCheckOpts{HealthCheckPath: "/"},
+					},
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+
+				mft := *testLBWebServiceManifest
+				mft.Exec = aws.Bool(true)
+				c.manifest = &mft
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+
+			wantedTemplate: "template",
+		},
+		"render template with an alias in the application's hosted zone": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().Read(lbWebSvcDNSCertValidatorPath).Return(&template.Content{Buffer: bytes.NewBufferString("cert")}, nil)
+				m.EXPECT().Read(lbWebSvcCustomDomainPath).Return(&template.Content{Buffer: bytes.NewBufferString("domain")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(template.ServiceOpts{
+					RulePriorityLambda: "lambda",
+					TargetGroup: template.TargetGroupOpts{
+						HealthCheck: template.HTTPHealthCheckOpts{HealthCheckPath: "/"},
+					},
+					Alias: &template.AliasOpts{
+						AppDomainName:         "phonetool.example.com",
+						AppAccountID:          "123456789012",
+						DNSDelegationRoleName: "phonetool-DNSDelegationRole",
+						RequiresCert:          true,
+					},
+					DNSCertValidatorLambda: "cert",
+					CustomDomainLambda:     "domain",
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+
+				mft := *testLBWebServiceManifest
+				mft.Alias = aws.String("www.phonetool.example.com")
+				c.manifest = &mft
+				c.httpsEnabled = true
+				c.app = deploy.AppInformation{
+					Name:      testAppName,
+					AccountID: "123456789012",
+					DNSName:   "example.com",
+				}
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+
+			wantedTemplate: "template",
+		},
+		"render template with an alias covered by the environment's certificate": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().Read(lbWebSvcDNSCertValidatorPath).Return(&template.Content{Buffer: bytes.NewBufferString("cert")}, nil)
+				m.EXPECT().Read(lbWebSvcCustomDomainPath).Return(&template.Content{Buffer: bytes.NewBufferString("domain")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(template.ServiceOpts{
+					RulePriorityLambda: "lambda",
+					TargetGroup: template.TargetGroupOpts{
+						HealthCheck: template.HTTPHealthCheckOpts{HealthCheckPath: "/"},
+					},
+					Alias: &template.AliasOpts{
+						AppDomainName:         "phonetool.example.com",
+						AppAccountID:          "123456789012",
+						DNSDelegationRoleName: "phonetool-DNSDelegationRole",
+					},
+					DNSCertValidatorLambda: "cert",
+					CustomDomainLambda:     "domain",
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+
+				mft := *testLBWebServiceManifest
+				mft.Alias = aws.String("api.test.phonetool.example.com")
+				c.manifest = &mft
+				c.httpsEnabled = true
+				c.app = deploy.AppInformation{
+					Name:      testAppName,
+					AccountID: "123456789012",
+					DNSName:   "example.com",
+				}
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+
+			wantedTemplate: "template",
+		},
+		"alias without a domain": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+
+				mft := *testLBWebServiceManifest
+				mft.Alias = aws.String("www.phonetool.example.com")
+				c.manifest = &mft
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+
+			wantedError: errAliasWithoutDomain,
+		},
+		"alias outside of the application's domain": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+
+				mft := *testLBWebServiceManifest
+				mft.Alias = aws.String("www.example.com")
+				c.manifest = &mft
+				c.httpsEnabled = true
+				c.app = deploy.AppInformation{
+					Name:    testAppName,
+					DNSName: "example.com",
+				}
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+
+			wantedError: errors.New("alias www.example.com must be the application's domain phonetool.example.com or one of its subdomains"),
+		},
+		"render template with a network load balancer terminating TLS": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(template.ServiceOpts{
+					RulePriorityLambda: "lambda",
+					TargetGroup: template.TargetGroupOpts{
+						HealthCheck: template.HTTPHealthCheckOpts{HealthCheckPath: "/"},
+					},
+					NLB: &template.NetworkLoadBalancerOpts{
+						Port:             "8883",
+						Protocol:         "TLS",
+						SSLPolicy:        aws.String("ELBSecurityPolicy-TLS-1-2-2017-01"),
+						TargetContainer:  "frontend",
+						TargetPort:       "1883",
+						ExposeTargetPort: true,
+						Alias:            aws.String("mqtt.test.phonetool.example.com"),
+					},
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+
+				mft := *testLBWebServiceManifest
+				port := manifest.NLBPort("8883/tls")
+				mft.NLBConfig = manifest.NetworkLoadBalancerConfiguration{
+					Port:       &port,
+					TargetPort: aws.Uint16(1883),
+					SSLPolicy:  aws.String("ELBSecurityPolicy-TLS-1-2-2017-01"),
+					Alias:      aws.String("mqtt.test.phonetool.example.com"),
+				}
+				c.manifest = &mft
+				c.httpsEnabled = true
+				c.app = deploy.AppInformation{
+					Name:    testAppName,
+					DNSName: "example.com",
+				}
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+
+			wantedTemplate: "template",
+		},
+		"render template with a network load balancer targeting a sidecar": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(gomock.Any()).DoAndReturn(func(opts template.ServiceOpts) (*template.Content, error) {
+					require.Equal(t, &template.NetworkLoadBalancerOpts{
+						Port:            "5432",
+						Protocol:        "TCP",
+						TargetContainer: "proxy",
+						TargetPort:      "6432",
+					}, opts.NLB)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+
+				mft := *testLBWebServiceManifest
+				port := manifest.NLBPort("5432")
+				mft.NLBConfig = manifest.NetworkLoadBalancerConfiguration{
+					Port:            &port,
+					TargetContainer: aws.String("proxy"),
+				}
+				mft.Sidecars = map[string]*manifest.SidecarConfig{
+					"proxy": {
+						Port: aws.String("6432/tcp"),
+					},
+				}
+				c.manifest = &mft
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+
+			wantedTemplate: "template",
+		},
+		"network load balancer terminating TLS without a domain": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+
+				mft := *testLBWebServiceManifest
+				port := manifest.NLBPort("443/tls")
+				mft.NLBConfig = manifest.NetworkLoadBalancerConfiguration{
+					Port: &port,
+				}
+				c.manifest = &mft
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+
+			wantedError: fmt.Errorf("convert the network load balancer configuration for service frontend: %w", errNLBTLSWithoutDomain),
+		},
+		"network load balancer alias not covered by the environment's certificate": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+
+				mft := *testLBWebServiceManifest
+				port := manifest.NLBPort("443/tls")
+				mft.NLBConfig = manifest.NetworkLoadBalancerConfiguration{
+					Port:  &port,
+					Alias: aws.String("a.b.test.phonetool.example.com"),
+				}
+				c.manifest = &mft
+				c.httpsEnabled = true
+				c.app = deploy.AppInformation{
+					Name:    testAppName,
+					DNSName: "example.com",
+				}
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+
+			wantedError: fmt.Errorf("convert the network load balancer configuration for service frontend: %w",
+				errors.New("alias a.b.test.phonetool.example.com must be covered by the environment's certificate for test.phonetool.example.com and *.test.phonetool.example.com")),
+		},
+		"invalid deployment max percent": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+
+				mft := *testLBWebServiceManifest
+				mft.Deployment = manifest.DeploymentConfig{
+					MaxPercent: aws.Int(50),
+				}
+				c.manifest = &mft
+				c.parser = m
+				c.svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+
+			wantedError: errors.New(`"max_percent" for service frontend must be at least 100`),
+		},
 		"render template with addons": {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
 				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
@@ -163,6 +535,9 @@ func TestLoadBalancedWebService_Template(t *testing.T) {
 						PolicyOutputs:   []string{"AdditionalResourcesPolicyArn"},
 					},
 					RulePriorityLambda: "lambda",
+					TargetGroup: template.TargetGroupOpts{
+						HealthCheck: template.HTTPHealthCheckOpts{HealthCheckPath: "/"},
+					},
 				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
 				addons := mockTemplater{
 					tpl: `Resources:
@@ -309,6 +684,15 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 			ParameterValue: aws.String(""),
 		},
 	}
+	testLBWebServiceManifestWithAlias := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+		ServiceProps: &manifest.ServiceProps{
+			Name:       "frontend",
+			Dockerfile: "frontend/Dockerfile",
+		},
+		Path: "frontend",
+		Port: 80,
+	})
+	testLBWebServiceManifestWithAlias.Alias = aws.String("www.phonetool.example.com")
 	testCases := map[string]struct {
 		httpsEnabled bool
 		manifest     *manifest.LoadBalancedWebService
@@ -333,6 +717,10 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 					ParameterKey:   aws.String(LBWebServiceTargetPortParamKey),
 					ParameterValue: aws.String("80"),
 				},
+				{
+					ParameterKey:   aws.String(LBWebServiceDNSAliasParamKey),
+					ParameterValue: aws.String(""),
+				},
 			}...),
 		},
 		"HTTPS Not Enabled": {
@@ -352,6 +740,10 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 					ParameterKey:   aws.String(LBWebServiceTargetPortParamKey),
 					ParameterValue: aws.String("80"),
 				},
+				{
+					ParameterKey:   aws.String(LBWebServiceDNSAliasParamKey),
+					ParameterValue: aws.String(""),
+				},
 			}...),
 		},
 		"with sidecar container": {
@@ -371,6 +763,33 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 					ParameterKey:   aws.String(LBWebServiceTargetPortParamKey),
 					ParameterValue: aws.String("5000"),
 				},
+				{
+					ParameterKey:   aws.String(LBWebServiceDNSAliasParamKey),
+					ParameterValue: aws.String(""),
+				},
+			}...),
+		},
+		"with alias": {
+			httpsEnabled: true,
+			manifest:     testLBWebServiceManifestWithAlias,
+
+			expectedParams: append(expectedParams, []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(LBWebServiceHTTPSParamKey),
+					ParameterValue: aws.String("true"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceTargetContainerParamKey),
+					ParameterValue: aws.String("frontend"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceTargetPortParamKey),
+					ParameterValue: aws.String("80"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceDNSAliasParamKey),
+					ParameterValue: aws.String("www.phonetool.example.com"),
+				},
 			}...),
 		},
 		"with bad sidecar container": {
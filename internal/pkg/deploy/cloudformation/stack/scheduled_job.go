@@ -0,0 +1,348 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+)
+
+// Parameter logical IDs for a scheduled job.
+const (
+	ScheduledJobScheduleParamKey = "Schedule"
+)
+
+// Predefined schedules and their equivalent EventBridge schedule expressions.
+var predefinedSchedules = map[string]string{
+	"@yearly":   "cron(0 0 1 1 ? *)",
+	"@annually": "cron(0 0 1 1 ? *)",
+	"@monthly":  "cron(0 0 1 * ? *)",
+	"@weekly":   "cron(0 0 ? * 1 *)",
+	"@daily":    "cron(0 0 * * ? *)",
+	"@midnight": "cron(0 0 * * ? *)",
+	"@hourly":   "cron(0 * * * ? *)",
+}
+
+const (
+	everySchedulePrefix = "@every "
+	fmtRateExpression   = "rate(%d %s)"
+	fmtCronExpression   = "cron(%s)"
+)
+
+var (
+	awsScheduleRegexp = regexp.MustCompile(`^(rate|cron)\(.+\)$`)
+	cronDayOfWeekNum  = regexp.MustCompile(`[0-7]`)
+	cronDaysOfWeek    = map[string]int{"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6}
+
+	errScheduleMissing  = errors.New(`"schedule" field is required`)
+	errCronBothDayTypes = errors.New("cron expressions can't specify both a day of the month and a day of the week")
+)
+
+type scheduledJobReadParser interface {
+	template.ReadParser
+	ParseScheduledJob(template.ServiceOpts) (*template.Content, error)
+}
+
+// ScheduledJob represents the configuration needed to create a CloudFormation stack from a scheduled job manifest.
+type ScheduledJob struct {
+	*svc
+	manifest *manifest.ScheduledJob
+
+	parser scheduledJobReadParser
+}
+
+// NewScheduledJob creates a new ScheduledJob stack from a manifest file.
+func NewScheduledJob(mft *manifest.ScheduledJob, env, app string, rc RuntimeConfig) (*ScheduledJob, error) {
+	parser := template.New()
+	addons, err := addon.New(aws.StringValue(mft.Name))
+	if err != nil {
+		return nil, fmt.Errorf("new addons: %w", err)
+	}
+	envManifest, err := mft.ApplyEnv(env) // Apply environment overrides to the manifest values.
+	if err != nil {
+		return nil, fmt.Errorf("apply environment %s override: %s", env, err)
+	}
+	return &ScheduledJob{
+		svc: &svc{
+			name:   aws.StringValue(mft.Name),
+			env:    env,
+			app:    app,
+			tc:     envManifest.ScheduledJobConfig.TaskConfig,
+			rc:     rc,
+			parser: parser,
+			addons: addons,
+		},
+		manifest: envManifest,
+
+		parser: parser,
+	}, nil
+}
+
+// Template returns the CloudFormation template for the scheduled job.
+func (j *ScheduledJob) Template() (string, error) {
+	outputs, err := j.addonsOutputs()
+	if err != nil {
+		return "", err
+	}
+	sidecars, err := j.manifest.Sidecar.SidecarsOpts(j.manifest.MainContainer())
+	if err != nil {
+		return "", fmt.Errorf("convert the sidecar configuration for job %s: %w", j.name, err)
+	}
+	dependsOn, err := j.manifest.Sidecar.MainContainerDependsOnOpts(j.manifest.MainContainer())
+	if err != nil {
+		return "", fmt.Errorf("convert the container dependencies for job %s: %w", j.name, err)
+	}
+	storage, err := j.manifest.Storage.StorageOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the storage configuration for job %s: %w", j.name, err)
+	}
+	permissions, err := j.manifest.Permissions.PermissionsOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the permissions for job %s: %w", j.name, err)
+	}
+	capacityProviders, err := j.manifest.Platform.CapacityProviderOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the platform configuration for job %s: %w", j.name, err)
+	}
+	runtimePlatform, err := j.manifest.RuntimePlatformOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the platform configuration for job %s: %w", j.name, err)
+	}
+	secrets, err := j.manifest.SecretOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the secrets for job %s: %w", j.name, err)
+	}
+	envFile, err := j.rc.envFileOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the environment file for job %s: %w", j.name, err)
+	}
+	stateMachine, err := j.stateMachineOpts()
+	if err != nil {
+		return "", err
+	}
+	content, err := j.parser.ParseScheduledJob(template.ServiceOpts{
+		Variables:         j.manifest.Variables,
+		EnvFile:           envFile,
+		Secrets:           secrets,
+		NestedStack:       outputs,
+		Sidecars:          sidecars,
+		DependsOn:         dependsOn,
+		LogConfig:         j.manifest.LogConfigOpts(),
+		Storage:           storage,
+		Permissions:       permissions,
+		CapacityProviders: capacityProviders,
+		RuntimePlatform:   runtimePlatform,
+		StateMachine:      stateMachine,
+	})
+	if err != nil {
+		return "", fmt.Errorf("parse scheduled job template: %w", err)
+	}
+	tpl, err := applyCFNOverrides(content.String(), j.manifest.CFNOverrides)
+	if err != nil {
+		return "", fmt.Errorf("job %s: %w", j.name, err)
+	}
+	return tpl, nil
+}
+
+// Parameters returns the list of CloudFormation parameters used by the template.
+func (j *ScheduledJob) Parameters() ([]*cloudformation.Parameter, error) {
+	schedule, err := j.awsSchedule()
+	if err != nil {
+		return nil, err
+	}
+	svcParams, err := j.svc.Parameters()
+	if err != nil {
+		return nil, err
+	}
+	var params []*cloudformation.Parameter
+	for _, param := range svcParams {
+		// Jobs run a single task per invocation, so there is no desired count.
+		if aws.StringValue(param.ParameterKey) == ServiceTaskCountParamKey {
+			continue
+		}
+		params = append(params, param)
+	}
+	return append(params, &cloudformation.Parameter{
+		ParameterKey:   aws.String(ScheduledJobScheduleParamKey),
+		ParameterValue: aws.String(schedule),
+	}), nil
+}
+
+// SerializedParameters returns the CloudFormation stack's parameters serialized
+// to a YAML document annotated with comments for readability to users.
+func (j *ScheduledJob) SerializedParameters() (string, error) {
+	return j.svc.templateConfiguration(j)
+}
+
+func (j *ScheduledJob) stateMachineOpts() (*template.StateMachineOpts, error) {
+	var timeout *int
+	if j.manifest.Timeout != nil {
+		d, err := time.ParseDuration(aws.StringValue(j.manifest.Timeout))
+		if err != nil {
+			return nil, fmt.Errorf(`parse "timeout" %s for job %s: %w`, aws.StringValue(j.manifest.Timeout), j.name, err)
+		}
+		if d < time.Second {
+			return nil, fmt.Errorf(`"timeout" for job %s must be at least 1 second`, j.name)
+		}
+		timeout = aws.Int(int(d.Seconds()))
+	}
+	if j.manifest.Retries != nil && aws.IntValue(j.manifest.Retries) < 0 {
+		return nil, fmt.Errorf(`"retries" for job %s must not be negative`, j.name)
+	}
+	if timeout == nil && j.manifest.Retries == nil {
+		return nil, nil
+	}
+	return &template.StateMachineOpts{
+		Timeout: timeout,
+		Retries: j.manifest.Retries,
+	}, nil
+}
+
+// awsSchedule converts the schedule in the manifest into an EventBridge schedule expression.
+// See https://docs.aws.amazon.com/eventbridge/latest/userguide/scheduled-events.html
+func (j *ScheduledJob) awsSchedule() (string, error) {
+	schedule := strings.TrimSpace(aws.StringValue(j.manifest.Schedule))
+	if schedule == "" {
+		return "", fmt.Errorf("job %s: %w", j.name, errScheduleMissing)
+	}
+	expr, err := toAWSSchedule(schedule)
+	if err != nil {
+		return "", fmt.Errorf("convert schedule %s for job %s: %w", schedule, j.name, err)
+	}
+	return expr, nil
+}
+
+// ValidateSchedule returns an error if the schedule can't be converted into an EventBridge schedule expression.
+func ValidateSchedule(schedule string) error {
+	_, err := toAWSSchedule(strings.TrimSpace(schedule))
+	return err
+}
+
+func toAWSSchedule(schedule string) (string, error) {
+	if awsScheduleRegexp.MatchString(schedule) {
+		return schedule, nil
+	}
+	if expr, ok := predefinedSchedules[schedule]; ok {
+		return expr, nil
+	}
+	if strings.HasPrefix(schedule, everySchedulePrefix) {
+		return toRateExpression(strings.TrimPrefix(schedule, everySchedulePrefix))
+	}
+	return toCronExpression(schedule)
+}
+
+// toRateExpression converts a duration such as "30m" into a rate expression such as "rate(30 minutes)".
+func toRateExpression(duration string) (string, error) {
+	d, err := time.ParseDuration(strings.TrimSpace(duration))
+	if err != nil {
+		return "", fmt.Errorf("parse duration: %w", err)
+	}
+	if d < time.Minute || d%time.Minute != 0 {
+		return "", errors.New("duration must be a whole number of minutes")
+	}
+	unit := "minute"
+	value := int(d.Minutes())
+	switch {
+	case d%(24*time.Hour) == 0:
+		unit, value = "day", int(d.Hours()/24)
+	case d%time.Hour == 0:
+		unit, value = "hour", int(d.Hours())
+	}
+	if value > 1 {
+		unit += "s"
+	}
+	return fmt.Sprintf(fmtRateExpression, value, unit), nil
+}
+
+// toCronExpression converts a standard 5-field cron expression into the 6-field EventBridge format.
+// EventBridge requires that either the day-of-month or the day-of-week is "?", and numbers days of the week from 1 (SUN).
+func toCronExpression(schedule string) (string, error) {
+	fields := strings.Fields(schedule)
+	if len(fields) != 5 {
+		return "", fmt.Errorf("cron expression must have 5 fields, got %d", len(fields))
+	}
+	minute, hour, dayOfMonth, month, dayOfWeek := fields[0], fields[1], fields[2], fields[3], fields[4]
+	switch {
+	case dayOfWeek == "*" || dayOfWeek == "?":
+		dayOfWeek = "?"
+	case dayOfMonth == "*" || dayOfMonth == "?":
+		dayOfMonth = "?"
+		days, err := toCronDaysOfWeek(dayOfWeek)
+		if err != nil {
+			return "", err
+		}
+		dayOfWeek = days
+	default:
+		return "", errCronBothDayTypes
+	}
+	return fmt.Sprintf(fmtCronExpression, strings.Join([]string{minute, hour, dayOfMonth, month, dayOfWeek, "*"}, " ")), nil
+}
+
+// toCronDaysOfWeek renumbers the days of the week of a standard cron expression, where both 0 and 7 are SUN,
+// to the EventBridge numbering from 1 (SUN) to 7 (SAT).
+func toCronDaysOfWeek(daysOfWeek string) (string, error) {
+	var days []string
+	for _, day := range strings.Split(daysOfWeek, ",") {
+		// Only shift the days themselves and not the increment of a "day/increment" expression.
+		parts := strings.SplitN(day, "/", 2)
+		bounds := strings.SplitN(parts[0], "-", 2)
+		if len(bounds) == 2 && bounds[1] == "7" {
+			// A range that ends on SUN wraps around to the start of the EventBridge week, so list its days instead.
+			wrapped, err := wrappedDaysOfWeek(bounds[0], parts[1:])
+			if err != nil {
+				return "", err
+			}
+			days = append(days, wrapped...)
+			continue
+		}
+		parts[0] = cronDayOfWeekNum.ReplaceAllStringFunc(parts[0], func(day string) string {
+			n, _ := strconv.Atoi(day) // The regular expression guarantees a single digit.
+			return strconv.Itoa(n%7 + 1)
+		})
+		days = append(days, strings.Join(parts, "/"))
+	}
+	return strings.Join(days, ","), nil
+}
+
+// wrappedDaysOfWeek returns the EventBridge numbers of the days in the range from the start day to SUN (7),
+// every increment days if the range has one.
+func wrappedDaysOfWeek(start string, increment []string) ([]string, error) {
+	from, ok := cronDaysOfWeek[start]
+	if !ok {
+		n, err := strconv.Atoi(start)
+		if err != nil || n < 0 || n > 7 {
+			return nil, fmt.Errorf("invalid day of the week %s", start)
+		}
+		from = n
+	}
+	step := 1
+	if len(increment) != 0 {
+		n, err := strconv.Atoi(increment[0])
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid day of the week increment %s", increment[0])
+		}
+		step = n
+	}
+	var days []string
+	seen := make(map[int]bool)
+	for day := from; day <= 7; day += step {
+		n := day%7 + 1
+		if seen[n] {
+			continue
+		}
+		seen[n] = true
+		days = append(days, strconv.Itoa(n))
+	}
+	return days, nil
+}
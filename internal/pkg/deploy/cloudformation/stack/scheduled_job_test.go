@@ -0,0 +1,290 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestScheduledJobManifest() *manifest.ScheduledJob {
+	return manifest.NewScheduledJob(&manifest.ScheduledJobProps{
+		ServiceProps: &manifest.ServiceProps{
+			Name:       "mailer",
+			Dockerfile: "mailer/Dockerfile",
+		},
+		Schedule: "@daily",
+		Timeout:  "1h30m",
+		Retries:  3,
+	})
+}
+
+func TestScheduledJob_Template(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(t *testing.T, ctrl *gomock.Controller, job *ScheduledJob)
+		manifest         func() *manifest.ScheduledJob
+
+		wantedTemplate string
+		wantedErr      error
+	}{
+		"unexpected addons parsing error": {
+			manifest: newTestScheduledJobManifest,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, job *ScheduledJob) {
+				job.addons = mockTemplater{err: errors.New("some error")}
+			},
+			wantedErr: fmt.Errorf("generate addons template for service mailer: %w", errors.New("some error")),
+		},
+		"invalid timeout": {
+			manifest: func() *manifest.ScheduledJob {
+				mft := newTestScheduledJobManifest()
+				mft.Timeout = aws.String("forever")
+				return mft
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, job *ScheduledJob) {
+				job.addons = mockTemplater{tpl: ""}
+			},
+			wantedErr: errors.New(`parse "timeout" forever for job mailer: time: invalid duration "forever"`),
+		},
+		"failed parsing job template": {
+			manifest: newTestScheduledJobManifest,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, job *ScheduledJob) {
+				m := mocks.NewMockscheduledJobReadParser(ctrl)
+				m.EXPECT().ParseScheduledJob(gomock.Any()).Return(nil, errors.New("some error"))
+				job.parser = m
+				job.addons = mockTemplater{tpl: ""}
+			},
+			wantedErr: fmt.Errorf("parse scheduled job template: %w", errors.New("some error")),
+		},
+		"render template": {
+			manifest: newTestScheduledJobManifest,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, job *ScheduledJob) {
+				m := mocks.NewMockscheduledJobReadParser(ctrl)
+				m.EXPECT().ParseScheduledJob(template.ServiceOpts{
+					NestedStack: &template.ServiceNestedStackOpts{
+						StackName:       addon.StackName,
+						VariableOutputs: []string{"Hello"},
+					},
+					StateMachine: &template.StateMachineOpts{
+						Timeout: aws.Int(5400),
+						Retries: aws.Int(3),
+					},
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				job.parser = m
+				job.addons = mockTemplater{
+					tpl: `Outputs:
+  Hello:
+    Value: hello`,
+				}
+			},
+			wantedTemplate: "template",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mft := tc.manifest()
+			conf := &ScheduledJob{
+				svc: &svc{
+					name: aws.StringValue(mft.Name),
+					env:  testEnvName,
+					app:  testAppName,
+					rc: RuntimeConfig{
+						ImageRepoURL: testImageRepoURL,
+						ImageTag:     testImageTag,
+					},
+				},
+				manifest: mft,
+			}
+			tc.mockDependencies(t, ctrl, conf)
+
+			// WHEN
+			template, err := conf.Template()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTemplate, template)
+		})
+	}
+}
+
+func TestScheduledJob_Parameters(t *testing.T) {
+	// GIVEN
+	mft := newTestScheduledJobManifest()
+	conf := &ScheduledJob{
+		svc: &svc{
+			name: aws.StringValue(mft.Name),
+			env:  testEnvName,
+			app:  testAppName,
+			tc:   mft.TaskConfig,
+			rc: RuntimeConfig{
+				ImageRepoURL: testImageRepoURL,
+				ImageTag:     testImageTag,
+			},
+		},
+		manifest: mft,
+	}
+
+	// WHEN
+	params, err := conf.Parameters()
+
+	// THEN
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String(ServiceAppNameParamKey),
+			ParameterValue: aws.String("phonetool"),
+		},
+		{
+			ParameterKey:   aws.String(ServiceEnvNameParamKey),
+			ParameterValue: aws.String("test"),
+		},
+		{
+			ParameterKey:   aws.String(ServiceNameParamKey),
+			ParameterValue: aws.String("mailer"),
+		},
+		{
+			ParameterKey:   aws.String(ServiceContainerImageParamKey),
+			ParameterValue: aws.String("12345.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:manual-bf3678c"),
+		},
+		{
+			ParameterKey:   aws.String(ServiceTaskCPUParamKey),
+			ParameterValue: aws.String("256"),
+		},
+		{
+			ParameterKey:   aws.String(ServiceTaskMemoryParamKey),
+			ParameterValue: aws.String("512"),
+		},
+		{
+			ParameterKey:   aws.String(ServiceLogRetentionParamKey),
+			ParameterValue: aws.String("30"),
+		},
+		{
+			ParameterKey:   aws.String(ServiceAddonsTemplateURLParamKey),
+			ParameterValue: aws.String(""),
+		},
+		{
+			ParameterKey:   aws.String(ScheduledJobScheduleParamKey),
+			ParameterValue: aws.String("cron(0 0 * * ? *)"),
+		},
+	}, params)
+}
+
+func TestToAWSSchedule(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wanted    string
+		wantedErr error
+	}{
+		"rate expression": {
+			in:     "rate(5 minutes)",
+			wanted: "rate(5 minutes)",
+		},
+		"aws cron expression": {
+			in:     "cron(0 12 * * ? *)",
+			wanted: "cron(0 12 * * ? *)",
+		},
+		"predefined schedule": {
+			in:     "@hourly",
+			wanted: "cron(0 * * * ? *)",
+		},
+		"every minute": {
+			in:     "@every 1m",
+			wanted: "rate(1 minute)",
+		},
+		"every few hours": {
+			in:     "@every 6h",
+			wanted: "rate(6 hours)",
+		},
+		"every day": {
+			in:     "@every 24h",
+			wanted: "rate(1 day)",
+		},
+		"every duration that isn't in minutes": {
+			in:        "@every 30s",
+			wantedErr: errors.New("duration must be a whole number of minutes"),
+		},
+		"cron with any day of the week": {
+			in:     "0 9 1 * *",
+			wanted: "cron(0 9 1 * ? *)",
+		},
+		"cron with numbered days of the week": {
+			in:     "30 9 * * 1-5",
+			wanted: "cron(30 9 ? * 2-6 *)",
+		},
+		"cron with named days of the week": {
+			in:     "0 9 * * MON-FRI",
+			wanted: "cron(0 9 ? * MON-FRI *)",
+		},
+		"cron with a day of the week increment": {
+			in:     "0 0 * * 0/2",
+			wanted: "cron(0 0 ? * 1/2 *)",
+		},
+		"cron with a range of days of the week that ends on sunday": {
+			in:     "0 9 * * 5-7",
+			wanted: "cron(0 9 ? * 6,7,1 *)",
+		},
+		"cron with a range of days of the week from saturday to sunday": {
+			in:     "0 9 * * 6-7",
+			wanted: "cron(0 9 ? * 7,1 *)",
+		},
+		"cron with a range of every day of the week that ends on sunday": {
+			in:     "0 9 * * 0-7",
+			wanted: "cron(0 9 ? * 1,2,3,4,5,6,7 *)",
+		},
+		"cron with a named range of days of the week that ends on sunday": {
+			in:     "0 9 * * FRI-7",
+			wanted: "cron(0 9 ? * 6,7,1 *)",
+		},
+		"cron with an increment over a range of days of the week that ends on sunday": {
+			in:     "0 9 * * 1-7/2",
+			wanted: "cron(0 9 ? * 2,4,6,1 *)",
+		},
+		"cron with a list of days of the week": {
+			in:     "0 9 * * 1,3-4,6-7",
+			wanted: "cron(0 9 ? * 2,4-5,7,1 *)",
+		},
+		"cron with both days specified": {
+			in:        "0 0 1 * 1",
+			wantedErr: errCronBothDayTypes,
+		},
+		"cron with the wrong number of fields": {
+			in:        "0 0 1 *",
+			wantedErr: errors.New("cron expression must have 5 fields, got 4"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := toAWSSchedule(tc.in)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
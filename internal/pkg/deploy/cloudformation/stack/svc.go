@@ -11,16 +11,28 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 )
 
+var (
+	errAutoscalingRangeMissing  = errors.New(`"range" must be specified when "count" is an autoscaling configuration`)
+	errRequestsPerTargetNotLBWS = errors.New(`"requests_per_target" is only supported by services behind a load balancer`)
+)
+
 // Template rendering configuration common across services.
 const (
 	svcParamsTemplatePath = "services/params.json.tmpl"
 )
 
+// Default rolling update limits of a service's running tasks during a deployment.
+const (
+	defaultMinHealthyPercent = 100
+	defaultMaxPercent        = 200
+)
+
 // Parameter logical IDs common across services.
 const (
 	ServiceAppNameParamKey           = "AppName"
@@ -39,10 +51,35 @@ const (
 type RuntimeConfig struct {
 	ImageRepoURL      string            // ImageRepoURL is the ECR repository URL the container image should be pushed to.
 	ImageTag          string            // ImageTag is the container image's unique tag.
+	ImageLocation     string            // Optional. ImageLocation is an existing image to deploy instead of the image pushed to ImageRepoURL.
 	AddonsTemplateURL string            // Optional. S3 object URL for the addons template.
+	EnvFileURL        string            // Optional. S3 object URL for the environment file of the main container.
 	AdditionalTags    map[string]string // AdditionalTags are labels applied to resources in the service stack.
 }
 
+// image returns the location of the container image to deploy.
+func (rc RuntimeConfig) image() string {
+	if rc.ImageLocation != "" {
+		return rc.ImageLocation
+	}
+	return fmt.Sprintf("%s:%s", rc.ImageRepoURL, rc.ImageTag)
+}
+
+// envFileOpts returns the S3 object of the environment file, or nil if the service doesn't have one.
+func (rc RuntimeConfig) envFileOpts() (*template.EnvFileOpts, error) {
+	if rc.EnvFileURL == "" {
+		return nil, nil
+	}
+	bucket, key, err := s3.ParseURL(rc.EnvFileURL)
+	if err != nil {
+		return nil, err
+	}
+	return &template.EnvFileOpts{
+		Bucket: bucket,
+		Key:    key,
+	}, nil
+}
+
 type templater interface {
 	Template() (string, error)
 }
@@ -64,7 +101,11 @@ func (s *svc) StackName() string {
 }
 
 // Parameters returns the list of CloudFormation parameters used by the template.
-func (s *svc) Parameters() []*cloudformation.Parameter {
+func (s *svc) Parameters() ([]*cloudformation.Parameter, error) {
+	desiredCount, err := s.tc.Count.Desired()
+	if err != nil {
+		return nil, fmt.Errorf("service %s: %w", s.name, err)
+	}
 	return []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(ServiceAppNameParamKey),
@@ -80,7 +121,7 @@ func (s *svc) Parameters() []*cloudformation.Parameter {
 		},
 		{
 			ParameterKey:   aws.String(ServiceContainerImageParamKey),
-			ParameterValue: aws.String(fmt.Sprintf("%s:%s", s.rc.ImageRepoURL, s.rc.ImageTag)),
+			ParameterValue: aws.String(s.rc.image()),
 		},
 		{
 			ParameterKey:   aws.String(ServiceTaskCPUParamKey),
@@ -92,7 +133,7 @@ func (s *svc) Parameters() []*cloudformation.Parameter {
 		},
 		{
 			ParameterKey:   aws.String(ServiceTaskCountParamKey),
-			ParameterValue: aws.String(strconv.Itoa(*s.tc.Count)),
+			ParameterValue: aws.String(strconv.Itoa(aws.IntValue(desiredCount))),
 		},
 		{
 			ParameterKey:   aws.String(ServiceLogRetentionParamKey),
@@ -102,7 +143,7 @@ func (s *svc) Parameters() []*cloudformation.Parameter {
 			ParameterKey:   aws.String(ServiceAddonsTemplateURLParamKey),
 			ParameterValue: aws.String(s.rc.AddonsTemplateURL),
 		},
-	}
+	}, nil
 }
 
 // Tags returns the list of tags to apply to the CloudFormation stack.
@@ -161,6 +202,67 @@ func (s *svc) addonsOutputs() (*template.ServiceNestedStackOpts, error) {
 	}, nil
 }
 
+// autoscalingOpts converts the "count" autoscaling configuration into a format parsable by the templates pkg.
+// If the service isn't configured to autoscale, then returns nil.
+func (s *svc) autoscalingOpts() (*template.AutoscalingOpts, error) {
+	autoscaling := s.tc.Count.Autoscaling
+	if autoscaling.IsEmpty() {
+		return nil, nil
+	}
+	if autoscaling.Range == nil {
+		return nil, fmt.Errorf("service %s: %w", s.name, errAutoscalingRangeMissing)
+	}
+	min, max, err := autoscaling.Range.Parse()
+	if err != nil {
+		return nil, fmt.Errorf(`parse "range" %s for service %s: %w`, string(*autoscaling.Range), s.name, err)
+	}
+	for field, val := range map[string]*int{
+		"cpu_percentage":    autoscaling.CPU,
+		"memory_percentage": autoscaling.Memory,
+	} {
+		if val != nil && (*val <= 0 || *val > 100) {
+			return nil, fmt.Errorf(`"%s" for service %s must be between 1 and 100`, field, s.name)
+		}
+	}
+	if autoscaling.Requests != nil && *autoscaling.Requests <= 0 {
+		return nil, fmt.Errorf(`"requests_per_target" for service %s must be a positive number`, s.name)
+	}
+	return &template.AutoscalingOpts{
+		MinCapacity: min,
+		MaxCapacity: max,
+		CPU:         autoscaling.CPU,
+		Memory:      autoscaling.Memory,
+		Requests:    autoscaling.Requests,
+	}, nil
+}
+
+// deploymentConfigOpts converts the "deployment" configuration into a format parsable by the templates pkg.
+// If the deployment configuration is empty, then returns nil so that the default rolling update settings are used.
+func (s *svc) deploymentConfigOpts(dc manifest.DeploymentConfig) (*template.DeploymentConfigurationOpts, error) {
+	if dc.CircuitBreaker == nil && dc.MinHealthyPercent == nil && dc.MaxPercent == nil && len(dc.RollbackAlarms) == 0 {
+		return nil, nil
+	}
+	opts := &template.DeploymentConfigurationOpts{
+		MinHealthyPercent: defaultMinHealthyPercent,
+		MaxPercent:        defaultMaxPercent,
+		CircuitBreaker:    aws.BoolValue(dc.CircuitBreaker),
+		RollbackAlarms:    dc.RollbackAlarms,
+	}
+	if dc.MinHealthyPercent != nil {
+		if *dc.MinHealthyPercent < 0 || *dc.MinHealthyPercent > 100 {
+			return nil, fmt.Errorf(`"min_healthy_percent" for service %s must be between 0 and 100`, s.name)
+		}
+		opts.MinHealthyPercent = *dc.MinHealthyPercent
+	}
+	if dc.MaxPercent != nil {
+		if *dc.MaxPercent < 100 {
+			return nil, fmt.Errorf(`"max_percent" for service %s must be at least 100`, s.name)
+		}
+		opts.MaxPercent = *dc.MaxPercent
+	}
+	return opts, nil
+}
+
 func secretOutputNames(outputs []addon.Output) []string {
 	var secrets []string
 	for _, out := range outputs {
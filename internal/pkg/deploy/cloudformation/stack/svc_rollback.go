@@ -0,0 +1,72 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+)
+
+// SvcRevision holds the template, parameters and tags that a service stack was deployed with.
+type SvcRevision struct {
+	Template   string                      `json:"template"`
+	Parameters []*cloudformation.Parameter `json:"parameters"`
+	Tags       []*cloudformation.Tag       `json:"tags"`
+}
+
+type svcStackConfigurer interface {
+	templateConfigurer
+	Template() (string, error)
+}
+
+// NewSvcRevision returns the revision that deploying the configuration of a service stack creates.
+func NewSvcRevision(conf svcStackConfigurer) (*SvcRevision, error) {
+	tpl, err := conf.Template()
+	if err != nil {
+		return nil, err
+	}
+	params, err := conf.Parameters()
+	if err != nil {
+		return nil, err
+	}
+	return &SvcRevision{
+		Template:   tpl,
+		Parameters: params,
+		Tags:       conf.Tags(),
+	}, nil
+}
+
+// SvcRollback represents the configuration needed to redeploy a service stack with a previous revision.
+type SvcRollback struct {
+	name     string
+	revision SvcRevision
+}
+
+// NewSvcRollback returns a configuration that redeploys the template, parameters and tags of a previous revision
+// of a service stack.
+func NewSvcRollback(stackName string, revision SvcRevision) *SvcRollback {
+	return &SvcRollback{
+		name:     stackName,
+		revision: revision,
+	}
+}
+
+// StackName returns the name of the service stack.
+func (s *SvcRollback) StackName() string {
+	return s.name
+}
+
+// Template returns the template of the revision.
+func (s *SvcRollback) Template() (string, error) {
+	return s.revision.Template, nil
+}
+
+// Parameters returns the parameters of the revision.
+func (s *SvcRollback) Parameters() ([]*cloudformation.Parameter, error) {
+	return s.revision.Parameters, nil
+}
+
+// Tags returns the tags of the revision.
+func (s *SvcRollback) Tags() []*cloudformation.Tag {
+	return s.revision.Tags
+}
@@ -0,0 +1,124 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSvcStackConfigurer struct {
+	tpl       string
+	tplErr    error
+	params    []*cloudformation.Parameter
+	paramsErr error
+	tags      []*cloudformation.Tag
+}
+
+func (m mockSvcStackConfigurer) Template() (string, error) {
+	return m.tpl, m.tplErr
+}
+
+func (m mockSvcStackConfigurer) Parameters() ([]*cloudformation.Parameter, error) {
+	return m.params, m.paramsErr
+}
+
+func (m mockSvcStackConfigurer) Tags() []*cloudformation.Tag {
+	return m.tags
+}
+
+func TestNewSvcRevision(t *testing.T) {
+	params := []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String(ServiceContainerImageParamKey),
+			ParameterValue: aws.String("12345.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:v1"),
+		},
+	}
+	tags := []*cloudformation.Tag{
+		{
+			Key:   aws.String("copilot-application"),
+			Value: aws.String("phonetool"),
+		},
+	}
+	testCases := map[string]struct {
+		in mockSvcStackConfigurer
+
+		wanted    *SvcRevision
+		wantedErr error
+	}{
+		"error if the template can't be generated": {
+			in:        mockSvcStackConfigurer{tplErr: errors.New("some error")},
+			wantedErr: errors.New("some error"),
+		},
+		"error if the parameters can't be generated": {
+			in:        mockSvcStackConfigurer{paramsErr: errors.New("some error")},
+			wantedErr: errors.New("some error"),
+		},
+		"returns the template, parameters and tags": {
+			in: mockSvcStackConfigurer{
+				tpl:    "template",
+				params: params,
+				tags:   tags,
+			},
+			wanted: &SvcRevision{
+				Template:   "template",
+				Parameters: params,
+				Tags:       tags,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewSvcRevision(tc.in)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestSvcRollback(t *testing.T) {
+	// GIVEN
+	revision := SvcRevision{
+		Template: "template",
+		Parameters: []*cloudformation.Parameter{
+			{
+				ParameterKey:   aws.String(ServiceContainerImageParamKey),
+				ParameterValue: aws.String("12345.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:v1"),
+			},
+			{
+				ParameterKey:   aws.String(ServiceTaskCPUParamKey),
+				ParameterValue: aws.String("256"),
+			},
+		},
+		Tags: []*cloudformation.Tag{
+			{
+				Key:   aws.String("copilot-application"),
+				Value: aws.String("phonetool"),
+			},
+		},
+	}
+	conf := NewSvcRollback("phonetool-test-frontend", revision)
+
+	// WHEN
+	tpl, tplErr := conf.Template()
+	params, paramsErr := conf.Parameters()
+
+	// THEN
+	require.NoError(t, tplErr)
+	require.NoError(t, paramsErr)
+	require.Equal(t, "phonetool-test-frontend", conf.StackName())
+	require.Equal(t, "template", tpl)
+	require.Equal(t, revision.Parameters, params)
+	require.Equal(t, revision.Tags, conf.Tags())
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -51,10 +52,22 @@ func (t *taskStackConfig) StackName() string {
 
 // Template returns the task CloudFormation template.
 func (t *taskStackConfig) Template() (string, error) {
+	var runtimePlatform *template.RuntimePlatformOpts
+	if t.Platform != "" {
+		opts, err := manifest.ParsePlatform(t.Platform)
+		if err != nil {
+			return "", fmt.Errorf("convert the platform for task stack: %w", err)
+		}
+		runtimePlatform = opts
+	}
 	content, err := t.parser.Parse(taskTemplatePath, struct{
-		EnvVars map[string]string
+		EnvVars         map[string]string
+		EnableExec      bool
+		RuntimePlatform *template.RuntimePlatformOpts
 	}{
-		EnvVars: t.EnvVars,
+		EnvVars:         t.EnvVars,
+		EnableExec:      t.EnableExec,
+		RuntimePlatform: runtimePlatform,
 	})
 	if err != nil {
 		return "", fmt.Errorf("read template for task stack: %w", err)
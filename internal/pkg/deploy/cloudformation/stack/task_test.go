@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"reflect"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"testing"
 
@@ -21,11 +22,28 @@ const (
 
 func TestTaskStackConfig_Template(t *testing.T) {
 	testCases := map[string]struct {
+		inPlatform     string
 		mockReadParser func(m *mocks.MockReadParser)
 
 		wantedTemplate string
 		wantedError    error
 	}{
+		"should return error if the platform is invalid": {
+			inPlatform:  "windows/amd64",
+			wantedError: errors.New("convert the platform for task stack: platform windows/amd64 must be one of linux/amd64, linux/x86_64, linux/arm64"),
+		},
+		"should parse the template with the runtime platform": {
+			inPlatform: "linux/arm64",
+			mockReadParser: func(m *mocks.MockReadParser) {
+				m.EXPECT().Parse(taskTemplatePath, gomock.Any()).DoAndReturn(func(_ string, data interface{}, _ ...template.ParseOption) (*template.Content, error) {
+					require.Equal(t, &template.RuntimePlatformOpts{OS: "LINUX", Arch: "ARM64"}, reflect.ValueOf(data).FieldByName("RuntimePlatform").Interface())
+					return &template.Content{
+						Buffer: bytes.NewBufferString("This is the task template"),
+					}, nil
+				})
+			},
+			wantedTemplate: "This is the task template",
+		},
 		"should return error if unable to read": {
 			mockReadParser: func(m *mocks.MockReadParser) {
 				m.EXPECT().Parse(taskTemplatePath, gomock.Any()).Return(nil, errors.New("error reading template"))
@@ -52,7 +70,9 @@ func TestTaskStackConfig_Template(t *testing.T) {
 				tc.mockReadParser(mockReadParser)
 			}
 
-			taskInput := deploy.CreateTaskResourcesInput{}
+			taskInput := deploy.CreateTaskResourcesInput{
+				Platform: tc.inPlatform,
+			}
 
 			taskStackConfig := &taskStackConfig{
 				CreateTaskResourcesInput: &taskInput,
@@ -0,0 +1,241 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+)
+
+// Limits of the SQS queue configuration.
+// See https://docs.aws.amazon.com/AWSSimpleQueueService/latest/APIReference/API_CreateQueue.html
+const (
+	defaultDeadLetterTries = 10
+	minDeadLetterTries     = 1
+	maxDeadLetterTries     = 1000
+
+	minQueueRetention = time.Minute
+	maxQueueRetention = 14 * 24 * time.Hour
+	maxQueueTimeout   = 12 * time.Hour
+)
+
+var (
+	errMessagesPerTaskMissing = errors.New(`"messages_per_task" must be a positive number when "scaling" is specified`)
+	errScalingRangeMissing    = errors.New(`"range" is required when "messages_per_task" is specified`)
+	errScalingRangeConflict   = errors.New(`"scaling" can't be specified together with an autoscaling "count"`)
+)
+
+type workerSvcReadParser interface {
+	template.ReadParser
+	ParseWorkerService(template.ServiceOpts) (*template.Content, error)
+}
+
+// WorkerService represents the configuration needed to create a CloudFormation stack from a worker service manifest.
+type WorkerService struct {
+	*svc
+	manifest *manifest.WorkerService
+
+	parser workerSvcReadParser
+}
+
+// NewWorkerService creates a new WorkerService stack from a manifest file.
+func NewWorkerService(mft *manifest.WorkerService, env, app string, rc RuntimeConfig) (*WorkerService, error) {
+	parser := template.New()
+	addons, err := addon.New(aws.StringValue(mft.Name))
+	if err != nil {
+		return nil, fmt.Errorf("new addons: %w", err)
+	}
+	envManifest, err := mft.ApplyEnv(env) // Apply environment overrides to the manifest values.
+	if err != nil {
+		return nil, fmt.Errorf("apply environment %s override: %s", env, err)
+	}
+	return &WorkerService{
+		svc: &svc{
+			name:   aws.StringValue(mft.Name),
+			env:    env,
+			app:    app,
+			tc:     envManifest.WorkerServiceConfig.TaskConfig,
+			rc:     rc,
+			parser: parser,
+			addons: addons,
+		},
+		manifest: envManifest,
+
+		parser: parser,
+	}, nil
+}
+
+// Template returns the CloudFormation template for the worker service.
+func (s *WorkerService) Template() (string, error) {
+	outputs, err := s.addonsOutputs()
+	if err != nil {
+		return "", err
+	}
+	sidecars, err := s.manifest.Sidecar.SidecarsOpts(s.manifest.MainContainer())
+	if err != nil {
+		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
+	}
+	dependsOn, err := s.manifest.Sidecar.MainContainerDependsOnOpts(s.manifest.MainContainer())
+	if err != nil {
+		return "", fmt.Errorf("convert the container dependencies for service %s: %w", s.name, err)
+	}
+	storage, err := s.manifest.Storage.StorageOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the storage configuration for service %s: %w", s.name, err)
+	}
+	permissions, err := s.manifest.Permissions.PermissionsOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the permissions for service %s: %w", s.name, err)
+	}
+	capacityProviders, err := s.manifest.Platform.CapacityProviderOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the platform configuration for service %s: %w", s.name, err)
+	}
+	runtimePlatform, err := s.manifest.RuntimePlatformOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the platform configuration for service %s: %w", s.name, err)
+	}
+	secrets, err := s.manifest.SecretOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the secrets for service %s: %w", s.name, err)
+	}
+	envFile, err := s.rc.envFileOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the environment file for service %s: %w", s.name, err)
+	}
+	queue, err := s.queueOpts()
+	if err != nil {
+		return "", err
+	}
+	autoscaling, err := s.autoscalingOpts()
+	if err != nil {
+		return "", err
+	}
+	deploymentConfig, err := s.deploymentConfigOpts(s.manifest.Deployment)
+	if err != nil {
+		return "", err
+	}
+	content, err := s.parser.ParseWorkerService(template.ServiceOpts{
+		Variables:         s.manifest.WorkerServiceConfig.Variables,
+		EnvFile:           envFile,
+		Secrets:           secrets,
+		NestedStack:       outputs,
+		Sidecars:          sidecars,
+		DependsOn:         dependsOn,
+		LogConfig:         s.manifest.LogConfigOpts(),
+		Storage:           storage,
+		Permissions:       permissions,
+		CapacityProviders: capacityProviders,
+		RuntimePlatform:   runtimePlatform,
+		EnableExec:        aws.BoolValue(s.manifest.Exec),
+		Queue:             queue,
+		Autoscaling:       autoscaling,
+		DeploymentConfig:  deploymentConfig,
+	})
+	if err != nil {
+		return "", fmt.Errorf("parse worker service template: %w", err)
+	}
+	tpl, err := applyCFNOverrides(content.String(), s.manifest.CFNOverrides)
+	if err != nil {
+		return "", fmt.Errorf("service %s: %w", s.name, err)
+	}
+	return tpl, nil
+}
+
+// Parameters returns the list of CloudFormation parameters used by the template.
+func (s *WorkerService) Parameters() ([]*cloudformation.Parameter, error) {
+	autoscaling, err := s.autoscalingOpts()
+	if err != nil {
+		return nil, err
+	}
+	params, err := s.svc.Parameters()
+	if err != nil {
+		return nil, err
+	}
+	if autoscaling == nil {
+		return params, nil
+	}
+	// Start with the minimum number of tasks and let the scaling policies adjust the desired count.
+	for _, param := range params {
+		if aws.StringValue(param.ParameterKey) == ServiceTaskCountParamKey {
+			param.ParameterValue = aws.String(strconv.Itoa(autoscaling.MinCapacity))
+		}
+	}
+	return params, nil
+}
+
+// SerializedParameters returns the CloudFormation stack's parameters serialized
+// to a YAML document annotated with comments for readability to users.
+func (s *WorkerService) SerializedParameters() (string, error) {
+	return s.svc.templateConfiguration(s)
+}
+
+func (s *WorkerService) queueOpts() (*template.QueueOpts, error) {
+	queue := s.manifest.Queue
+	opts := &template.QueueOpts{
+		DeadLetterTries: defaultDeadLetterTries,
+	}
+	if queue.Retention != nil {
+		retention := *queue.Retention
+		if retention < minQueueRetention || retention > maxQueueRetention {
+			return nil, fmt.Errorf(`"retention" for service %s must be between %s and %s`, s.name, minQueueRetention, maxQueueRetention)
+		}
+		opts.Retention = aws.Int(int(retention.Seconds()))
+	}
+	if queue.Timeout != nil {
+		timeout := *queue.Timeout
+		if timeout < 0 || timeout > maxQueueTimeout {
+			return nil, fmt.Errorf(`"timeout" for service %s must be between 0s and %s`, s.name, maxQueueTimeout)
+		}
+		opts.Timeout = aws.Int(int(timeout.Seconds()))
+	}
+	if queue.DeadLetter != nil && queue.DeadLetter.Tries != nil {
+		tries := aws.IntValue(queue.DeadLetter.Tries)
+		if tries < minDeadLetterTries || tries > maxDeadLetterTries {
+			return nil, fmt.Errorf(`"tries" for service %s must be between %d and %d`, s.name, minDeadLetterTries, maxDeadLetterTries)
+		}
+		opts.DeadLetterTries = tries
+	}
+	return opts, nil
+}
+
+func (s *WorkerService) autoscalingOpts() (*template.AutoscalingOpts, error) {
+	opts, err := s.svc.autoscalingOpts()
+	if err != nil {
+		return nil, err
+	}
+	if opts != nil && opts.Requests != nil {
+		return nil, fmt.Errorf("service %s: %w", s.name, errRequestsPerTargetNotLBWS)
+	}
+	scaling := s.manifest.Scaling
+	if scaling == nil || (scaling.Range == nil && scaling.MessagesPerTask == nil) {
+		return opts, nil
+	}
+	if opts != nil {
+		return nil, fmt.Errorf("service %s: %w", s.name, errScalingRangeConflict)
+	}
+	if scaling.Range == nil {
+		return nil, fmt.Errorf("service %s: %w", s.name, errScalingRangeMissing)
+	}
+	min, max, err := scaling.Range.Parse()
+	if err != nil {
+		return nil, fmt.Errorf(`parse "range" %s for service %s: %w`, aws.StringValue((*string)(scaling.Range)), s.name, err)
+	}
+	if aws.IntValue(scaling.MessagesPerTask) <= 0 {
+		return nil, fmt.Errorf("service %s: %w", s.name, errMessagesPerTaskMissing)
+	}
+	return &template.AutoscalingOpts{
+		MinCapacity:          min,
+		MaxCapacity:          max,
+		QueueMessagesPerTask: scaling.MessagesPerTask,
+	}, nil
+}
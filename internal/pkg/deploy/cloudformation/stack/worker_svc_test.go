@@ -0,0 +1,273 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWorkerServiceManifest() *manifest.WorkerService {
+	mft := manifest.NewWorkerService(&manifest.WorkerServiceProps{
+		ServiceProps: &manifest.ServiceProps{
+			Name:       "orders",
+			Dockerfile: "orders/Dockerfile",
+		},
+	})
+	retention := 24 * time.Hour
+	scalingRange := manifest.Range("2-10")
+	mft.Queue.Retention = &retention
+	mft.Scaling = &manifest.QueueScaling{
+		Range:           &scalingRange,
+		MessagesPerTask: aws.Int(50),
+	}
+	return mft
+}
+
+func TestWorkerService_Template(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(t *testing.T, ctrl *gomock.Controller, svc *WorkerService)
+		manifest         func() *manifest.WorkerService
+
+		wantedTemplate string
+		wantedErr      error
+	}{
+		"unexpected addons parsing error": {
+			manifest: newTestWorkerServiceManifest,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *WorkerService) {
+				svc.addons = mockTemplater{err: errors.New("some error")}
+			},
+			wantedErr: fmt.Errorf("generate addons template for service orders: %w", errors.New("some error")),
+		},
+		"invalid queue retention": {
+			manifest: func() *manifest.WorkerService {
+				mft := newTestWorkerServiceManifest()
+				retention := 30 * 24 * time.Hour
+				mft.Queue.Retention = &retention
+				return mft
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *WorkerService) {
+				svc.addons = mockTemplater{tpl: ""}
+			},
+			wantedErr: errors.New(`"retention" for service orders must be between 1m0s and 336h0m0s`),
+		},
+		"invalid dead-letter tries": {
+			manifest: func() *manifest.WorkerService {
+				mft := newTestWorkerServiceManifest()
+				mft.Queue.DeadLetter = &manifest.DeadLetterQueue{Tries: aws.Int(0)}
+				return mft
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *WorkerService) {
+				svc.addons = mockTemplater{tpl: ""}
+			},
+			wantedErr: errors.New(`"tries" for service orders must be between 1 and 1000`),
+		},
+		"invalid scaling range": {
+			manifest: func() *manifest.WorkerService {
+				mft := newTestWorkerServiceManifest()
+				scalingRange := manifest.Range("10")
+				mft.Scaling.Range = &scalingRange
+				return mft
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *WorkerService) {
+				svc.addons = mockTemplater{tpl: ""}
+			},
+			wantedErr: errors.New(`parse "range" 10 for service orders: range must be in the format "min-max"`),
+		},
+		"missing scaling range": {
+			manifest: func() *manifest.WorkerService {
+				mft := newTestWorkerServiceManifest()
+				mft.Scaling.Range = nil
+				return mft
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *WorkerService) {
+				svc.addons = mockTemplater{tpl: ""}
+			},
+			wantedErr: fmt.Errorf("service orders: %w", errScalingRangeMissing),
+		},
+		"missing messages per task": {
+			manifest: func() *manifest.WorkerService {
+				mft := newTestWorkerServiceManifest()
+				mft.Scaling.MessagesPerTask = nil
+				return mft
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *WorkerService) {
+				svc.addons = mockTemplater{tpl: ""}
+			},
+			wantedErr: fmt.Errorf("service orders: %w", errMessagesPerTaskMissing),
+		},
+		"failed parsing svc template": {
+			manifest: newTestWorkerServiceManifest,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *WorkerService) {
+				m := mocks.NewMockworkerSvcReadParser(ctrl)
+				m.EXPECT().ParseWorkerService(gomock.Any()).Return(nil, errors.New("some error"))
+				svc.parser = m
+				svc.addons = mockTemplater{tpl: ""}
+			},
+			wantedErr: fmt.Errorf("parse worker service template: %w", errors.New("some error")),
+		},
+		"render template": {
+			manifest: newTestWorkerServiceManifest,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *WorkerService) {
+				m := mocks.NewMockworkerSvcReadParser(ctrl)
+				m.EXPECT().ParseWorkerService(template.ServiceOpts{
+					NestedStack: &template.ServiceNestedStackOpts{
+						StackName:       addon.StackName,
+						VariableOutputs: []string{"Hello"},
+					},
+					Queue: &template.QueueOpts{
+						Retention:       aws.Int(86400),
+						DeadLetterTries: 10,
+					},
+					Autoscaling: &template.AutoscalingOpts{
+						MinCapacity:          2,
+						MaxCapacity:          10,
+						QueueMessagesPerTask: aws.Int(50),
+					},
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{
+					tpl: `Outputs:
+  Hello:
+    Value: hello`,
+				}
+			},
+			wantedTemplate: "template",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mft := tc.manifest()
+			conf := &WorkerService{
+				svc: &svc{
+					name: aws.StringValue(mft.Name),
+					env:  testEnvName,
+					app:  testAppName,
+					rc: RuntimeConfig{
+						ImageRepoURL: testImageRepoURL,
+						ImageTag:     testImageTag,
+					},
+				},
+				manifest: mft,
+			}
+			tc.mockDependencies(t, ctrl, conf)
+
+			// WHEN
+			template, err := conf.Template()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTemplate, template)
+		})
+	}
+}
+
+func TestWorkerService_Parameters(t *testing.T) {
+	// GIVEN
+	mft := newTestWorkerServiceManifest()
+	conf := &WorkerService{
+		svc: &svc{
+			name: aws.StringValue(mft.Name),
+			env:  testEnvName,
+			app:  testAppName,
+			tc:   mft.TaskConfig,
+			rc: RuntimeConfig{
+				ImageRepoURL: testImageRepoURL,
+				ImageTag:     testImageTag,
+			},
+		},
+		manifest: mft,
+	}
+
+	// WHEN
+	params, err := conf.Parameters()
+
+	// THEN
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String(ServiceAppNameParamKey),
+			ParameterValue: aws.String("phonetool"),
+		},
+		{
+			ParameterKey:   aws.String(ServiceEnvNameParamKey),
+			ParameterValue: aws.String("test"),
+		},
+		{
+			ParameterKey:   aws.String(ServiceNameParamKey),
+			ParameterValue: aws.String("orders"),
+		},
+		{
+			ParameterKey:   aws.String(ServiceContainerImageParamKey),
+			ParameterValue: aws.String("12345.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:manual-bf3678c"),
+		},
+		{
+			ParameterKey:   aws.String(ServiceTaskCPUParamKey),
+			ParameterValue: aws.String("256"),
+		},
+		{
+			ParameterKey:   aws.String(ServiceTaskMemoryParamKey),
+			ParameterValue: aws.String("512"),
+		},
+		{
+			ParameterKey:   aws.String(ServiceTaskCountParamKey),
+			ParameterValue: aws.String("2"),
+		},
+		{
+			ParameterKey:   aws.String(ServiceLogRetentionParamKey),
+			ParameterValue: aws.String("30"),
+		},
+		{
+			ParameterKey:   aws.String(ServiceAddonsTemplateURLParamKey),
+			ParameterValue: aws.String(""),
+		},
+	}, params)
+}
+
+func TestWorkerService_ParametersWithImageLocation(t *testing.T) {
+	// GIVEN
+	mft := newTestWorkerServiceManifest()
+	conf := &WorkerService{
+		svc: &svc{
+			name: aws.StringValue(mft.Name),
+			env:  testEnvName,
+			app:  testAppName,
+			tc:   mft.TaskConfig,
+			rc: RuntimeConfig{
+				ImageLocation: "123456789012.dkr.ecr.us-west-2.amazonaws.com/orders:v1.2.0",
+			},
+		},
+		manifest: mft,
+	}
+
+	// WHEN
+	params, err := conf.Parameters()
+
+	// THEN
+	require.NoError(t, err)
+	require.Contains(t, params, &cloudformation.Parameter{
+		ParameterKey:   aws.String(ServiceContainerImageParamKey),
+		ParameterValue: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/orders:v1.2.0"),
+	})
+}
@@ -30,6 +30,7 @@ func (e CreateEnvironmentInput) ImportVPCOpts() *template.ImportVPCOpts {
 	}
 	return &template.ImportVPCOpts{
 		ID:               e.ImportVPCConfig.ID,
+		CIDR:             e.ImportVPCConfig.CIDR,
 		PrivateSubnetIDs: e.ImportVPCConfig.PrivateSubnetIDs,
 		PublicSubnetIDs:  e.ImportVPCConfig.PublicSubnetIDs,
 	}
@@ -50,6 +51,7 @@ func (e CreateEnvironmentInput) AdjustVPCOpts() *template.AdjustVPCOpts {
 // ImportVPCConfig holds the fields to import VPC resources.
 type ImportVPCConfig struct {
 	ID               string // ID for the VPC.
+	CIDR             string // CIDR range of the VPC.
 	PublicSubnetIDs  []string
 	PrivateSubnetIDs []string
 }
@@ -5,9 +5,17 @@
 // This file defines service deployment resources.
 package deploy
 
+import "time"
+
 // DeleteServiceInput holds the fields required to delete a service.
 type DeleteServiceInput struct {
 	Name    string // Name of the service that needs to be deleted.
 	EnvName string // Name of the environment the service is deployed in.
 	AppName string // Name of the application the service belongs to.
 }
+
+// ServiceRevision holds a task definition that was deployed by a successful update of a service stack.
+type ServiceRevision struct {
+	TaskDefinition string    // ARN of the task definition, such as "arn:aws:ecs:us-west-2:1234567890:task-definition/app-env-svc:3".
+	DeployedAt     time.Time // Time at which the stack operation that deployed the task definition completed.
+}
@@ -16,6 +16,8 @@ type CreateTaskResourcesInput struct {
 	ExecutionRole string
 	Command  string
 	EnvVars  map[string]string
+	EnableExec bool
+	Platform string
 
 	App      string
 	Env      string
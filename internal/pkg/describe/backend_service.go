@@ -63,6 +63,7 @@ func NewBackendServiceDescriber(opt NewBackendServiceConfig) (*BackendServiceDes
 
 // URI returns the service discovery namespace and is used to make
 // BackendServiceDescriber have the same signature as WebServiceDescriber.
+// If the service is fronted by the environment's internal load balancer, it returns the load balancer's URL instead.
 func (d *BackendServiceDescriber) URI(envName string) (string, error) {
 	if err := d.initServiceDescriber(envName); err != nil {
 		return "", err
@@ -71,6 +72,17 @@ func (d *BackendServiceDescriber) URI(envName string) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("retrieve service deployment configuration: %w", err)
 	}
+	if path := svcParams[stack.BackendServiceRulePathParamKey]; path != "" {
+		envOutputs, err := d.svcDescriber[envName].EnvOutputs()
+		if err != nil {
+			return "", fmt.Errorf("get output for environment %s: %w", envName, err)
+		}
+		uri := &WebServiceURI{
+			DNSName: envOutputs[stack.EnvOutputInternalLoadBalancerDNSName],
+			Path:    path,
+		}
+		return uri.String(), nil
+	}
 	s := serviceDiscovery{
 		Service: d.svc,
 		Port:    svcParams[stack.LBWebServiceContainerPortParamKey],
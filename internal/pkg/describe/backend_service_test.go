@@ -21,6 +21,96 @@ type backendSvcDescriberMocks struct {
 	svcDescriber *mocks.MocksvcDescriber
 }
 
+func TestBackendServiceDescriber_URI(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testEnv = "test"
+		testSvc = "jobs"
+	)
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(mocks backendSvcDescriberMocks)
+
+		wantedURI   string
+		wantedError error
+	}{
+		"return error if fail to retrieve service deployment configuration": {
+			setupMocks: func(m backendSvcDescriberMocks) {
+				m.svcDescriber.EXPECT().Params().Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("retrieve service deployment configuration: some error"),
+		},
+		"return service discovery endpoint": {
+			setupMocks: func(m backendSvcDescriberMocks) {
+				m.svcDescriber.EXPECT().Params().Return(map[string]string{
+					stack.BackendServiceContainerPortParamKey: "8080",
+				}, nil)
+			},
+			wantedURI: "jobs.phonetool.local:8080",
+		},
+		"return error if fail to retrieve environment outputs": {
+			setupMocks: func(m backendSvcDescriberMocks) {
+				gomock.InOrder(
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.BackendServiceContainerPortParamKey: "8080",
+						stack.BackendServiceRulePathParamKey:      "api",
+					}, nil),
+					m.svcDescriber.EXPECT().EnvOutputs().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("get output for environment test: some error"),
+		},
+		"return internal load balancer url": {
+			setupMocks: func(m backendSvcDescriberMocks) {
+				gomock.InOrder(
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.BackendServiceContainerPortParamKey: "8080",
+						stack.BackendServiceRulePathParamKey:      "api",
+					}, nil),
+					m.svcDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+						stack.EnvOutputInternalLoadBalancerDNSName: "internal-abc.us-west-2.elb.amazonaws.com",
+					}, nil),
+				)
+			},
+			wantedURI: "http://internal-abc.us-west-2.elb.amazonaws.com/api",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvcDescriber := mocks.NewMocksvcDescriber(ctrl)
+			mocks := backendSvcDescriberMocks{
+				svcDescriber: mockSvcDescriber,
+			}
+
+			tc.setupMocks(mocks)
+
+			d := &BackendServiceDescriber{
+				app: testApp,
+				svc: testSvc,
+				svcDescriber: map[string]svcDescriber{
+					"test": mockSvcDescriber,
+				},
+				initServiceDescriber: func(string) error { return nil },
+			}
+
+			// WHEN
+			uri, err := d.URI(testEnv)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedURI, uri)
+			}
+		})
+	}
+}
+
 func TestBackendServiceDescriber_Describe(t *testing.T) {
 	const (
 		testApp     = "phonetool"
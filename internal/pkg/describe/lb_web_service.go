@@ -57,6 +57,7 @@ func (s *serviceDiscovery) String() string {
 
 type svcDescriber interface {
 	Params() (map[string]string, error)
+	Outputs() (map[string]string, error)
 	EnvOutputs() (map[string]string, error)
 	EnvVars() (map[string]string, error)
 	ServiceStackResources() ([]*cloudformation.StackResource, error)
@@ -135,6 +136,16 @@ func (d *WebServiceDescriber) Describe() (HumanJSONStringer, error) {
 			Environment: env,
 			URL:         webServiceURI,
 		})
+		additionalURLs, err := d.additionalRoutes(env)
+		if err != nil {
+			return nil, fmt.Errorf("retrieve additional routes: %w", err)
+		}
+		for _, url := range additionalURLs {
+			routes = append(routes, &WebServiceRoute{
+				Environment: env,
+				URL:         url,
+			})
+		}
 		configs = append(configs, &ServiceConfig{
 			Environment: env,
 			Port:        d.svcParams[stack.LBWebServiceContainerPortParamKey],
@@ -207,6 +218,9 @@ func (d *WebServiceDescriber) URI(envName string) (string, error) {
 	_, isHTTPS := envOutputs[stack.EnvOutputSubdomain]
 	if isHTTPS {
 		dnsName := fmt.Sprintf("%s.%s", d.svc, envOutputs[stack.EnvOutputSubdomain])
+		if alias := svcParams[stack.LBWebServiceDNSAliasParamKey]; alias != "" {
+			dnsName = alias
+		}
 		uri = &WebServiceURI{
 			DNSName: dnsName,
 		}
@@ -214,6 +228,28 @@ func (d *WebServiceDescriber) URI(envName string) (string, error) {
 	return uri.String(), nil
 }
 
+// additionalRoutes returns the URLs of the service's additional listener rules and
+// the endpoint of its network load balancer in an environment.
+func (d *WebServiceDescriber) additionalRoutes(envName string) ([]string, error) {
+	outputs, err := d.svcDescriber[envName].Outputs()
+	if err != nil {
+		return nil, fmt.Errorf("get outputs for service %s: %w", d.svc, err)
+	}
+	var urls []string
+	// Only one of the outputs exists depending on whether the environment serves traffic on HTTPS.
+	routes := outputs[stack.LBWebServiceHTTPSAdditionalRoutesOutputKey]
+	if routes == "" {
+		routes = outputs[stack.LBWebServiceHTTPAdditionalRoutesOutputKey]
+	}
+	if routes != "" {
+		urls = append(urls, strings.Split(routes, ",")...)
+	}
+	if endpoint := outputs[stack.LBWebServiceNLBEndpointOutputKey]; endpoint != "" {
+		urls = append(urls, endpoint)
+	}
+	return urls, nil
+}
+
 // EnvVars contains serialized environment variables for a service.
 type EnvVars struct {
 	Environment string `json:"environment"`
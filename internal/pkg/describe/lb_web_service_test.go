@@ -111,6 +111,22 @@ func TestWebServiceDescriber_URI(t *testing.T) {
 
 			wantedURI: "https://jobs.test.phonetool.com",
 		},
+		"https web service with an alias": {
+			setupMocks: func(m webSvcDescriberMocks) {
+				gomock.InOrder(
+					m.svcDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+						stack.EnvOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+						stack.EnvOutputSubdomain:                 testEnvSubdomain,
+					}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceRulePathParamKey: testSvcPath,
+						stack.LBWebServiceDNSAliasParamKey: "jobs.phonetool.com",
+					}, nil),
+				)
+			},
+
+			wantedURI: "https://jobs.phonetool.com",
+		},
 		"http web service": {
 			setupMocks: func(m webSvcDescriberMocks) {
 				gomock.InOrder(
@@ -200,6 +216,21 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("retrieve service URI: get output for environment test: some error"),
 		},
+		"return error if fail to retrieve additional routes": {
+			setupMocks: func(m webSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.svcDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+						stack.EnvOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceRulePathParamKey: testSvcPath,
+					}, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve additional routes: get outputs for service jobs: some error"),
+		},
 		"return error if fail to retrieve service deployment configuration": {
 			setupMocks: func(m webSvcDescriberMocks) {
 				gomock.InOrder(
@@ -214,6 +245,7 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						stack.ServiceTaskMemoryParamKey:         "512",
 						stack.LBWebServiceRulePathParamKey:      testSvcPath,
 					}, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
 					m.svcDescriber.EXPECT().EnvVars().Return(nil, mockErr),
 				)
 			},
@@ -234,6 +266,7 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						stack.ServiceTaskCPUParamKey:            "256",
 						stack.ServiceTaskMemoryParamKey:         "512",
 					}, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
 					m.svcDescriber.EXPECT().EnvVars().Return(
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": testEnv,
@@ -259,6 +292,9 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						stack.ServiceTaskCPUParamKey:            "256",
 						stack.ServiceTaskMemoryParamKey:         "512",
 					}, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(map[string]string{
+						stack.LBWebServiceHTTPAdditionalRoutesOutputKey: "http://abc.us-west-1.elb.amazonaws.com/v2/api,http://api.example.com",
+					}, nil),
 					m.svcDescriber.EXPECT().EnvVars().Return(
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": testEnv,
@@ -274,6 +310,9 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						stack.ServiceTaskCPUParamKey:            "512",
 						stack.ServiceTaskMemoryParamKey:         "1024",
 					}, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(map[string]string{
+						stack.LBWebServiceNLBEndpointOutputKey: "tcp://jobs-Publi.elb.us-west-2.amazonaws.com:1883",
+					}, nil),
 					m.svcDescriber.EXPECT().EnvVars().Return(
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": prodEnv,
@@ -318,10 +357,22 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						Environment: "test",
 						URL:         "http://abc.us-west-1.elb.amazonaws.com/*",
 					},
+					{
+						Environment: "test",
+						URL:         "http://abc.us-west-1.elb.amazonaws.com/v2/api",
+					},
+					{
+						Environment: "test",
+						URL:         "http://api.example.com",
+					},
 					{
 						Environment: "prod",
 						URL:         "http://abc.us-west-1.elb.amazonaws.com/*",
 					},
+					{
+						Environment: "prod",
+						URL:         "tcp://jobs-Publi.elb.us-west-2.amazonaws.com:1883",
+					},
 				},
 				ServiceDiscovery: []*ServiceDiscovery{
 					{
@@ -48,6 +48,21 @@ func (mr *MocksvcDescriberMockRecorder) Params() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MocksvcDescriber)(nil).Params))
 }
 
+// Outputs mocks base method
+func (m *MocksvcDescriber) Outputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Outputs indicates an expected call of Outputs
+func (mr *MocksvcDescriberMockRecorder) Outputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MocksvcDescriber)(nil).Outputs))
+}
+
 // EnvOutputs mocks base method
 func (m *MocksvcDescriber) EnvOutputs() (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	applicationautoscaling "github.com/aws/copilot-cli/internal/pkg/aws/applicationautoscaling"
 	cloudwatch "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	resourcegroups "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
@@ -126,6 +127,21 @@ func (mr *MockecsServiceGetterMockRecorder) ServiceTasks(clusterName, serviceNam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceTasks", reflect.TypeOf((*MockecsServiceGetter)(nil).ServiceTasks), clusterName, serviceName)
 }
 
+// StoppedServiceTasks mocks base method
+func (m *MockecsServiceGetter) StoppedServiceTasks(clusterName, serviceName string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoppedServiceTasks", clusterName, serviceName)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoppedServiceTasks indicates an expected call of StoppedServiceTasks
+func (mr *MockecsServiceGetterMockRecorder) StoppedServiceTasks(clusterName, serviceName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoppedServiceTasks", reflect.TypeOf((*MockecsServiceGetter)(nil).StoppedServiceTasks), clusterName, serviceName)
+}
+
 // Service mocks base method
 func (m *MockecsServiceGetter) Service(clusterName, serviceName string) (*ecs.Service, error) {
 	m.ctrl.T.Helper()
@@ -140,3 +156,41 @@ func (mr *MockecsServiceGetterMockRecorder) Service(clusterName, serviceName int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockecsServiceGetter)(nil).Service), clusterName, serviceName)
 }
+
+// MockscalableTargetGetter is a mock of scalableTargetGetter interface
+type MockscalableTargetGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockscalableTargetGetterMockRecorder
+}
+
+// MockscalableTargetGetterMockRecorder is the mock recorder for MockscalableTargetGetter
+type MockscalableTargetGetterMockRecorder struct {
+	mock *MockscalableTargetGetter
+}
+
+// NewMockscalableTargetGetter creates a new mock instance
+func NewMockscalableTargetGetter(ctrl *gomock.Controller) *MockscalableTargetGetter {
+	mock := &MockscalableTargetGetter{ctrl: ctrl}
+	mock.recorder = &MockscalableTargetGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockscalableTargetGetter) EXPECT() *MockscalableTargetGetterMockRecorder {
+	return m.recorder
+}
+
+// ECSServiceScalableTarget mocks base method
+func (m *MockscalableTargetGetter) ECSServiceScalableTarget(clusterName, serviceName string) (*applicationautoscaling.ScalableTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECSServiceScalableTarget", clusterName, serviceName)
+	ret0, _ := ret[0].(*applicationautoscaling.ScalableTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECSServiceScalableTarget indicates an expected call of ECSServiceScalableTarget
+func (mr *MockscalableTargetGetterMockRecorder) ECSServiceScalableTarget(clusterName, serviceName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceScalableTarget", reflect.TypeOf((*MockscalableTargetGetter)(nil).ECSServiceScalableTarget), clusterName, serviceName)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -135,6 +136,29 @@ func (d *ServiceDescriber) ServiceStackResources() ([]*cloudformation.StackResou
 	return resources, nil
 }
 
+// AddonsOutputs returns the outputs of the service's addons nested stack.
+// If the service doesn't have any addons, it returns an empty map.
+func (d *ServiceDescriber) AddonsOutputs() (map[string]string, error) {
+	svcResources, err := d.stackDescriber.StackResources(stack.NameForService(d.app, d.env, d.service))
+	if err != nil {
+		return nil, err
+	}
+	outputs := make(map[string]string)
+	for _, resource := range svcResources {
+		if aws.StringValue(resource.LogicalResourceId) != addon.StackName {
+			continue
+		}
+		addonsStack, err := d.stackDescriber.Stack(aws.StringValue(resource.PhysicalResourceId))
+		if err != nil {
+			return nil, err
+		}
+		for _, out := range addonsStack.Outputs {
+			outputs[aws.StringValue(out.OutputKey)] = aws.StringValue(out.OutputValue)
+		}
+	}
+	return outputs, nil
+}
+
 // EnvOutputs returns the output of the environment stack.
 func (d *ServiceDescriber) EnvOutputs() (map[string]string, error) {
 	envStack, err := d.stackDescriber.Stack(stack.NameForEnv(d.app, d.env))
@@ -148,6 +172,19 @@ func (d *ServiceDescriber) EnvOutputs() (map[string]string, error) {
 	return outputs, nil
 }
 
+// Outputs returns the outputs of the service stack.
+func (d *ServiceDescriber) Outputs() (map[string]string, error) {
+	svcStack, err := d.stackDescriber.Stack(stack.NameForService(d.app, d.env, d.service))
+	if err != nil {
+		return nil, err
+	}
+	outputs := make(map[string]string)
+	for _, out := range svcStack.Outputs {
+		outputs[aws.StringValue(out.OutputKey)] = aws.StringValue(out.OutputValue)
+	}
+	return outputs, nil
+}
+
 // Params returns the parameters of the service stack.
 func (d *ServiceDescriber) Params() (map[string]string, error) {
 	svcStack, err := d.stackDescriber.Stack(stack.NameForService(d.app, d.env, d.service))
@@ -201,3 +201,107 @@ func TestServiceDescriber_ServiceStackResources(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceDescriber_AddonsOutputs(t *testing.T) {
+	const (
+		testApp         = "phonetool"
+		testEnv         = "test"
+		testSvc         = "jobs"
+		testAddonsStack = "arn:aws:cloudformation:us-west-2:1234567890:stack/phonetool-test-jobs-AddonsStack-1O4X2Z1ZJ2N4/8b7c1d10"
+	)
+	testCases := map[string]struct {
+		setupMocks func(mocks svcDescriberMocks)
+
+		wantedOutputs map[string]string
+		wantedError   error
+	}{
+		"returns error when fail to describe stack resources": {
+			setupMocks: func(m svcDescriberMocks) {
+				m.mockStackDescriber.EXPECT().StackResources(stack.NameForService(testApp, testEnv, testSvc)).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"returns empty outputs if the service has no addons": {
+			setupMocks: func(m svcDescriberMocks) {
+				m.mockStackDescriber.EXPECT().StackResources(stack.NameForService(testApp, testEnv, testSvc)).Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("EnvControllerAction"),
+						PhysicalResourceId: aws.String("phonetool-test-jobs-EnvControllerAction"),
+					},
+				}, nil)
+			},
+
+			wantedOutputs: map[string]string{},
+		},
+		"returns error when fail to describe the addons stack": {
+			setupMocks: func(m svcDescriberMocks) {
+				gomock.InOrder(
+					m.mockStackDescriber.EXPECT().StackResources(stack.NameForService(testApp, testEnv, testSvc)).Return([]*cloudformation.StackResource{
+						{
+							LogicalResourceId:  aws.String("AddonsStack"),
+							PhysicalResourceId: aws.String(testAddonsStack),
+						},
+					}, nil),
+					m.mockStackDescriber.EXPECT().Stack(testAddonsStack).Return(nil, errors.New("some error")),
+				)
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"returns the outputs of the addons stack": {
+			setupMocks: func(m svcDescriberMocks) {
+				gomock.InOrder(
+					m.mockStackDescriber.EXPECT().StackResources(stack.NameForService(testApp, testEnv, testSvc)).Return([]*cloudformation.StackResource{
+						{
+							LogicalResourceId:  aws.String("AddonsStack"),
+							PhysicalResourceId: aws.String(testAddonsStack),
+						},
+					}, nil),
+					m.mockStackDescriber.EXPECT().Stack(testAddonsStack).Return(&cloudformation.Stack{
+						Outputs: []*cloudformation.Output{
+							{
+								OutputKey:   aws.String("MyTableName"),
+								OutputValue: aws.String("phonetool-test-jobs-MyTable"),
+							},
+						},
+					}, nil),
+				)
+			},
+
+			wantedOutputs: map[string]string{
+				"MyTableName": "phonetool-test-jobs-MyTable",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStackDescriber := mocks.NewMockstackAndResourcesDescriber(ctrl)
+			tc.setupMocks(svcDescriberMocks{
+				mockStackDescriber: mockStackDescriber,
+			})
+			d := &ServiceDescriber{
+				app:            testApp,
+				service:        testSvc,
+				env:            testEnv,
+				stackDescriber: mockStackDescriber,
+			}
+
+			// WHEN
+			actual, err := d.AddonsOutputs()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOutputs, actual)
+			}
+		})
+	}
+}
@@ -7,8 +7,12 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"text/tabwriter"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	aas "github.com/aws/copilot-cli/internal/pkg/aws/applicationautoscaling"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
@@ -31,9 +35,14 @@ type resourcesGetter interface {
 
 type ecsServiceGetter interface {
 	ServiceTasks(clusterName, serviceName string) ([]*ecs.Task, error)
+	StoppedServiceTasks(clusterName, serviceName string) ([]*ecs.Task, error)
 	Service(clusterName, serviceName string) (*ecs.Service, error)
 }
 
+type scalableTargetGetter interface {
+	ECSServiceScalableTarget(clusterName, serviceName string) (*aas.ScalableTarget, error)
+}
+
 // ServiceStatus retrieves status of a service.
 type ServiceStatus struct {
 	AppName string
@@ -43,13 +52,15 @@ type ServiceStatus struct {
 	EcsSvc ecsServiceGetter
 	CwSvc  alarmStatusGetter
 	rgSvc  resourcesGetter
+	aasSvc scalableTargetGetter
 }
 
 // ServiceStatusDesc contains the status for a service.
 type ServiceStatusDesc struct {
-	Service ecs.ServiceStatus        `json:",flow"`
-	Tasks   []ecs.TaskStatus         `json:"tasks"`
-	Alarms  []cloudwatch.AlarmStatus `json:"alarms"`
+	Service     ecs.ServiceStatus        `json:",flow"`
+	Autoscaling *aas.ScalableTarget      `json:"autoscaling,omitempty"` // Nil if the service isn't configured to autoscale.
+	Tasks       []ecs.TaskStatus         `json:"tasks"`
+	Alarms      []cloudwatch.AlarmStatus `json:"alarms"`
 }
 
 // NewServiceStatusConfig contains fields that initiates ServiceStatus struct.
@@ -77,6 +88,7 @@ func NewServiceStatus(opt *NewServiceStatusConfig) (*ServiceStatus, error) {
 		rgSvc:   rg.New(sess),
 		CwSvc:   cloudwatch.New(sess),
 		EcsSvc:  ecs.New(sess),
+		aasSvc:  aas.New(sess),
 	}, nil
 }
 
@@ -96,24 +108,37 @@ func (s *ServiceStatus) getServiceArn() (*ecs.ServiceArn, error) {
 	return &serviceArn, nil
 }
 
-// Describe returns status of a service.
-func (s *ServiceStatus) Describe() (*ServiceStatusDesc, error) {
+// clusterAndServiceName returns the names of the ECS cluster and service of the Copilot service.
+func (s *ServiceStatus) clusterAndServiceName() (clusterName, serviceName string, err error) {
 	serviceArn, err := s.getServiceArn()
 	if err != nil {
-		return nil, fmt.Errorf("get service ARN: %w", err)
+		return "", "", fmt.Errorf("get service ARN: %w", err)
 	}
-	clusterName, err := serviceArn.ClusterName()
+	clusterName, err = serviceArn.ClusterName()
 	if err != nil {
-		return nil, fmt.Errorf("get cluster name: %w", err)
+		return "", "", fmt.Errorf("get cluster name: %w", err)
 	}
-	serviceName, err := serviceArn.ServiceName()
+	serviceName, err = serviceArn.ServiceName()
 	if err != nil {
-		return nil, fmt.Errorf("get service name: %w", err)
+		return "", "", fmt.Errorf("get service name: %w", err)
+	}
+	return clusterName, serviceName, nil
+}
+
+// Describe returns status of a service.
+func (s *ServiceStatus) Describe() (*ServiceStatusDesc, error) {
+	clusterName, serviceName, err := s.clusterAndServiceName()
+	if err != nil {
+		return nil, err
 	}
 	service, err := s.EcsSvc.Service(clusterName, serviceName)
 	if err != nil {
 		return nil, fmt.Errorf("get service %s: %w", serviceName, err)
 	}
+	scalableTarget, err := s.aasSvc.ECSServiceScalableTarget(clusterName, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("get autoscaling configuration for service %s: %w", serviceName, err)
+	}
 	tasks, err := s.EcsSvc.ServiceTasks(clusterName, serviceName)
 	if err != nil {
 		return nil, fmt.Errorf("get tasks for service %s: %w", serviceName, err)
@@ -135,12 +160,61 @@ func (s *ServiceStatus) Describe() (*ServiceStatusDesc, error) {
 		return nil, fmt.Errorf("get CloudWatch alarms: %w", err)
 	}
 	return &ServiceStatusDesc{
-		Service: service.ServiceStatus(),
-		Tasks:   taskStatus,
-		Alarms:  alarms,
+		Service:     service.ServiceStatus(),
+		Autoscaling: scalableTarget,
+		Tasks:       taskStatus,
+		Alarms:      alarms,
 	}, nil
 }
 
+// StoppedTasks returns the status of the service's tasks that stopped after the given time,
+// ordered from the most recently stopped task.
+func (s *ServiceStatus) StoppedTasks(since time.Time) ([]ecs.TaskStatus, error) {
+	clusterName, serviceName, err := s.clusterAndServiceName()
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := s.EcsSvc.StoppedServiceTasks(clusterName, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("get stopped tasks for service %s: %w", serviceName, err)
+	}
+	var taskStatus []ecs.TaskStatus
+	for _, task := range tasks {
+		status, err := task.TaskStatus()
+		if err != nil {
+			return nil, fmt.Errorf("get status for task %s: %w", aws.StringValue(task.TaskArn), err)
+		}
+		if status.StoppedAt.Before(since) {
+			continue
+		}
+		taskStatus = append(taskStatus, *status)
+	}
+	sort.SliceStable(taskStatus, func(i, j int) bool {
+		return taskStatus[i].StoppedAt.After(taskStatus[j].StoppedAt)
+	})
+	return taskStatus, nil
+}
+
+// RunningTasks returns the tasks of the service that are running.
+func (s *ServiceStatus) RunningTasks() ([]*ecs.Task, error) {
+	clusterName, serviceName, err := s.clusterAndServiceName()
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := s.EcsSvc.ServiceTasks(clusterName, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("get tasks for service %s: %w", serviceName, err)
+	}
+	var running []*ecs.Task
+	for _, task := range tasks {
+		if aws.StringValue(task.LastStatus) != ecs.DesiredStatusRunning {
+			continue
+		}
+		running = append(running, task)
+	}
+	return running, nil
+}
+
 // JSONString returns the stringified ServiceStatusDesc struct with json format.
 func (s *ServiceStatusDesc) JSONString() (string, error) {
 	b, err := json.Marshal(s)
@@ -158,6 +232,10 @@ func (s *ServiceStatusDesc) HumanString() string {
 	writer.Flush()
 	fmt.Fprintf(writer, "  %s %v / %v running tasks (%v pending)\n", statusColor(s.Service.Status),
 		s.Service.RunningCount, s.Service.DesiredCount, s.Service.DesiredCount-s.Service.RunningCount)
+	if s.Autoscaling != nil {
+		fmt.Fprintf(writer, "  Autoscaling %v desired tasks (min %v, max %v)\n",
+			s.Service.DesiredCount, s.Autoscaling.MinCapacity, s.Autoscaling.MaxCapacity)
+	}
 	fmt.Fprintf(writer, color.Bold.Sprint("\nLast Deployment\n\n"))
 	writer.Flush()
 	fmt.Fprintf(writer, "  %s\t%s\n", "Updated At", humanizeTime(s.Service.LastDeploymentAt))
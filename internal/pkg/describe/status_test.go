@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
+	aas "github.com/aws/copilot-cli/internal/pkg/aws/applicationautoscaling"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 
@@ -27,6 +28,7 @@ type serviceStatusMocks struct {
 	ecsServiceGetter  *mocks.MockecsServiceGetter
 	alarmStatusGetter *mocks.MockalarmStatusGetter
 	resourcesGetter   *mocks.MockresourcesGetter
+	targetGetter      *mocks.MockscalableTargetGetter
 }
 
 func TestServiceStatus_Describe(t *testing.T) {
@@ -83,6 +85,21 @@ func TestServiceStatus_Describe(t *testing.T) {
 
 			wantedError: fmt.Errorf("get service mockService: some error"),
 		},
+		"errors if failed to get scalable target": {
+			setupMocks: func(m serviceStatusMocks) {
+				gomock.InOrder(
+					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+						{
+							ARN: mockServiceArn,
+						},
+					}, nil),
+					m.ecsServiceGetter.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{}, nil),
+					m.targetGetter.EXPECT().ECSServiceScalableTarget("mockCluster", "mockService").Return(nil, mockError),
+				)
+			},
+
+			wantedError: fmt.Errorf("get autoscaling configuration for service mockService: some error"),
+		},
 		"errors if failed to get running tasks info": {
 			setupMocks: func(m serviceStatusMocks) {
 				gomock.InOrder(
@@ -92,6 +109,7 @@ func TestServiceStatus_Describe(t *testing.T) {
 						},
 					}, nil),
 					m.ecsServiceGetter.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{}, nil),
+					m.targetGetter.EXPECT().ECSServiceScalableTarget("mockCluster", "mockService").Return(nil, nil),
 					m.ecsServiceGetter.EXPECT().ServiceTasks("mockCluster", "mockService").Return(nil, mockError),
 				)
 			},
@@ -107,6 +125,7 @@ func TestServiceStatus_Describe(t *testing.T) {
 						},
 					}, nil),
 					m.ecsServiceGetter.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{}, nil),
+					m.targetGetter.EXPECT().ECSServiceScalableTarget("mockCluster", "mockService").Return(nil, nil),
 					m.ecsServiceGetter.EXPECT().ServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{
 						{
 							TaskArn: aws.String("badMockTaskArn"),
@@ -126,6 +145,7 @@ func TestServiceStatus_Describe(t *testing.T) {
 						},
 					}, nil),
 					m.ecsServiceGetter.EXPECT().Service("mockCluster", "mockService").Return(&ecs.Service{}, nil),
+					m.targetGetter.EXPECT().ECSServiceScalableTarget("mockCluster", "mockService").Return(nil, nil),
 					m.ecsServiceGetter.EXPECT().ServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{
 						{
 							TaskArn:   aws.String("arn:aws:ecs:us-west-2:123456789012:task/mockCluster/1234567890123456789"),
@@ -161,6 +181,10 @@ func TestServiceStatus_Describe(t *testing.T) {
 							},
 						},
 					}, nil),
+					m.targetGetter.EXPECT().ECSServiceScalableTarget("mockCluster", "mockService").Return(&aas.ScalableTarget{
+						MinCapacity: 1,
+						MaxCapacity: 3,
+					}, nil),
 					m.ecsServiceGetter.EXPECT().ServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{
 						{
 							TaskArn:      aws.String("arn:aws:ecs:us-west-2:123456789012:task/mockCluster/1234567890123456789"),
@@ -206,6 +230,10 @@ func TestServiceStatus_Describe(t *testing.T) {
 					LastDeploymentAt: startTime,
 					TaskDefinition:   "mockTaskDefinition",
 				},
+				Autoscaling: &aas.ScalableTarget{
+					MinCapacity: 1,
+					MaxCapacity: 3,
+				},
 				Alarms: []cloudwatch.AlarmStatus{
 					{
 						Arn:          "mockAlarmArn",
@@ -248,10 +276,12 @@ func TestServiceStatus_Describe(t *testing.T) {
 			mockecsSvc := mocks.NewMockecsServiceGetter(ctrl)
 			mockcwSvc := mocks.NewMockalarmStatusGetter(ctrl)
 			mockrgSvc := mocks.NewMockresourcesGetter(ctrl)
+			mockaasSvc := mocks.NewMockscalableTargetGetter(ctrl)
 			mocks := serviceStatusMocks{
 				ecsServiceGetter:  mockecsSvc,
 				alarmStatusGetter: mockcwSvc,
 				resourcesGetter:   mockrgSvc,
+				targetGetter:      mockaasSvc,
 			}
 
 			tc.setupMocks(mocks)
@@ -263,6 +293,7 @@ func TestServiceStatus_Describe(t *testing.T) {
 				CwSvc:   mockcwSvc,
 				EcsSvc:  mockecsSvc,
 				rgSvc:   mockrgSvc,
+				aasSvc:  mockaasSvc,
 			}
 
 			// WHEN
@@ -279,6 +310,216 @@ func TestServiceStatus_Describe(t *testing.T) {
 	}
 }
 
+func TestServiceStatus_StoppedTasks(t *testing.T) {
+	mockTags := map[string]string{
+		deploy.AppTagKey:     "mockApp",
+		deploy.EnvTagKey:     "mockEnv",
+		deploy.ServiceTagKey: "mockSvc",
+	}
+	mockServiceArn := "arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService"
+	deployStartTime, _ := time.Parse(time.RFC3339, "2020-03-13T19:50:30+00:00")
+	testCases := map[string]struct {
+		setupMocks func(mocks serviceStatusMocks)
+
+		wantedError error
+		wantedTasks []ecs.TaskStatus
+	}{
+		"errors if failed to get service ARN": {
+			setupMocks: func(m serviceStatusMocks) {
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("get service ARN: some error"),
+		},
+		"errors if failed to get stopped tasks": {
+			setupMocks: func(m serviceStatusMocks) {
+				gomock.InOrder(
+					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+						{
+							ARN: mockServiceArn,
+						},
+					}, nil),
+					m.ecsServiceGetter.EXPECT().StoppedServiceTasks("mockCluster", "mockService").Return(nil, errors.New("some error")),
+				)
+			},
+
+			wantedError: fmt.Errorf("get stopped tasks for service mockService: some error"),
+		},
+		"returns tasks stopped since the given time, most recent first": {
+			setupMocks: func(m serviceStatusMocks) {
+				gomock.InOrder(
+					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+						{
+							ARN: mockServiceArn,
+						},
+					}, nil),
+					m.ecsServiceGetter.EXPECT().StoppedServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{
+						{
+							TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789012:task/mockCluster/1234567890123456789"),
+							StoppedAt:     aws.Time(deployStartTime.Add(-time.Minute)),
+							StoppedReason: aws.String("Scaling activity initiated by deployment"),
+						},
+						{
+							TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789012:task/mockCluster/2234567890123456789"),
+							StoppedAt:     aws.Time(deployStartTime.Add(time.Minute)),
+							StoppedReason: aws.String("Essential container in task exited"),
+						},
+						{
+							TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789012:task/mockCluster/3234567890123456789"),
+							StoppedAt:     aws.Time(deployStartTime.Add(2 * time.Minute)),
+							StoppedReason: aws.String("Task failed ELB health checks"),
+						},
+					}, nil),
+				)
+			},
+
+			wantedTasks: []ecs.TaskStatus{
+				{
+					ID:            "3234567890123456789",
+					StoppedAt:     deployStartTime.Add(2 * time.Minute),
+					StoppedReason: "Task failed ELB health checks",
+				},
+				{
+					ID:            "2234567890123456789",
+					StoppedAt:     deployStartTime.Add(time.Minute),
+					StoppedReason: "Essential container in task exited",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockecsSvc := mocks.NewMockecsServiceGetter(ctrl)
+			mockrgSvc := mocks.NewMockresourcesGetter(ctrl)
+			tc.setupMocks(serviceStatusMocks{
+				ecsServiceGetter: mockecsSvc,
+				resourcesGetter:  mockrgSvc,
+			})
+
+			svcStatus := &ServiceStatus{
+				SvcName: "mockSvc",
+				EnvName: "mockEnv",
+				AppName: "mockApp",
+				EcsSvc:  mockecsSvc,
+				rgSvc:   mockrgSvc,
+			}
+
+			// WHEN
+			tasks, err := svcStatus.StoppedTasks(deployStartTime)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTasks, tasks)
+			}
+		})
+	}
+}
+
+func TestServiceStatus_RunningTasks(t *testing.T) {
+	mockTags := map[string]string{
+		deploy.AppTagKey:     "mockApp",
+		deploy.EnvTagKey:     "mockEnv",
+		deploy.ServiceTagKey: "mockSvc",
+	}
+	mockServiceArn := "arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService"
+	testCases := map[string]struct {
+		setupMocks func(mocks serviceStatusMocks)
+
+		wantedError error
+		wantedTasks []*ecs.Task
+	}{
+		"errors if failed to get service ARN": {
+			setupMocks: func(m serviceStatusMocks) {
+				m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("get service ARN: some error"),
+		},
+		"errors if failed to get tasks": {
+			setupMocks: func(m serviceStatusMocks) {
+				gomock.InOrder(
+					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+						{
+							ARN: mockServiceArn,
+						},
+					}, nil),
+					m.ecsServiceGetter.EXPECT().ServiceTasks("mockCluster", "mockService").Return(nil, errors.New("some error")),
+				)
+			},
+
+			wantedError: fmt.Errorf("get tasks for service mockService: some error"),
+		},
+		"returns only running tasks": {
+			setupMocks: func(m serviceStatusMocks) {
+				gomock.InOrder(
+					m.resourcesGetter.EXPECT().GetResourcesByTags(ecsServiceResourceType, mockTags).Return([]*rg.Resource{
+						{
+							ARN: mockServiceArn,
+						},
+					}, nil),
+					m.ecsServiceGetter.EXPECT().ServiceTasks("mockCluster", "mockService").Return([]*ecs.Task{
+						{
+							TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789012:task/mockCluster/1234567890123456789"),
+							LastStatus: aws.String("PENDING"),
+						},
+						{
+							TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789012:task/mockCluster/2234567890123456789"),
+							LastStatus: aws.String("RUNNING"),
+						},
+					}, nil),
+				)
+			},
+
+			wantedTasks: []*ecs.Task{
+				{
+					TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789012:task/mockCluster/2234567890123456789"),
+					LastStatus: aws.String("RUNNING"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockecsSvc := mocks.NewMockecsServiceGetter(ctrl)
+			mockrgSvc := mocks.NewMockresourcesGetter(ctrl)
+			tc.setupMocks(serviceStatusMocks{
+				ecsServiceGetter: mockecsSvc,
+				resourcesGetter:  mockrgSvc,
+			})
+
+			svcStatus := &ServiceStatus{
+				SvcName: "mockSvc",
+				EnvName: "mockEnv",
+				AppName: "mockApp",
+				EcsSvc:  mockecsSvc,
+				rgSvc:   mockrgSvc,
+			}
+
+			// WHEN
+			tasks, err := svcStatus.RunningTasks()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTasks, tasks)
+			}
+		})
+	}
+}
+
 func TestServiceStatusDesc_String(t *testing.T) {
 	// from the function changes (ex: from "1 month ago" to "2 months ago"). To make our tests stable,
 	oldHumanize := humanizeTime
@@ -356,6 +597,10 @@ Alarms
 					LastDeploymentAt: startTime,
 					TaskDefinition:   "mockTaskDefinition",
 				},
+				Autoscaling: &aas.ScalableTarget{
+					MinCapacity: 1,
+					MaxCapacity: 3,
+				},
 				Alarms: []cloudwatch.AlarmStatus{
 					{
 						Arn:          "mockAlarmArn",
@@ -390,6 +635,7 @@ Alarms
 			human: `Service Status
 
   ACTIVE 1 / 1 running tasks (0 pending)
+  Autoscaling 1 desired tasks (min 1, max 3)
 
 Last Deployment
 
@@ -406,7 +652,7 @@ Alarms
   Name              Health              Last Updated        Reason
   mockAlarm         OK                  2 months from now   Threshold Crossed
 `,
-			json: "{\"Service\":{\"desiredCount\":1,\"runningCount\":1,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"tasks\":[{\"health\":\"HEALTHY\",\"id\":\"1234567890123456789\",\"images\":[{\"ID\":\"mockImageID1\",\"Digest\":\"69671a968e8ec3648e2697417750e\"},{\"ID\":\"mockImageID2\",\"Digest\":\"ca27a44e25ce17fea7b07940ad793\"}],\"lastStatus\":\"RUNNING\",\"startedAt\":\"2006-01-02T15:04:05Z\",\"stoppedAt\":\"2006-01-02T16:04:05Z\",\"stoppedReason\":\"some reason\"}],\"alarms\":[{\"arn\":\"mockAlarmArn\",\"name\":\"mockAlarm\",\"reason\":\"Threshold Crossed\",\"status\":\"OK\",\"type\":\"Metric\",\"updatedTimes\":\"2020-03-13T19:50:30Z\"}]}\n",
+			json: "{\"Service\":{\"desiredCount\":1,\"runningCount\":1,\"status\":\"ACTIVE\",\"lastDeploymentAt\":\"2006-01-02T15:04:05Z\",\"taskDefinition\":\"mockTaskDefinition\"},\"autoscaling\":{\"minCapacity\":1,\"maxCapacity\":3},\"tasks\":[{\"health\":\"HEALTHY\",\"id\":\"1234567890123456789\",\"images\":[{\"ID\":\"mockImageID1\",\"Digest\":\"69671a968e8ec3648e2697417750e\"},{\"ID\":\"mockImageID2\",\"Digest\":\"ca27a44e25ce17fea7b07940ad793\"}],\"lastStatus\":\"RUNNING\",\"startedAt\":\"2006-01-02T15:04:05Z\",\"stoppedAt\":\"2006-01-02T16:04:05Z\",\"stoppedReason\":\"some reason\"}],\"alarms\":[{\"arn\":\"mockAlarmArn\",\"name\":\"mockAlarm\",\"reason\":\"Threshold Crossed\",\"status\":\"OK\",\"type\":\"Metric\",\"updatedTimes\":\"2020-03-13T19:50:30Z\"}]}\n",
 		},
 	}
 
@@ -5,8 +5,12 @@
 package docker
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"path/filepath"
+	"runtime"
 	"sort"
 	"strings"
 
@@ -37,8 +41,12 @@ type BuildArguments struct {
 	Context        string            // Optional. Build context directory to pass to `docker build`
 	Args           map[string]string // Optional. Build args to pass via `--build-arg` flags. Equivalent to ARG directives in dockerfile.
 	AdditionalTags []string          // Optional. Additional image tags to pass to docker.
+	Platform       string            // Optional. Target platform of the image, such as "linux/arm64", to pass via the --platform flag.
 }
 
+// hostPlatform is the platform of the images that docker builds by default.
+var hostPlatform = "linux/" + runtime.GOARCH
+
 // Build will run a `docker build` command with the input uri, tag, and Dockerfile path.
 func (r Runner) Build(in *BuildArguments) error {
 	dfDir := in.Context
@@ -47,6 +55,13 @@ func (r Runner) Build(in *BuildArguments) error {
 	}
 
 	args := []string{"build"}
+	if in.Platform != "" {
+		if !isHostPlatform(in.Platform) {
+			// Cross-building requires buildx, and --load stores the image locally so that it can be pushed.
+			args = []string{"buildx", "build", "--load"}
+		}
+		args = append(args, "--platform", in.Platform)
+	}
 
 	// Add additional image tags to the docker build call.
 	for _, tag := range append(in.AdditionalTags, in.ImageTag) {
@@ -75,6 +90,11 @@ func (r Runner) Build(in *BuildArguments) error {
 	return nil
 }
 
+// isHostPlatform returns true if the platform matches the one that docker builds images for by default.
+func isHostPlatform(platform string) bool {
+	return strings.Replace(platform, "x86_64", "amd64", 1) == hostPlatform
+}
+
 // Login will run a `docker login` command against the Service repository URI with the input uri and auth data.
 func (r Runner) Login(uri, username, password string) error {
 	err := r.Run("docker",
@@ -102,6 +122,92 @@ func (r Runner) Push(uri, imageTag string, additionalTags ...string) error {
 	return nil
 }
 
+// RunArguments holds the arguments to start a container with `docker run`.
+type RunArguments struct {
+	Name    string            // Required. Name of the container.
+	Image   string            // Required. Image to start the container from.
+	Network string            // Optional. Network to connect the container to, or "container:<name>" to share the network stack of another container.
+	Aliases []string          // Optional. Network-scoped aliases that other containers on the network can reach the container with.
+	Ports   []string          // Optional. Ports to publish to the host, such as "8080" or "2000/udp".
+	EnvVars map[string]string // Optional. Environment variables to set in the container.
+}
+
+// CreateNetwork will run a `docker network create` command if a network with the input name doesn't exist yet.
+func (r Runner) CreateNetwork(name string) error {
+	buf := new(bytes.Buffer)
+	err := r.Run("docker", []string{"network", "ls", "--quiet", "--filter", fmt.Sprintf("name=^%s$", name)}, command.Stdout(buf))
+	if err != nil {
+		return fmt.Errorf("list networks with name %s: %w", name, err)
+	}
+	if strings.TrimSpace(buf.String()) != "" {
+		return nil
+	}
+	if err := r.Run("docker", []string{"network", "create", name}, command.Stdout(ioutil.Discard)); err != nil {
+		return fmt.Errorf("create network %s: %w", name, err)
+	}
+	return nil
+}
+
+// RunContainer will run a `docker run` command in detached mode, and removes the container once it stops.
+// The environment variables are passed to docker through its own environment, so that their values don't show up in the process list.
+func (r Runner) RunContainer(in *RunArguments) error {
+	args := []string{"run", "--detach", "--rm", "--name", in.Name}
+	if in.Network != "" {
+		args = append(args, "--network", in.Network)
+	}
+	for _, alias := range in.Aliases {
+		args = append(args, "--network-alias", alias)
+	}
+	for _, port := range in.Ports {
+		args = append(args, "--publish", publishedPort(port))
+	}
+
+	// Collect the keys in a slice to sort for test stability
+	var keys []string
+	for k := range in.EnvVars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	env := os.Environ()
+	for _, k := range keys {
+		args = append(args, "--env", k)
+		env = append(env, fmt.Sprintf("%s=%s", k, in.EnvVars[k]))
+	}
+
+	args = append(args, in.Image)
+	if err := r.Run("docker", args, command.Stdout(ioutil.Discard), command.Env(env)); err != nil {
+		return fmt.Errorf("run container %s: %w", in.Name, err)
+	}
+	return nil
+}
+
+// FollowLogs will run a `docker logs --follow` command that streams the logs of the container until it stops.
+func (r Runner) FollowLogs(name string) error {
+	if err := r.Run("docker", []string{"logs", "--follow", name}, command.Stdout(os.Stdout)); err != nil {
+		return fmt.Errorf("follow logs of container %s: %w", name, err)
+	}
+	return nil
+}
+
+// RemoveContainers will run a `docker rm --force` command that stops and removes the containers.
+func (r Runner) RemoveContainers(names ...string) error {
+	args := append([]string{"rm", "--force"}, names...)
+	if err := r.Run("docker", args, command.Stdout(ioutil.Discard)); err != nil {
+		return fmt.Errorf("remove containers %s: %w", strings.Join(names, ", "), err)
+	}
+	return nil
+}
+
+// publishedPort maps a container port, such as "2000/udp", to the same port on the host.
+func publishedPort(port string) string {
+	parts := strings.SplitN(port, "/", 2)
+	mapping := fmt.Sprintf("%s:%s", parts[0], parts[0])
+	if len(parts) == 2 {
+		mapping = fmt.Sprintf("%s/%s", mapping, parts[1])
+	}
+	return mapping
+}
+
 func imageName(uri, tag string) string {
 	return fmt.Sprintf("%s:%s", uri, tag)
 }
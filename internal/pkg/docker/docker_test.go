@@ -5,9 +5,11 @@ package docker
 import (
 	"errors"
 	"fmt"
+	"os/exec"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/docker/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -25,11 +27,16 @@ func TestBuild(t *testing.T) {
 
 	var mockRunner *mocks.Mockrunner
 
+	defaultHostPlatform := hostPlatform
+	hostPlatform = "linux/amd64"
+	defer func() { hostPlatform = defaultHostPlatform }()
+
 	tests := map[string]struct {
 		path           string
 		context        string
 		additionalTags []string
 		args           map[string]string
+		platform       string
 		setupMocks     func(controller *gomock.Controller)
 
 		wantedError error
@@ -102,6 +109,28 @@ func TestBuild(t *testing.T) {
 					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}).Return(nil)
 			},
 		},
+		"success with the host platform": {
+			path:     mockPath,
+			platform: "linux/x86_64",
+			setupMocks: func(c *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(c)
+				mockRunner.EXPECT().Run("docker", []string{"build",
+					"--platform", "linux/x86_64",
+					"-t", mockURI + ":" + mockTag1,
+					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}).Return(nil)
+			},
+		},
+		"cross-builds with buildx for a different platform": {
+			path:     mockPath,
+			platform: "linux/arm64",
+			setupMocks: func(c *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(c)
+				mockRunner.EXPECT().Run("docker", []string{"buildx", "build", "--load",
+					"--platform", "linux/arm64",
+					"-t", mockURI + ":" + mockTag1,
+					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}).Return(nil)
+			},
+		},
 	}
 
 	for name, tc := range tests {
@@ -118,6 +147,7 @@ func TestBuild(t *testing.T) {
 				ImageTag:       mockTag1,
 				AdditionalTags: tc.additionalTags,
 				Args:           tc.args,
+				Platform:       tc.platform,
 			}
 			got := s.Build(&buildInput)
 
@@ -226,3 +256,179 @@ func TestPush(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateNetwork(t *testing.T) {
+	mockError := errors.New("mockError")
+
+	var mockRunner *mocks.Mockrunner
+
+	tests := map[string]struct {
+		setupMocks func(controller *gomock.Controller)
+
+		want error
+	}{
+		"wrap error returned from listing networks": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", []string{"network", "ls", "--quiet", "--filter", "name=^mockNetwork$"}, gomock.Any()).Return(mockError)
+			},
+			want: fmt.Errorf("list networks with name mockNetwork: %w", mockError),
+		},
+		"does not create the network if it already exists": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", []string{"network", "ls", "--quiet", "--filter", "name=^mockNetwork$"}, gomock.Any()).
+					DoAndReturn(func(name string, args []string, opts ...command.Option) error {
+						cmd := &exec.Cmd{}
+						opts[0](cmd)
+						_, err := cmd.Stdout.Write([]byte("6d4aa8bd1f2e\n"))
+						return err
+					})
+			},
+			want: nil,
+		},
+		"wrap error returned from creating the network": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", []string{"network", "ls", "--quiet", "--filter", "name=^mockNetwork$"}, gomock.Any()).Return(nil)
+				mockRunner.EXPECT().Run("docker", []string{"network", "create", "mockNetwork"}, gomock.Any()).Return(mockError)
+			},
+			want: fmt.Errorf("create network mockNetwork: %w", mockError),
+		},
+		"happy path": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", []string{"network", "ls", "--quiet", "--filter", "name=^mockNetwork$"}, gomock.Any()).Return(nil)
+				mockRunner.EXPECT().Run("docker", []string{"network", "create", "mockNetwork"}, gomock.Any()).Return(nil)
+			},
+			want: nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			test.setupMocks(controller)
+			s := Runner{
+				runner: mockRunner,
+			}
+
+			got := s.CreateNetwork("mockNetwork")
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestRunContainer(t *testing.T) {
+	mockError := errors.New("mockError")
+
+	var mockRunner *mocks.Mockrunner
+
+	tests := map[string]struct {
+		in         *RunArguments
+		setupMocks func(controller *gomock.Controller)
+
+		want error
+	}{
+		"wrap error returned from Run()": {
+			in: &RunArguments{
+				Name:  "mockContainer",
+				Image: "mockImage",
+			},
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", []string{"run", "--detach", "--rm", "--name", "mockContainer", "mockImage"}, gomock.Any(), gomock.Any()).Return(mockError)
+			},
+			want: fmt.Errorf("run container mockContainer: %w", mockError),
+		},
+		"passes the network, ports and environment variable names": {
+			in: &RunArguments{
+				Name:    "mockContainer",
+				Image:   "mockImage",
+				Network: "mockNetwork",
+				Aliases: []string{"api.phonetool.local"},
+				Ports:   []string{"80", "2000/udp"},
+				EnvVars: map[string]string{
+					"LOG_LEVEL":    "DEBUG",
+					"GITHUB_TOKEN": "s3cr3t",
+				},
+			},
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", []string{"run", "--detach", "--rm", "--name", "mockContainer",
+					"--network", "mockNetwork",
+					"--network-alias", "api.phonetool.local",
+					"--publish", "80:80",
+					"--publish", "2000:2000/udp",
+					"--env", "GITHUB_TOKEN",
+					"--env", "LOG_LEVEL",
+					"mockImage"}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+			want: nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			test.setupMocks(controller)
+			s := Runner{
+				runner: mockRunner,
+			}
+
+			got := s.RunContainer(test.in)
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestRemoveContainers(t *testing.T) {
+	mockError := errors.New("mockError")
+
+	var mockRunner *mocks.Mockrunner
+
+	tests := map[string]struct {
+		setupMocks func(controller *gomock.Controller)
+
+		want error
+	}{
+		"wrap error returned from Run()": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", []string{"rm", "--force", "api", "api-xray"}, gomock.Any()).Return(mockError)
+			},
+			want: fmt.Errorf("remove containers api, api-xray: %w", mockError),
+		},
+		"happy path": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+
+				mockRunner.EXPECT().Run("docker", []string{"rm", "--force", "api", "api-xray"}, gomock.Any()).Return(nil)
+			},
+			want: nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			test.setupMocks(controller)
+			s := Runner{
+				runner: mockRunner,
+			}
+
+			got := s.RemoveContainers("api", "api-xray")
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
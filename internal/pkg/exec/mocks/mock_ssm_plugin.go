@@ -0,0 +1,91 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/exec/ssm_plugin.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	command "github.com/aws/copilot-cli/internal/pkg/term/command"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// Mockrunner is a mock of runner interface
+type Mockrunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockrunnerMockRecorder
+}
+
+// MockrunnerMockRecorder is the mock recorder for Mockrunner
+type MockrunnerMockRecorder struct {
+	mock *Mockrunner
+}
+
+// NewMockrunner creates a new mock instance
+func NewMockrunner(ctrl *gomock.Controller) *Mockrunner {
+	mock := &Mockrunner{ctrl: ctrl}
+	mock.recorder = &MockrunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *Mockrunner) EXPECT() *MockrunnerMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method
+func (m *Mockrunner) Run(name string, args []string, options ...command.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name, args}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Run", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run
+func (mr *MockrunnerMockRecorder) Run(name, args interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name, args}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*Mockrunner)(nil).Run), varargs...)
+}
+
+// MockbinaryFinder is a mock of binaryFinder interface
+type MockbinaryFinder struct {
+	ctrl     *gomock.Controller
+	recorder *MockbinaryFinderMockRecorder
+}
+
+// MockbinaryFinderMockRecorder is the mock recorder for MockbinaryFinder
+type MockbinaryFinderMockRecorder struct {
+	mock *MockbinaryFinder
+}
+
+// NewMockbinaryFinder creates a new mock instance
+func NewMockbinaryFinder(ctrl *gomock.Controller) *MockbinaryFinder {
+	mock := &MockbinaryFinder{ctrl: ctrl}
+	mock.recorder = &MockbinaryFinderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockbinaryFinder) EXPECT() *MockbinaryFinderMockRecorder {
+	return m.recorder
+}
+
+// LookPath mocks base method
+func (m *MockbinaryFinder) LookPath(file string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookPath", file)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookPath indicates an expected call of LookPath
+func (mr *MockbinaryFinderMockRecorder) LookPath(file interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookPath", reflect.TypeOf((*MockbinaryFinder)(nil).LookPath), file)
+}
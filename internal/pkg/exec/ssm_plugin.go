@@ -0,0 +1,86 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package exec provides functionality to start interactive sessions with running containers.
+package exec
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+)
+
+const (
+	ssmPluginBinaryName    = "session-manager-plugin"
+	startSessionAction     = "StartSession"
+	ssmPluginInstallDocURL = "https://docs.aws.amazon.com/systems-manager/latest/userguide/session-manager-working-with-install-plugin.html"
+)
+
+// ErrSSMPluginNotExist is returned when the Session Manager plugin is not installed.
+var ErrSSMPluginNotExist = fmt.Errorf("Session Manager plugin is not installed, see %s to install it", ssmPluginInstallDocURL)
+
+type runner interface {
+	Run(name string, args []string, options ...command.Option) error
+}
+
+type binaryFinder interface {
+	LookPath(file string) (string, error)
+}
+
+// SSMPluginCommand starts interactive sessions with containers through the Session Manager plugin.
+type SSMPluginCommand struct {
+	region   string
+	endpoint string
+
+	runner runner
+	finder binaryFinder
+}
+
+// NewSSMPluginCommand returns a SSMPluginCommand configured against the input session.
+func NewSSMPluginCommand(s *session.Session) *SSMPluginCommand {
+	region := aws.StringValue(s.Config.Region)
+	return &SSMPluginCommand{
+		region:   region,
+		endpoint: s.ClientConfig("ecs").Endpoint,
+		runner:   command.New(),
+		finder:   command.New(),
+	}
+}
+
+// ValidateBinary returns ErrSSMPluginNotExist if the Session Manager plugin is not in the PATH.
+func (s *SSMPluginCommand) ValidateBinary() error {
+	if _, err := s.finder.LookPath(ssmPluginBinaryName); err != nil {
+		return ErrSSMPluginNotExist
+	}
+	return nil
+}
+
+// StartSession connects the terminal to the session until the remote command exits.
+func (s *SSMPluginCommand) StartSession(ssmSess *ecs.Session) error {
+	sess, err := json.Marshal(ssmSess)
+	if err != nil {
+		return fmt.Errorf("marshal session %s: %w", ssmSess.ID, err)
+	}
+	target, err := json.Marshal(map[string]string{
+		"Target": ssmSess.Target,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal target of session %s: %w", ssmSess.ID, err)
+	}
+	// The plugin handles interrupts itself and forwards them to the remote command.
+	signal.Ignore(os.Interrupt)
+	defer signal.Reset(os.Interrupt)
+	err = s.runner.Run(ssmPluginBinaryName,
+		[]string{string(sess), s.region, startSessionAction, "", string(target), s.endpoint},
+		command.Stdin(os.Stdin), command.Stdout(os.Stdout))
+	if err != nil {
+		return fmt.Errorf("start session %s using the Session Manager plugin: %w", ssmSess.ID, err)
+	}
+	return nil
+}
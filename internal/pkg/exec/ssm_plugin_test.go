@@ -0,0 +1,113 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package exec
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/exec/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSMPluginCommand_ValidateBinary(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockbinaryFinder)
+
+		wantedError error
+	}{
+		"plugin is not installed": {
+			setupMocks: func(m *mocks.MockbinaryFinder) {
+				m.EXPECT().LookPath("session-manager-plugin").Return("", errors.New("executable file not found in $PATH"))
+			},
+			wantedError: ErrSSMPluginNotExist,
+		},
+		"plugin is installed": {
+			setupMocks: func(m *mocks.MockbinaryFinder) {
+				m.EXPECT().LookPath("session-manager-plugin").Return("/usr/local/bin/session-manager-plugin", nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockFinder := mocks.NewMockbinaryFinder(ctrl)
+			tc.setupMocks(mockFinder)
+			cmd := &SSMPluginCommand{
+				finder: mockFinder,
+			}
+
+			// WHEN
+			err := cmd.ValidateBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+		})
+	}
+}
+
+func TestSSMPluginCommand_StartSession(t *testing.T) {
+	mockSession := &ecs.Session{
+		ID:         "mockSessionID",
+		StreamURL:  "mockStreamURL",
+		TokenValue: "mockToken",
+		Target:     "ecs:mockCluster_mockTaskID_mockRuntimeID",
+	}
+	wantedArgs := []string{
+		`{"SessionId":"mockSessionID","StreamUrl":"mockStreamURL","TokenValue":"mockToken"}`,
+		"us-west-2",
+		"StartSession",
+		"",
+		`{"Target":"ecs:mockCluster_mockTaskID_mockRuntimeID"}`,
+		"https://ecs.us-west-2.amazonaws.com",
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockrunner)
+
+		wantedError error
+	}{
+		"errors if the plugin fails": {
+			setupMocks: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("session-manager-plugin", wantedArgs, gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("start session mockSessionID using the Session Manager plugin: some error"),
+		},
+		"success": {
+			setupMocks: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run("session-manager-plugin", wantedArgs, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRunner := mocks.NewMockrunner(ctrl)
+			tc.setupMocks(mockRunner)
+			cmd := &SSMPluginCommand{
+				region:   "us-west-2",
+				endpoint: "https://ecs.us-west-2.amazonaws.com",
+				runner:   mockRunner,
+			}
+
+			// WHEN
+			err := cmd.StartSession(mockSession)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
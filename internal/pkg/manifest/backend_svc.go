@@ -39,6 +39,33 @@ type BackendServiceConfig struct {
 	TaskConfig `yaml:",inline"`
 	*LogConfig `yaml:"logging,flow"`
 	Sidecar    `yaml:",inline"`
+	Deployment DeploymentConfig   `yaml:"deployment"`
+	Exec       *bool              `yaml:"exec"` // Enables ECS Exec to run commands in the running containers.
+	HTTP       InternalHTTPConfig `yaml:"http"`
+
+	CFNOverrides []CFNOverride `yaml:"cfn_overrides"`
+}
+
+// InternalHTTPConfig holds the configuration to route requests from the environment's internal load balancer to the service.
+type InternalHTTPConfig struct {
+	Path                *string                 `yaml:"path"`
+	HealthCheck         HealthCheckArgsOrString `yaml:"healthcheck"`
+	Stickiness          *bool                   `yaml:"stickiness"`
+	DeregistrationDelay *time.Duration          `yaml:"deregistration_delay"`
+}
+
+// IsEmpty returns true if the service is not fronted by the internal load balancer.
+func (c InternalHTTPConfig) IsEmpty() bool {
+	return c.Path == nil
+}
+
+// TargetGroupOpts converts the service's health check and target group configuration into a format parsable by the templates pkg.
+func (c InternalHTTPConfig) TargetGroupOpts() (template.TargetGroupOpts, error) {
+	return RoutingRule{
+		HealthCheck:         c.HealthCheck,
+		Stickiness:          c.Stickiness,
+		DeregistrationDelay: c.DeregistrationDelay,
+	}.TargetGroupOpts()
 }
 
 // LogConfigOpts converts the service's Firelens configuration into a format parsable by the templates pkg.
@@ -102,6 +129,15 @@ func (s *BackendService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.Image.BuildConfig(wsRoot)
 }
 
+// MainContainer returns the configuration of the main container that the sidecars of the service can depend on.
+func (s *BackendService) MainContainer() MainContainer {
+	return MainContainer{
+		Name:           aws.StringValue(s.Name),
+		DependsOn:      s.Image.DependsOn,
+		HasHealthCheck: s.Image.HealthCheck != nil,
+	}
+}
+
 // ApplyEnv returns the service manifest with environment overrides.
 // If the environment passed in does not have any overrides then it returns itself.
 func (s BackendService) ApplyEnv(envName string) (*BackendService, error) {
@@ -112,14 +148,32 @@ func (s BackendService) ApplyEnv(envName string) (*BackendService, error) {
 	// Apply overrides to the original service s.
 	err := mergo.Merge(&s, BackendService{
 		BackendServiceConfig: *overrideConfig,
-	}, mergo.WithOverride, mergo.WithOverwriteWithEmptyValue)
+	}, mergo.WithOverride, mergo.WithOverwriteWithEmptyValue, mergo.WithTransformers(sliceOverrideTransformer{}))
 	if err != nil {
 		return nil, err
 	}
+	s.Count.applyOverride(overrideConfig.Count)
+	s.Image.applyOverride(overrideConfig.Image.ServiceImage)
+	s.HTTP.HealthCheck.applyOverride(overrideConfig.HTTP.HealthCheck)
+	s.Platform.applyOverride(overrideConfig.Platform)
 	s.Environments = nil
 	return &s, nil
 }
 
+// EnvWorkload returns the configuration of the service in the environment after applying the environment's overrides.
+func (s BackendService) EnvWorkload(envName string) (*WorkloadConfig, error) {
+	svc, err := s.ApplyEnv(envName)
+	if err != nil {
+		return nil, err
+	}
+	return &WorkloadConfig{
+		Image:    svc.Image.ServiceImage,
+		Port:     svc.Image.Port,
+		Task:     svc.TaskConfig,
+		Sidecars: svc.Sidecars,
+	}, nil
+}
+
 // newDefaultBackendService returns a backend service with minimal task sizes and a single replica.
 func newDefaultBackendService() *BackendService {
 	return &BackendService{
@@ -131,7 +185,9 @@ func newDefaultBackendService() *BackendService {
 			TaskConfig: TaskConfig{
 				CPU:    aws.Int(256),
 				Memory: aws.Int(512),
-				Count:  aws.Int(1),
+				Count: Count{
+					Value: aws.Int(1),
+				},
 			},
 		},
 	}
@@ -192,11 +248,17 @@ func (i imageWithPortAndHealthcheck) HealthCheckOpts() *ecs.HealthCheck {
 	if i.HealthCheck == nil {
 		return nil
 	}
+	return i.HealthCheck.healthCheckOpts()
+}
+
+// healthCheckOpts converts the healthcheck configuration into a format parsable by the templates pkg.
+// All fields of the healthcheck are expected to be set.
+func (hc *ContainerHealthCheck) healthCheckOpts() *ecs.HealthCheck {
 	return &ecs.HealthCheck{
-		Command:     aws.StringSlice(i.HealthCheck.Command),
-		Interval:    aws.Int64(int64(i.HealthCheck.Interval.Seconds())),
-		Retries:     aws.Int64(int64(*i.HealthCheck.Retries)),
-		StartPeriod: aws.Int64(int64(i.HealthCheck.StartPeriod.Seconds())),
-		Timeout:     aws.Int64(int64(i.HealthCheck.Timeout.Seconds())),
+		Command:     aws.StringSlice(hc.Command),
+		Interval:    aws.Int64(int64(hc.Interval.Seconds())),
+		Retries:     aws.Int64(int64(*hc.Retries)),
+		StartPeriod: aws.Int64(int64(hc.StartPeriod.Seconds())),
+		Timeout:     aws.Int64(int64(hc.Timeout.Seconds())),
 	}
 }
@@ -49,7 +49,7 @@ func TestNewBackendSvc(t *testing.T) {
 					TaskConfig: TaskConfig{
 						CPU:    aws.Int(256),
 						Memory: aws.Int(512),
-						Count:  aws.Int(1),
+						Count:  Count{Value: aws.Int(1)},
 					},
 				},
 			},
@@ -93,7 +93,7 @@ func TestNewBackendSvc(t *testing.T) {
 					TaskConfig: TaskConfig{
 						CPU:    aws.Int(256),
 						Memory: aws.Int(512),
-						Count:  aws.Int(1),
+						Count:  Count{Value: aws.Int(1)},
 					},
 				},
 			},
@@ -197,7 +197,7 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 			TaskConfig: TaskConfig{
 				CPU:    aws.Int(256),
 				Memory: aws.Int(256),
-				Count:  aws.Int(1),
+				Count:  Count{Value: aws.Int(1)},
 			},
 		},
 	}
@@ -229,7 +229,7 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 			TaskConfig: TaskConfig{
 				CPU:    aws.Int(256),
 				Memory: aws.Int(256),
-				Count:  aws.Int(1),
+				Count:  Count{Value: aws.Int(1)},
 			},
 			Sidecar: Sidecar{
 				Sidecars: map[string]*SidecarConfig{
@@ -249,7 +249,7 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 		Environments: map[string]*BackendServiceConfig{
 			"test": {
 				TaskConfig: TaskConfig{
-					Count: aws.Int(0),
+					Count: Count{Value: aws.Int(0)},
 					CPU:   aws.Int(512),
 					Variables: map[string]string{
 						"LOG_LEVEL": "",
@@ -271,6 +271,50 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 			},
 		},
 	}
+	mockBackendServiceWithHTTPOverride := BackendService{
+		BackendServiceConfig: BackendServiceConfig{
+			HTTP: InternalHTTPConfig{
+				Path: aws.String("api"),
+				HealthCheck: HealthCheckArgsOrString{
+					HealthCheckPath: aws.String("/"),
+				},
+			},
+		},
+		Environments: map[string]*BackendServiceConfig{
+			"test": {
+				HTTP: InternalHTTPConfig{
+					HealthCheck: HealthCheckArgsOrString{
+						HealthCheckArgs: HTTPHealthCheckArgs{
+							Path:             aws.String("/healthz"),
+							HealthyThreshold: aws.Int64(3),
+						},
+					},
+					Stickiness: aws.Bool(true),
+				},
+			},
+		},
+	}
+	mockBackendServiceWithPlatformOverride := BackendService{
+		BackendServiceConfig: BackendServiceConfig{
+			TaskConfig: TaskConfig{
+				Platform: PlatformConfig{
+					PlatformArgs: PlatformArgs{
+						Architecture: aws.String("x86_64"),
+						Capacity:     []CapacityProviderStrategy{{Provider: aws.String("FARGATE")}},
+					},
+				},
+			},
+		},
+		Environments: map[string]*BackendServiceConfig{
+			"test": {
+				TaskConfig: TaskConfig{
+					Platform: PlatformConfig{
+						PlatformString: aws.String("linux/arm64"),
+					},
+				},
+			},
+		},
+	}
 	testCases := map[string]struct {
 		svc       *BackendService
 		inEnvName string
@@ -314,7 +358,7 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 					TaskConfig: TaskConfig{
 						CPU:    aws.Int(512),
 						Memory: aws.Int(256),
-						Count:  aws.Int(0),
+						Count:  Count{Value: aws.Int(0)},
 						Variables: map[string]string{
 							"LOG_LEVEL": "",
 						},
@@ -339,6 +383,44 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 			},
 			original: &mockBackendServiceWithAllOverride,
 		},
+		"uses env http overrides": {
+			svc:       &mockBackendServiceWithHTTPOverride,
+			inEnvName: "test",
+
+			wanted: &BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					HTTP: InternalHTTPConfig{
+						Path: aws.String("api"),
+						HealthCheck: HealthCheckArgsOrString{
+							HealthCheckArgs: HTTPHealthCheckArgs{
+								Path:             aws.String("/healthz"),
+								HealthyThreshold: aws.Int64(3),
+							},
+						},
+						Stickiness: aws.Bool(true),
+					},
+				},
+			},
+			original: &mockBackendServiceWithHTTPOverride,
+		},
+		"uses env platform overrides": {
+			svc:       &mockBackendServiceWithPlatformOverride,
+			inEnvName: "test",
+
+			wanted: &BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					TaskConfig: TaskConfig{
+						Platform: PlatformConfig{
+							PlatformString: aws.String("linux/arm64"),
+							PlatformArgs: PlatformArgs{
+								Capacity: []CapacityProviderStrategy{{Provider: aws.String("FARGATE")}},
+							},
+						},
+					},
+				},
+			},
+			original: &mockBackendServiceWithPlatformOverride,
+		},
 	}
 
 	for name, tc := range testCases {
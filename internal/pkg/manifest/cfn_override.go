@@ -0,0 +1,74 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	cfnOverridePathSeparator = "/"
+
+	cfnOverrideOpReplace = "replace"
+	cfnOverrideOpAdd     = "add"
+)
+
+var errNoCFNOverrideValue = errors.New(`"value" must be specified`)
+
+// CFNOverride represents a value that replaces or adds a field of the generated CloudFormation template.
+type CFNOverride struct {
+	// Op is "replace", the default, to replace the value of an existing field, or "add" to add a new field.
+	Op string `yaml:"op"`
+	// Path is a JSON pointer to the field, such as "/Resources/Service/Properties/PlatformVersion".
+	// Use "-" as the last segment to append to a list.
+	Path  string    `yaml:"path"`
+	Value yaml.Node `yaml:"value"` // Any YAML value, including CloudFormation intrinsic functions such as "!Ref".
+}
+
+// Adds returns true if the override adds a new field to the template instead of replacing an existing one.
+func (o CFNOverride) Adds() bool {
+	return o.Op == cfnOverrideOpAdd
+}
+
+// Segments returns the keys and indexes of the path from the root of the template, with "~1" and "~0"
+// unescaped into "/" and "~".
+func (o CFNOverride) Segments() []string {
+	segments := strings.Split(strings.TrimPrefix(o.Path, cfnOverridePathSeparator), cfnOverridePathSeparator)
+	for i, segment := range segments {
+		segments[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(segment)
+	}
+	return segments
+}
+
+// validate returns an error if the operation is unknown, the path is not a JSON pointer or the value is missing.
+func (o CFNOverride) validate() error {
+	if o.Op != "" && o.Op != cfnOverrideOpReplace && o.Op != cfnOverrideOpAdd {
+		return fmt.Errorf(`op %q must be one of %q or %q`, o.Op, cfnOverrideOpReplace, cfnOverrideOpAdd)
+	}
+	if !strings.HasPrefix(o.Path, cfnOverridePathSeparator) || o.Path == cfnOverridePathSeparator {
+		return fmt.Errorf(`path %q must start with "/" and reference a field of the template`, o.Path)
+	}
+	for _, segment := range o.Segments() {
+		if segment == "" {
+			return fmt.Errorf("path %q must not contain empty keys", o.Path)
+		}
+	}
+	if o.Value.IsZero() {
+		return fmt.Errorf("path %s: %w", o.Path, errNoCFNOverrideValue)
+	}
+	return nil
+}
+
+func validateCFNOverrides(overrides []CFNOverride) error {
+	for i, override := range overrides {
+		if err := override.validate(); err != nil {
+			return fmt.Errorf(`"cfn_overrides" %d: %w`, i+1, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"gopkg.in/yaml.v3"
+)
+
+var errDefaultsNotMap = errors.New("defaults must be a map of manifest fields")
+
+// Fields that identify a service and can't be shared through the defaults.
+var fieldsNotInDefaults = []string{"name", "type"}
+
+// MergeDefaults returns the manifest with the fields of the workspace defaults that it doesn't set.
+// Maps are merged key by key recursively, while any other value set by the manifest, including lists
+// and null, replaces the default value. If there are no defaults, then the manifest is returned unchanged.
+func MergeDefaults(defaults, in []byte) ([]byte, error) {
+	var defaultsDoc yaml.Node
+	if err := yaml.Unmarshal(defaults, &defaultsDoc); err != nil {
+		return nil, fmt.Errorf("unmarshal defaults: %w", err)
+	}
+	if len(defaultsDoc.Content) == 0 {
+		return in, nil
+	}
+	src := defaultsDoc.Content[0]
+	if src.Kind != yaml.MappingNode {
+		return nil, errDefaultsNotMap
+	}
+	for _, field := range fieldsNotInDefaults {
+		if mappingValue(src, field) != nil {
+			return nil, fmt.Errorf("field %q can't be set in the defaults", field)
+		}
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(in, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		// Let the unmarshaling of the manifest report the error.
+		return in, nil
+	}
+	mergeMappings(doc.Content[0], src)
+	out, err := template.EncodeYAML(&doc)
+	if err != nil {
+		return nil, fmt.Errorf("marshal manifest merged with defaults: %w", err)
+	}
+	return out, nil
+}
+
+// mergeMappings adds the keys of src that are missing in dst, and merges the values that are maps in both.
+func mergeMappings(dst, src *yaml.Node) {
+	for i := 0; i+1 < len(src.Content); i += 2 {
+		key, value := src.Content[i], src.Content[i+1]
+		existing := mappingValue(dst, key.Value)
+		if existing == nil {
+			dst.Content = append(dst.Content, key, value)
+			continue
+		}
+		if existing.Kind == yaml.MappingNode && value.Kind == yaml.MappingNode {
+			mergeMappings(existing, value)
+		}
+	}
+}
+
+// mappingValue returns the value of the key in a map, or nil if the key doesn't exist.
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeDefaults(t *testing.T) {
+	const mft = `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+variables:
+  LOG_LEVEL: debug
+sidecars:
+  datadog:
+    image: datadog/agent:latest
+command: ["./api", "--verbose"]
+`
+	testCases := map[string]struct {
+		inDefaults string
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"returns the manifest as is without defaults": {
+			inDefaults: `# No defaults yet.
+`,
+			wantedContent: mft,
+		},
+		"merges maps and keeps the values of the manifest": {
+			inDefaults: `cpu: 512
+variables:
+  LOG_LEVEL: info
+  REGION: us-west-2
+sidecars:
+  datadog:
+    image: datadog/agent:7
+    port: 8125
+  nginx:
+    image: nginx
+command: ["./start"]
+logging:
+  destination:
+    Name: datadog
+environments:
+  prod:
+    count: 3
+`,
+			wantedContent: `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+variables:
+  LOG_LEVEL: debug
+  REGION: us-west-2
+sidecars:
+  datadog:
+    image: datadog/agent:latest
+    port: 8125
+  nginx:
+    image: nginx
+command: ["./api", "--verbose"]
+cpu: 512
+logging:
+  destination:
+    Name: datadog
+environments:
+  prod:
+    count: 3
+`,
+		},
+		"error if the defaults are not a map": {
+			inDefaults: `- cpu: 256`,
+			wantedErr:  errDefaultsNotMap,
+		},
+		"error if the defaults set the type": {
+			inDefaults: `type: Backend Service`,
+			wantedErr:  errors.New(`field "type" can't be set in the defaults`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := MergeDefaults([]byte(tc.inDefaults), []byte(mft))
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, string(got))
+		})
+	}
+}
+
+func TestUnmarshalService_WithDefaults(t *testing.T) {
+	mft, err := UnmarshalService([]byte(`name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+cpu: 256
+environments:
+  prod:
+    count: 3
+`), WithDefaults([]byte(`cpu: 512
+memory: 1024
+variables:
+  LOG_LEVEL: info
+environments:
+  prod:
+    memory: 2048
+`)))
+	require.NoError(t, err)
+	svc, err := mft.(*BackendService).ApplyEnv("prod")
+	require.NoError(t, err)
+
+	require.Equal(t, 256, *svc.CPU)
+	require.Equal(t, 2048, *svc.Memory)
+	require.Equal(t, 3, *svc.Count.Value)
+	require.Equal(t, map[string]string{"LOG_LEVEL": "info"}, svc.Variables)
+}
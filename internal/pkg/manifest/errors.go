@@ -50,3 +50,14 @@ func (e *ErrUnknownProvider) Is(target error) bool {
 	_, ok := target.(*ErrUnknownProvider)
 	return ok
 }
+
+// ErrUnknownField occurs when a manifest contains a key that doesn't match any field, usually due to a typo.
+type ErrUnknownField struct {
+	Field  string // Dot separated path to the key, such as "http.healtcheck".
+	Line   int
+	Column int
+}
+
+func (e *ErrUnknownField) Error() string {
+	return fmt.Sprintf("line %d, column %d: unknown field %q", e.Line, e.Column, e.Field)
+}
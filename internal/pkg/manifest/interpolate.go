@@ -0,0 +1,142 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"gopkg.in/yaml.v3"
+)
+
+// Built-in variables that can be referenced in a manifest in addition to the environment variables of the process.
+const (
+	AppNameInterpolationVar = "COPILOT_APPLICATION_NAME"
+	EnvNameInterpolationVar = "COPILOT_ENVIRONMENT_NAME"
+)
+
+// interpolationRegexp matches an escaped "$${NAME}", which is replaced with a literal "${NAME}", or a "${NAME}" reference.
+var interpolationRegexp = regexp.MustCompile(`\$?\$\{([a-zA-Z_][a-zA-Z0-9_]*)\}`)
+
+// ErrUndefinedVariable occurs when a manifest references a variable that is neither a built-in nor an environment variable.
+type ErrUndefinedVariable struct {
+	Name string
+	Line int
+}
+
+func (e *ErrUndefinedVariable) Error() string {
+	return fmt.Sprintf("line %d: variable %s is not defined", e.Line, e.Name)
+}
+
+// Interpolator substitutes "${NAME}" references in the values of a manifest with the value of the variable.
+type Interpolator struct {
+	builtIns  map[string]string
+	lookupEnv func(key string) (string, bool)
+}
+
+// NewInterpolator returns an Interpolator that resolves the built-in variables with the application and environment names,
+// and any other variable with the environment of the process. An empty name leaves the references to its built-in
+// variable unresolved, such as when a manifest is linted without an environment.
+func NewInterpolator(appName, envName string) *Interpolator {
+	return &Interpolator{
+		builtIns: map[string]string{
+			AppNameInterpolationVar: appName,
+			EnvNameInterpolationVar: envName,
+		},
+		lookupEnv: os.LookupEnv,
+	}
+}
+
+// Interpolate returns the manifest with the variables referenced in its values substituted.
+// Keys, comments and values tagged with CloudFormation intrinsic functions are left as is.
+// If the manifest doesn't reference any variable, then it's returned unchanged.
+func (i *Interpolator) Interpolate(in []byte) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(in, &doc); err != nil {
+		return nil, err
+	}
+	changed, err := i.interpolateNode(&doc)
+	if err != nil {
+		return nil, err
+	}
+	if !changed {
+		return in, nil
+	}
+	out, err := template.EncodeYAML(&doc)
+	if err != nil {
+		return nil, fmt.Errorf("marshal interpolated manifest: %w", err)
+	}
+	return out, nil
+}
+
+func (i *Interpolator) interpolateNode(node *yaml.Node) (changed bool, err error) {
+	if isCustomTag(node.Tag) {
+		// Values of CloudFormation intrinsic functions such as "!Sub" have their own "${Name}" references.
+		return false, nil
+	}
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return i.interpolateScalar(node)
+	case yaml.MappingNode:
+		for j := 1; j < len(node.Content); j += 2 {
+			valueChanged, err := i.interpolateNode(node.Content[j])
+			if err != nil {
+				return false, err
+			}
+			changed = changed || valueChanged
+		}
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, child := range node.Content {
+			childChanged, err := i.interpolateNode(child)
+			if err != nil {
+				return false, err
+			}
+			changed = changed || childChanged
+		}
+	}
+	return changed, nil
+}
+
+func (i *Interpolator) interpolateScalar(node *yaml.Node) (bool, error) {
+	if !interpolationRegexp.MatchString(node.Value) {
+		return false, nil
+	}
+	var undefined string
+	value := interpolationRegexp.ReplaceAllStringFunc(node.Value, func(match string) string {
+		name := interpolationRegexp.FindStringSubmatch(match)[1]
+		if match[1] == '$' {
+			return match[1:] // Escaped reference.
+		}
+		if v, ok := i.builtIns[name]; ok {
+			if v == "" {
+				return match // Unresolved built-in.
+			}
+			return v
+		}
+		if v, ok := i.lookupEnv(name); ok {
+			return v
+		}
+		if undefined == "" {
+			undefined = name
+		}
+		return match
+	})
+	if undefined != "" {
+		return false, &ErrUndefinedVariable{Name: undefined, Line: node.Line}
+	}
+	node.Value = value
+	if node.Style == 0 {
+		// Resolve the type of a plain value again so that "count: ${COUNT}" is still an integer.
+		node.Tag = ""
+	}
+	return true, nil
+}
+
+// isCustomTag returns true if the tag is set by the user, such as "!Ref", rather than a standard tag such as "!!str".
+func isCustomTag(tag string) bool {
+	return strings.HasPrefix(tag, "!") && !strings.HasPrefix(tag, "!!")
+}
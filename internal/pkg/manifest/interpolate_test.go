@@ -0,0 +1,113 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterpolator_Interpolate(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+		inEnvVars map[string]string
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"returns the manifest as is without references": {
+			inContent: `# The manifest of the api service.
+name: api
+image:
+  location: nginx # The latest image.
+`,
+			wantedContent: `# The manifest of the api service.
+name: api
+image:
+  location: nginx # The latest image.
+`,
+		},
+		"substitutes built-in and environment variables in values": {
+			inContent: `name: api
+image:
+  location: ${REGISTRY}/api:${TAG}
+count: ${COUNT}
+variables:
+  ${KEY}: ${COPILOT_APPLICATION_NAME}-${COPILOT_ENVIRONMENT_NAME}
+`,
+			inEnvVars: map[string]string{
+				"REGISTRY":                 "123456789012.dkr.ecr.us-west-2.amazonaws.com",
+				"TAG":                      "v1.2.0",
+				"COUNT":                    "3",
+				"COPILOT_ENVIRONMENT_NAME": "ignored",
+			},
+			wantedContent: `name: api
+image:
+  location: 123456789012.dkr.ecr.us-west-2.amazonaws.com/api:v1.2.0
+count: 3
+variables:
+  ${KEY}: phonetool-test
+`,
+		},
+		"keeps quoted values as strings": {
+			inContent: `variables:
+  VERSION: "${VERSION}"
+`,
+			inEnvVars: map[string]string{"VERSION": "1.0"},
+			wantedContent: `variables:
+  VERSION: "1.0"
+`,
+		},
+		"unescapes references and leaves intrinsic functions as is": {
+			inContent: `command: echo $${HOME} ${TAG}
+cfn_overrides:
+  - path: /Resources/Service/Properties/ServiceName
+    value: !Sub '${AppName}-${EnvName}'
+`,
+			inEnvVars: map[string]string{"TAG": "v1"},
+			wantedContent: `command: echo ${HOME} v1
+cfn_overrides:
+  - path: /Resources/Service/Properties/ServiceName
+    value: !Sub '${AppName}-${EnvName}'
+`,
+		},
+		"error if a variable is not defined": {
+			inContent: `name: api
+image:
+  location: ${REGISTRY}/api
+`,
+			wantedErr: &ErrUndefinedVariable{Name: "REGISTRY", Line: 3},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			interpolator := NewInterpolator("phonetool", "test")
+			interpolator.lookupEnv = func(key string) (string, bool) {
+				v, ok := tc.inEnvVars[key]
+				return v, ok
+			}
+
+			got, err := interpolator.Interpolate([]byte(tc.inContent))
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, string(got))
+		})
+	}
+}
+
+func TestInterpolator_Interpolate_UnresolvedBuiltIn(t *testing.T) {
+	interpolator := NewInterpolator("phonetool", "")
+	interpolator.lookupEnv = func(key string) (string, bool) { return "", false }
+
+	got, err := interpolator.Interpolate([]byte(`name: ${COPILOT_APPLICATION_NAME}-${COPILOT_ENVIRONMENT_NAME}`))
+
+	require.NoError(t, err)
+	require.Equal(t, "name: phonetool-${COPILOT_ENVIRONMENT_NAME}\n", string(got))
+}
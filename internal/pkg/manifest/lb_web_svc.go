@@ -4,11 +4,19 @@
 package manifest
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/imdario/mergo"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -16,6 +24,22 @@ const (
 
 	// LogRetentionInDays is the default log retention time in days.
 	LogRetentionInDays = 30
+
+	defaultHealthCheckPath = "/"
+)
+
+const (
+	// NLBProtocolTCP forwards TCP traffic to the service as is.
+	NLBProtocolTCP = "tcp"
+	// NLBProtocolTLS terminates TLS connections with the environment's certificate.
+	NLBProtocolTLS = "tls"
+)
+
+var validNLBProtocols = []string{NLBProtocolTCP, NLBProtocolTLS}
+
+var (
+	errUnmarshalHealthCheckOpts = errors.New(`can't unmarshal healthcheck field into string or a healthcheck map`)
+	errInvalidNLBPortFormat     = errors.New(`nlb port must be in the format "port" or "port/protocol"`)
 )
 
 // LoadBalancedWebService holds the configuration to build a container image with an exposed port that receives
@@ -36,6 +60,11 @@ type LoadBalancedWebServiceConfig struct {
 	TaskConfig  `yaml:",inline"`
 	*LogConfig  `yaml:"logging,flow"`
 	Sidecar     `yaml:",inline"`
+	Deployment  DeploymentConfig                 `yaml:"deployment"`
+	Exec        *bool                            `yaml:"exec"` // Enables ECS Exec to run commands in the running containers.
+	NLBConfig   NetworkLoadBalancerConfiguration `yaml:"nlb"`  // Creates a network load balancer in front of the service.
+
+	CFNOverrides []CFNOverride `yaml:"cfn_overrides"`
 }
 
 // LogConfigOpts converts the service's Firelens configuration into a format parsable by the templates pkg.
@@ -48,10 +77,233 @@ func (lc *LoadBalancedWebServiceConfig) LogConfigOpts() *template.LogConfigOpts
 
 // RoutingRule holds the path to route requests to the service.
 type RoutingRule struct {
-	Path            *string `yaml:"path"`
-	HealthCheckPath *string `yaml:"healthcheck"`
+	Path                *string                 `yaml:"path"`
+	HealthCheck         HealthCheckArgsOrString `yaml:"healthcheck"`
+	Stickiness          *bool                   `yaml:"stickiness"`           // Routes the requests of a client to the same task.
+	DeregistrationDelay *time.Duration          `yaml:"deregistration_delay"` // Time to drain in-flight requests of a task before it's deregistered.
+	Alias               *string                 `yaml:"alias"`                // Custom domain name in the application's hosted zone that routes to the service.
 	// TargetContainer is the container load balancer routes traffic to.
 	TargetContainer *string `yaml:"targetContainer"`
+	// AdditionalRules are listener rules that route more paths or hosts to the service.
+	AdditionalRules []ListenerRule `yaml:"additional_rules"`
+}
+
+// HealthCheckArgsOrString is a custom type which supports unmarshaling yaml which
+// can either be of type string or type HTTPHealthCheckArgs.
+type HealthCheckArgsOrString struct {
+	HealthCheckPath *string
+	HealthCheckArgs HTTPHealthCheckArgs
+}
+
+// HTTPHealthCheckArgs holds the configuration to determine if the load balanced web service is healthy.
+// See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-elasticloadbalancingv2-targetgroup.html
+type HTTPHealthCheckArgs struct {
+	Path               *string        `yaml:"path"`
+	SuccessCodes       *string        `yaml:"success_codes"` // HTTP codes of a healthy response, such as "200,202" or "200-299".
+	HealthyThreshold   *int64         `yaml:"healthy_threshold"`
+	UnhealthyThreshold *int64         `yaml:"unhealthy_threshold"`
+	Timeout            *time.Duration `yaml:"timeout"`
+	Interval           *time.Duration `yaml:"interval"`
+	GracePeriod        *time.Duration `yaml:"grace_period"` // Time to ignore failing health checks after a task starts.
+}
+
+func (h *HTTPHealthCheckArgs) isEmpty() bool {
+	return h.Path == nil && h.SuccessCodes == nil && h.HealthyThreshold == nil && h.UnhealthyThreshold == nil &&
+		h.Timeout == nil && h.Interval == nil && h.GracePeriod == nil
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the HealthCheckArgsOrString
+// struct, allowing it to perform more complex unmarshaling behavior.
+// This method implements the yaml.Unmarshaler (v2) interface.
+func (h *HealthCheckArgsOrString) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if err := unmarshal(&h.HealthCheckArgs); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+
+	if !h.HealthCheckArgs.isEmpty() {
+		// Unmarshaled successfully to h.HealthCheckArgs, return.
+		return nil
+	}
+
+	if err := unmarshal(&h.HealthCheckPath); err != nil {
+		return errUnmarshalHealthCheckOpts
+	}
+	return nil
+}
+
+// Path returns the path that the load balancer sends health check requests to.
+func (h *HealthCheckArgsOrString) Path() string {
+	if h.HealthCheckArgs.Path != nil {
+		return aws.StringValue(h.HealthCheckArgs.Path)
+	}
+	if h.HealthCheckPath != nil {
+		return aws.StringValue(h.HealthCheckPath)
+	}
+	return defaultHealthCheckPath
+}
+
+// applyOverride resolves the health check path when an environment overrides it with a different form.
+// The rest of the fields are expected to be already merged.
+func (h *HealthCheckArgsOrString) applyOverride(override HealthCheckArgsOrString) {
+	if override.HealthCheckPath != nil {
+		h.HealthCheckArgs.Path = nil
+	}
+	if override.HealthCheckArgs.Path != nil {
+		h.HealthCheckPath = nil
+	}
+}
+
+// TargetGroupOpts converts the service's health check and target group configuration into a format parsable by the templates pkg.
+// It returns an error if a duration isn't a whole number of seconds or is outside of the range allowed by the load balancer.
+func (r RoutingRule) TargetGroupOpts() (template.TargetGroupOpts, error) {
+	args := r.HealthCheck.HealthCheckArgs
+	timeout, err := seconds("healthcheck.timeout", args.Timeout, 2, 120)
+	if err != nil {
+		return template.TargetGroupOpts{}, err
+	}
+	interval, err := seconds("healthcheck.interval", args.Interval, 5, 300)
+	if err != nil {
+		return template.TargetGroupOpts{}, err
+	}
+	gracePeriod, err := seconds("healthcheck.grace_period", args.GracePeriod, 0, math.MaxInt32)
+	if err != nil {
+		return template.TargetGroupOpts{}, err
+	}
+	deregistrationDelay, err := seconds("deregistration_delay", r.DeregistrationDelay, 0, 3600)
+	if err != nil {
+		return template.TargetGroupOpts{}, err
+	}
+	return template.TargetGroupOpts{
+		HealthCheck: template.HTTPHealthCheckOpts{
+			HealthCheckPath:    r.HealthCheck.Path(),
+			SuccessCodes:       args.SuccessCodes,
+			HealthyThreshold:   args.HealthyThreshold,
+			UnhealthyThreshold: args.UnhealthyThreshold,
+			Timeout:            timeout,
+			Interval:           interval,
+			GracePeriod:        gracePeriod,
+		},
+		Stickiness:          aws.BoolValue(r.Stickiness),
+		DeregistrationDelay: deregistrationDelay,
+	}, nil
+}
+
+// seconds returns the duration in seconds, or nil if the duration is not set.
+// It returns an error if the duration isn't a whole number of seconds between min and max.
+func seconds(field string, d *time.Duration, min, max int64) (*int64, error) {
+	if d == nil {
+		return nil, nil
+	}
+	secs := int64(d.Seconds())
+	if *d%time.Second != 0 || secs < min || secs > max {
+		return nil, fmt.Errorf(`"%s" must be a whole number of seconds between %d and %d, got %s`, field, min, max, d.String())
+	}
+	return aws.Int64(secs), nil
+}
+
+// ListenerRule holds the conditions that a request must match to be routed to the service.
+type ListenerRule struct {
+	Path         *string             `yaml:"path"`
+	Hosts        []string            `yaml:"hosts"`
+	HTTPHeaders  map[string][]string `yaml:"http_headers"`
+	QueryStrings map[string]string   `yaml:"query_strings"`
+}
+
+// ListenerRulesOpts converts the service's additional listener rules into a format parsable by the templates pkg.
+func (r RoutingRule) ListenerRulesOpts() ([]*template.ListenerRuleOpts, error) {
+	var opts []*template.ListenerRuleOpts
+	for i, rule := range r.AdditionalRules {
+		if rule.Path == nil && len(rule.Hosts) == 0 && len(rule.HTTPHeaders) == 0 && len(rule.QueryStrings) == 0 {
+			return nil, fmt.Errorf(`additional rule %d must specify at least one of "path", "hosts", "http_headers" or "query_strings"`, i+1)
+		}
+		opts = append(opts, rule.opts())
+	}
+	return opts, nil
+}
+
+func (r ListenerRule) opts() *template.ListenerRuleOpts {
+	opts := &template.ListenerRuleOpts{
+		Hosts: r.Hosts,
+	}
+	if r.Path != nil {
+		// Like the service's path, rule paths are relative to the root of the load balancer.
+		path := strings.Trim(aws.StringValue(r.Path), "/")
+		opts.Path = aws.String(path)
+		opts.PathPatterns = []string{"/*"}
+		if path != "" {
+			opts.PathPatterns = []string{"/" + path, fmt.Sprintf("/%s/*", path)}
+		}
+	}
+	// Sort the names so that the generated template is stable between deployments.
+	names := make([]string, 0, len(r.HTTPHeaders))
+	for name := range r.HTTPHeaders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		opts.HTTPHeaders = append(opts.HTTPHeaders, template.HTTPHeaderCondition{
+			Name:   name,
+			Values: r.HTTPHeaders[name],
+		})
+	}
+	keys := make([]string, 0, len(r.QueryStrings))
+	for key := range r.QueryStrings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		opts.QueryStrings = append(opts.QueryStrings, template.QueryStringCondition{
+			Key:   key,
+			Value: r.QueryStrings[key],
+		})
+	}
+	return opts
+}
+
+// NetworkLoadBalancerConfiguration holds the configuration of a network load balancer that routes
+// TCP connections to the service.
+type NetworkLoadBalancerConfiguration struct {
+	Port            *NLBPort `yaml:"port"`             // Port and protocol of the listener, such as "8883/tls".
+	TargetContainer *string  `yaml:"target_container"` // Defaults to the main container.
+	TargetPort      *uint16  `yaml:"target_port"`      // Defaults to the listener's port.
+	SSLPolicy       *string  `yaml:"ssl_policy"`       // Security policy of a TLS listener.
+	Alias           *string  `yaml:"alias"`            // Domain name in the environment's hosted zone that routes to the load balancer.
+}
+
+// IsEmpty returns true if the service doesn't need a network load balancer.
+func (c *NetworkLoadBalancerConfiguration) IsEmpty() bool {
+	return c.Port == nil
+}
+
+// NLBPort represents the port and protocol of a network load balancer's listener in the format "port/protocol",
+// such as "8883/tls". The protocol defaults to "tcp".
+type NLBPort string
+
+// Parse extracts the port and the protocol of the listener.
+func (p NLBPort) Parse() (port uint16, protocol string, err error) {
+	portProtocol := strings.Split(string(p), "/")
+	if len(portProtocol) > 2 {
+		return 0, "", errInvalidNLBPortFormat
+	}
+	parsed, err := strconv.ParseUint(strings.TrimSpace(portProtocol[0]), 10, 16)
+	if err != nil || parsed == 0 {
+		return 0, "", errInvalidNLBPortFormat
+	}
+	protocol = NLBProtocolTCP
+	if len(portProtocol) == 2 {
+		protocol = strings.ToLower(strings.TrimSpace(portProtocol[1]))
+	}
+	for _, valid := range validNLBProtocols {
+		if protocol == valid {
+			return uint16(parsed), protocol, nil
+		}
+	}
+	return 0, "", fmt.Errorf("nlb protocol %s must be one of %s", protocol, strings.Join(validNLBProtocols, ", "))
 }
 
 // LoadBalancedWebServiceProps contains properties for creating a new load balanced fargate service manifest.
@@ -91,12 +343,16 @@ func newDefaultLoadBalancedWebService() *LoadBalancedWebService {
 		LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
 			Image: ServiceImageWithPort{},
 			RoutingRule: RoutingRule{
-				HealthCheckPath: aws.String("/"),
+				HealthCheck: HealthCheckArgsOrString{
+					HealthCheckPath: aws.String(defaultHealthCheckPath),
+				},
 			},
 			TaskConfig: TaskConfig{
 				CPU:    aws.Int(256),
 				Memory: aws.Int(512),
-				Count:  aws.Int(1),
+				Count: Count{
+					Value: aws.Int(1),
+				},
 			},
 		},
 	}
@@ -123,6 +379,14 @@ func (s *LoadBalancedWebService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.Image.BuildConfig(wsRoot)
 }
 
+// MainContainer returns the configuration of the main container that the sidecars of the service can depend on.
+func (s *LoadBalancedWebService) MainContainer() MainContainer {
+	return MainContainer{
+		Name:      aws.StringValue(s.Name),
+		DependsOn: s.Image.DependsOn,
+	}
+}
+
 // ApplyEnv returns the service manifest with environment overrides.
 // If the environment passed in does not have any overrides then it returns itself.
 func (s LoadBalancedWebService) ApplyEnv(envName string) (*LoadBalancedWebService, error) {
@@ -133,10 +397,28 @@ func (s LoadBalancedWebService) ApplyEnv(envName string) (*LoadBalancedWebServic
 	// Apply overrides to the original service s.
 	err := mergo.Merge(&s, LoadBalancedWebService{
 		LoadBalancedWebServiceConfig: *overrideConfig,
-	}, mergo.WithOverride, mergo.WithOverwriteWithEmptyValue)
+	}, mergo.WithOverride, mergo.WithOverwriteWithEmptyValue, mergo.WithTransformers(sliceOverrideTransformer{}))
 	if err != nil {
 		return nil, err
 	}
+	s.Count.applyOverride(overrideConfig.Count)
+	s.Image.applyOverride(overrideConfig.Image.ServiceImage)
+	s.HealthCheck.applyOverride(overrideConfig.HealthCheck)
+	s.Platform.applyOverride(overrideConfig.Platform)
 	s.Environments = nil
 	return &s, nil
 }
+
+// EnvWorkload returns the configuration of the service in the environment after applying the environment's overrides.
+func (s LoadBalancedWebService) EnvWorkload(envName string) (*WorkloadConfig, error) {
+	svc, err := s.ApplyEnv(envName)
+	if err != nil {
+		return nil, err
+	}
+	return &WorkloadConfig{
+		Image:    svc.Image.ServiceImage,
+		Port:     svc.Image.Port,
+		Task:     svc.TaskConfig,
+		Sidecars: svc.Sidecars,
+	}, nil
+}
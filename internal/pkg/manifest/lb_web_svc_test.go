@@ -7,12 +7,14 @@ import (
 	"bytes"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestLoadBalancedWebSvc_MarshalBinary(t *testing.T) {
@@ -86,13 +88,15 @@ func TestLoadBalancedWebSvc_ApplyEnv(t *testing.T) {
 						Port: aws.Uint16(80),
 					},
 					RoutingRule: RoutingRule{
-						Path:            aws.String("/awards/*"),
-						HealthCheckPath: aws.String("/"),
+						Path: aws.String("/awards/*"),
+						HealthCheck: HealthCheckArgsOrString{
+							HealthCheckPath: aws.String("/"),
+						},
 					},
 					TaskConfig: TaskConfig{
 						CPU:    aws.Int(1024),
 						Memory: aws.Int(1024),
-						Count:  aws.Int(1),
+						Count:  Count{Value: aws.Int(1)},
 					},
 				},
 			},
@@ -115,13 +119,15 @@ func TestLoadBalancedWebSvc_ApplyEnv(t *testing.T) {
 						Port: aws.Uint16(80),
 					},
 					RoutingRule: RoutingRule{
-						Path:            aws.String("/awards/*"),
-						HealthCheckPath: aws.String("/"),
+						Path: aws.String("/awards/*"),
+						HealthCheck: HealthCheckArgsOrString{
+							HealthCheckPath: aws.String("/"),
+						},
 					},
 					TaskConfig: TaskConfig{
 						CPU:    aws.Int(1024),
 						Memory: aws.Int(1024),
-						Count:  aws.Int(1),
+						Count:  Count{Value: aws.Int(1)},
 					},
 				},
 			},
@@ -144,20 +150,22 @@ func TestLoadBalancedWebSvc_ApplyEnv(t *testing.T) {
 						Port: aws.Uint16(80),
 					},
 					RoutingRule: RoutingRule{
-						Path:            aws.String("/awards/*"),
-						HealthCheckPath: aws.String("/"),
+						Path: aws.String("/awards/*"),
+						HealthCheck: HealthCheckArgsOrString{
+							HealthCheckPath: aws.String("/"),
+						},
 					},
 					TaskConfig: TaskConfig{
 						CPU:    aws.Int(1024),
 						Memory: aws.Int(1024),
-						Count:  aws.Int(1),
+						Count:  Count{Value: aws.Int(1)},
 						Variables: map[string]string{
 							"LOG_LEVEL":      "DEBUG",
 							"DDB_TABLE_NAME": "awards",
 						},
-						Secrets: map[string]string{
-							"GITHUB_TOKEN": "1111",
-							"TWILIO_TOKEN": "1111",
+						Secrets: map[string]Secret{
+							"GITHUB_TOKEN": {From: aws.String("1111")},
+							"TWILIO_TOKEN": {From: aws.String("1111")},
 						},
 					},
 					Sidecar: Sidecar{
@@ -190,7 +198,7 @@ func TestLoadBalancedWebSvc_ApplyEnv(t *testing.T) {
 						},
 						TaskConfig: TaskConfig{
 							CPU:   aws.Int(2046),
-							Count: aws.Int(0),
+							Count: Count{Value: aws.Int(0)},
 							Variables: map[string]string{
 								"DDB_TABLE_NAME": "awards-prod",
 							},
@@ -229,21 +237,23 @@ func TestLoadBalancedWebSvc_ApplyEnv(t *testing.T) {
 						Port: aws.Uint16(5000),
 					},
 					RoutingRule: RoutingRule{
-						Path:            aws.String("/awards/*"),
-						HealthCheckPath: aws.String("/"),
+						Path: aws.String("/awards/*"),
+						HealthCheck: HealthCheckArgsOrString{
+							HealthCheckPath: aws.String("/"),
+						},
 						TargetContainer: aws.String("xray"),
 					},
 					TaskConfig: TaskConfig{
 						CPU:    aws.Int(2046),
 						Memory: aws.Int(1024),
-						Count:  aws.Int(0),
+						Count:  Count{Value: aws.Int(0)},
 						Variables: map[string]string{
 							"LOG_LEVEL":      "DEBUG",
 							"DDB_TABLE_NAME": "awards-prod",
 						},
-						Secrets: map[string]string{
-							"GITHUB_TOKEN": "1111",
-							"TWILIO_TOKEN": "1111",
+						Secrets: map[string]Secret{
+							"GITHUB_TOKEN": {From: aws.String("1111")},
+							"TWILIO_TOKEN": {From: aws.String("1111")},
 						},
 					},
 					Sidecar: Sidecar{
@@ -264,6 +274,253 @@ func TestLoadBalancedWebSvc_ApplyEnv(t *testing.T) {
 				},
 			},
 		},
+		"with autoscaling overridden by a fixed count": {
+			in: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					TaskConfig: TaskConfig{
+						Count: Count{
+							Autoscaling: Autoscaling{
+								Range: rangep("1-10"),
+								CPU:   aws.Int(70),
+							},
+						},
+					},
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"test": {
+						TaskConfig: TaskConfig{
+							Count: Count{Value: aws.Int(1)},
+						},
+					},
+				},
+			},
+			envToApply: "test",
+
+			wanted: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					TaskConfig: TaskConfig{
+						Count: Count{Value: aws.Int(1)},
+					},
+				},
+			},
+		},
+		"with the autoscaling range overridden": {
+			in: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					TaskConfig: TaskConfig{
+						Count: Count{
+							Autoscaling: Autoscaling{
+								Range: rangep("1-10"),
+								CPU:   aws.Int(70),
+							},
+						},
+					},
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"prod": {
+						TaskConfig: TaskConfig{
+							Count: Count{
+								Autoscaling: Autoscaling{
+									Range: rangep("5-50"),
+								},
+							},
+						},
+					},
+				},
+			},
+			envToApply: "prod",
+
+			wanted: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					TaskConfig: TaskConfig{
+						Count: Count{
+							Autoscaling: Autoscaling{
+								Range: rangep("5-50"),
+								CPU:   aws.Int(70),
+							},
+						},
+					},
+				},
+			},
+		},
+		"with the built image overridden by an existing image": {
+			in: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Image: ServiceImageWithPort{
+						ServiceImage: ServiceImage{
+							Build: BuildArgsOrString{
+								BuildString: aws.String("./Dockerfile"),
+							},
+						},
+						Port: aws.Uint16(80),
+					},
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"prod": {
+						Image: ServiceImageWithPort{
+							ServiceImage: ServiceImage{
+								Location: aws.String("nginx:1.19"),
+							},
+						},
+					},
+				},
+			},
+			envToApply: "prod",
+
+			wanted: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Image: ServiceImageWithPort{
+						ServiceImage: ServiceImage{
+							Location: aws.String("nginx:1.19"),
+						},
+						Port: aws.Uint16(80),
+					},
+				},
+			},
+		},
+		"with an existing image overridden by a built image": {
+			in: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Image: ServiceImageWithPort{
+						ServiceImage: ServiceImage{
+							Location: aws.String("nginx:1.19"),
+						},
+						Port: aws.Uint16(80),
+					},
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"test": {
+						Image: ServiceImageWithPort{
+							ServiceImage: ServiceImage{
+								Build: BuildArgsOrString{
+									BuildString: aws.String("./Dockerfile"),
+								},
+							},
+						},
+					},
+				},
+			},
+			envToApply: "test",
+
+			wanted: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Image: ServiceImageWithPort{
+						ServiceImage: ServiceImage{
+							Build: BuildArgsOrString{
+								BuildString: aws.String("./Dockerfile"),
+							},
+						},
+						Port: aws.Uint16(80),
+					},
+				},
+			},
+		},
+		"with deployment configuration overridden": {
+			in: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Deployment: DeploymentConfig{
+						CircuitBreaker: aws.Bool(true),
+						RollbackAlarms: []string{"frontend-5xx"},
+					},
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"prod": {
+						Deployment: DeploymentConfig{
+							MinHealthyPercent: aws.Int(50),
+						},
+					},
+				},
+			},
+			envToApply: "prod",
+
+			wanted: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					Deployment: DeploymentConfig{
+						CircuitBreaker:    aws.Bool(true),
+						MinHealthyPercent: aws.Int(50),
+						RollbackAlarms:    []string{"frontend-5xx"},
+					},
+				},
+			},
+		},
+		"with the health check path overridden by a string": {
+			in: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					RoutingRule: RoutingRule{
+						HealthCheck: HealthCheckArgsOrString{
+							HealthCheckArgs: HTTPHealthCheckArgs{
+								Path:             aws.String("/health"),
+								HealthyThreshold: aws.Int64(3),
+							},
+						},
+						Stickiness: aws.Bool(true),
+					},
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"prod": {
+						RoutingRule: RoutingRule{
+							HealthCheck: HealthCheckArgsOrString{
+								HealthCheckPath: aws.String("/ping"),
+							},
+							DeregistrationDelay: durationp(30 * time.Second),
+						},
+					},
+				},
+			},
+			envToApply: "prod",
+
+			wanted: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					RoutingRule: RoutingRule{
+						HealthCheck: HealthCheckArgsOrString{
+							HealthCheckPath: aws.String("/ping"),
+							HealthCheckArgs: HTTPHealthCheckArgs{
+								HealthyThreshold: aws.Int64(3),
+							},
+						},
+						Stickiness:          aws.Bool(true),
+						DeregistrationDelay: durationp(30 * time.Second),
+					},
+				},
+			},
+		},
+		"with the health check map overridden": {
+			in: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					RoutingRule: RoutingRule{
+						HealthCheck: HealthCheckArgsOrString{
+							HealthCheckPath: aws.String("/"),
+						},
+					},
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"prod": {
+						RoutingRule: RoutingRule{
+							HealthCheck: HealthCheckArgsOrString{
+								HealthCheckArgs: HTTPHealthCheckArgs{
+									Path:        aws.String("/health"),
+									GracePeriod: durationp(2 * time.Minute),
+								},
+							},
+						},
+					},
+				},
+			},
+			envToApply: "prod",
+
+			wanted: &LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					RoutingRule: RoutingRule{
+						HealthCheck: HealthCheckArgsOrString{
+							HealthCheckArgs: HTTPHealthCheckArgs{
+								Path:        aws.String("/health"),
+								GracePeriod: durationp(2 * time.Minute),
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -278,3 +535,313 @@ func TestLoadBalancedWebSvc_ApplyEnv(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadBalancedWebSvc_EnvWorkload(t *testing.T) {
+	// GIVEN
+	mft := &LoadBalancedWebService{
+		Service: Service{
+			Name: aws.String("frontend"),
+			Type: aws.String(LoadBalancedWebServiceType),
+		},
+		LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+			Image: ServiceImageWithPort{
+				ServiceImage: ServiceImage{
+					Location: aws.String("nginx:latest"),
+				},
+				Port: aws.Uint16(80),
+			},
+		},
+		Environments: map[string]*LoadBalancedWebServiceConfig{
+			"prod": {
+				Image: ServiceImageWithPort{
+					Port: aws.Uint16(8080),
+				},
+			},
+		},
+	}
+
+	// WHEN
+	got, err := mft.EnvWorkload("prod")
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "nginx:latest", aws.StringValue(got.Image.Location))
+	require.Equal(t, uint16(8080), aws.Uint16Value(got.Port))
+}
+
+func TestHealthCheckArgsOrString_UnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wantedStruct HealthCheckArgsOrString
+		wantedError  error
+	}{
+		"legacy case: simple path": {
+			inContent: []byte(`healthcheck: /ping`),
+
+			wantedStruct: HealthCheckArgsOrString{
+				HealthCheckPath: aws.String("/ping"),
+			},
+		},
+		"health check map specified": {
+			inContent: []byte(`healthcheck:
+  path: /health
+  success_codes: '200,301'
+  healthy_threshold: 3
+  unhealthy_threshold: 2
+  interval: 15s
+  timeout: 10s
+  grace_period: 1m`),
+
+			wantedStruct: HealthCheckArgsOrString{
+				HealthCheckArgs: HTTPHealthCheckArgs{
+					Path:               aws.String("/health"),
+					SuccessCodes:       aws.String("200,301"),
+					HealthyThreshold:   aws.Int64(3),
+					UnhealthyThreshold: aws.Int64(2),
+					Interval:           durationp(15 * time.Second),
+					Timeout:            durationp(10 * time.Second),
+					GracePeriod:        durationp(time.Minute),
+				},
+			},
+		},
+		"error if unmarshalable": {
+			inContent: []byte(`healthcheck:
+  badfield: OH NOES`),
+			wantedError: errUnmarshalHealthCheckOpts,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var rule RoutingRule
+			err := yaml.Unmarshal(tc.inContent, &rule)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedStruct, rule.HealthCheck)
+			}
+		})
+	}
+}
+
+func TestRoutingRule_TargetGroupOpts(t *testing.T) {
+	testCases := map[string]struct {
+		in RoutingRule
+
+		wanted    template.TargetGroupOpts
+		wantedErr error
+	}{
+		"defaults to the root path": {
+			in: RoutingRule{},
+
+			wanted: template.TargetGroupOpts{
+				HealthCheck: template.HTTPHealthCheckOpts{
+					HealthCheckPath: "/",
+				},
+			},
+		},
+		"converts durations to seconds": {
+			in: RoutingRule{
+				HealthCheck: HealthCheckArgsOrString{
+					HealthCheckPath: aws.String("/"),
+					HealthCheckArgs: HTTPHealthCheckArgs{
+						Path:        aws.String("/health"),
+						Interval:    durationp(15 * time.Second),
+						Timeout:     durationp(10 * time.Second),
+						GracePeriod: durationp(2 * time.Minute),
+					},
+				},
+				Stickiness:          aws.Bool(true),
+				DeregistrationDelay: durationp(0),
+			},
+
+			wanted: template.TargetGroupOpts{
+				HealthCheck: template.HTTPHealthCheckOpts{
+					HealthCheckPath: "/health",
+					Interval:        aws.Int64(15),
+					Timeout:         aws.Int64(10),
+					GracePeriod:     aws.Int64(120),
+				},
+				Stickiness:          true,
+				DeregistrationDelay: aws.Int64(0),
+			},
+		},
+		"error if the timeout is less than a second": {
+			in: RoutingRule{
+				HealthCheck: HealthCheckArgsOrString{
+					HealthCheckArgs: HTTPHealthCheckArgs{
+						Timeout: durationp(500 * time.Millisecond),
+					},
+				},
+			},
+
+			wantedErr: errors.New(`"healthcheck.timeout" must be a whole number of seconds between 2 and 120, got 500ms`),
+		},
+		"error if the interval is zero": {
+			in: RoutingRule{
+				HealthCheck: HealthCheckArgsOrString{
+					HealthCheckArgs: HTTPHealthCheckArgs{
+						Interval: durationp(0),
+					},
+				},
+			},
+
+			wantedErr: errors.New(`"healthcheck.interval" must be a whole number of seconds between 5 and 300, got 0s`),
+		},
+		"error if the grace period isn't a whole number of seconds": {
+			in: RoutingRule{
+				HealthCheck: HealthCheckArgsOrString{
+					HealthCheckArgs: HTTPHealthCheckArgs{
+						GracePeriod: durationp(1500 * time.Millisecond),
+					},
+				},
+			},
+
+			wantedErr: errors.New(`"healthcheck.grace_period" must be a whole number of seconds between 0 and 2147483647, got 1.5s`),
+		},
+		"error if the deregistration delay is longer than an hour": {
+			in: RoutingRule{
+				DeregistrationDelay: durationp(2 * time.Hour),
+			},
+
+			wantedErr: errors.New(`"deregistration_delay" must be a whole number of seconds between 0 and 3600, got 2h0m0s`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.TargetGroupOpts()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestNLBPort_Parse(t *testing.T) {
+	testCases := map[string]struct {
+		in NLBPort
+
+		wantedPort     uint16
+		wantedProtocol string
+		wantedErr      error
+	}{
+		"defaults to tcp": {
+			in: "1883",
+
+			wantedPort:     1883,
+			wantedProtocol: NLBProtocolTCP,
+		},
+		"tls listener": {
+			in: "8883/TLS",
+
+			wantedPort:     8883,
+			wantedProtocol: NLBProtocolTLS,
+		},
+		"invalid port": {
+			in: "mqtt/tcp",
+
+			wantedErr: errInvalidNLBPortFormat,
+		},
+		"too many separators": {
+			in: "80/tcp/tls",
+
+			wantedErr: errInvalidNLBPortFormat,
+		},
+		"unsupported protocol": {
+			in: "53/udp",
+
+			wantedErr: errors.New("nlb protocol udp must be one of tcp, tls"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			port, protocol, err := tc.in.Parse()
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPort, port)
+			require.Equal(t, tc.wantedProtocol, protocol)
+		})
+	}
+}
+
+func TestRoutingRule_ListenerRulesOpts(t *testing.T) {
+	testCases := map[string]struct {
+		in RoutingRule
+
+		wanted    []*template.ListenerRuleOpts
+		wantedErr error
+	}{
+		"returns nil if there are no additional rules": {},
+		"errors if a rule has no conditions": {
+			in: RoutingRule{
+				AdditionalRules: []ListenerRule{
+					{Path: aws.String("v2/api")},
+					{},
+				},
+			},
+
+			wantedErr: errors.New(`additional rule 2 must specify at least one of "path", "hosts", "http_headers" or "query_strings"`),
+		},
+		"converts paths, hosts and conditions": {
+			in: RoutingRule{
+				AdditionalRules: []ListenerRule{
+					{
+						Path: aws.String("/v2/api/"),
+						HTTPHeaders: map[string][]string{
+							"X-Version":  {"2"},
+							"User-Agent": {"*Mobile*", "*Android*"},
+						},
+						QueryStrings: map[string]string{
+							"version": "2",
+							"beta":    "true",
+						},
+					},
+					{
+						Path:  aws.String("/"),
+						Hosts: []string{"api.example.com"},
+					},
+				},
+			},
+
+			wanted: []*template.ListenerRuleOpts{
+				{
+					Path:         aws.String("v2/api"),
+					PathPatterns: []string{"/v2/api", "/v2/api/*"},
+					HTTPHeaders: []template.HTTPHeaderCondition{
+						{Name: "User-Agent", Values: []string{"*Mobile*", "*Android*"}},
+						{Name: "X-Version", Values: []string{"2"}},
+					},
+					QueryStrings: []template.QueryStringCondition{
+						{Key: "beta", Value: "true"},
+						{Key: "version", Value: "2"},
+					},
+				},
+				{
+					Path:         aws.String(""),
+					PathPatterns: []string{"/*"},
+					Hosts:        []string{"api.example.com"},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.ListenerRulesOpts()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+)
+
+const (
+	policyEffectAllow = "Allow"
+	policyEffectDeny  = "Deny"
+
+	managedPolicyARNPrefix = "arn:"
+	managedPolicyResource  = ":policy/"
+)
+
+var (
+	errNoPolicyActions   = errors.New(`"actions" must be specified`)
+	errNoPolicyResources = errors.New(`"resources" must be specified`)
+)
+
+// Permissions represents the IAM permissions granted to the task role, in addition to the managed policies of the addons.
+type Permissions struct {
+	ManagedPolicies []string          `yaml:"managed_policies"` // ARNs of existing managed policies.
+	Statements      []PolicyStatement `yaml:"statements"`
+}
+
+// PolicyStatement represents a statement of the inline policy of the task role.
+type PolicyStatement struct {
+	Effect    *string  `yaml:"effect"` // "Allow" or "Deny", defaults to "Allow".
+	Actions   []string `yaml:"actions"`
+	Resources []string `yaml:"resources"` // ARNs, which can reference pseudo parameters such as "${AWS::Region}" and IAM policy variables such as "${aws:username}".
+}
+
+// PermissionsOpts converts the task role's permissions into a format parsable by the templates pkg.
+// If no permissions are specified, it returns nil.
+func (p *Permissions) PermissionsOpts() (*template.PermissionsOpts, error) {
+	if p == nil || (len(p.ManagedPolicies) == 0 && len(p.Statements) == 0) {
+		return nil, nil
+	}
+	for _, arn := range p.ManagedPolicies {
+		if !strings.HasPrefix(arn, managedPolicyARNPrefix) || !strings.Contains(arn, managedPolicyResource) {
+			return nil, fmt.Errorf("managed policy %q must be the ARN of an IAM policy", arn)
+		}
+	}
+	opts := &template.PermissionsOpts{
+		ManagedPolicies: p.ManagedPolicies,
+	}
+	for i, statement := range p.Statements {
+		if err := statement.validate(); err != nil {
+			return nil, fmt.Errorf("validate statement %d: %w", i+1, err)
+		}
+		effect := policyEffectAllow
+		if statement.Effect != nil {
+			effect = aws.StringValue(statement.Effect)
+		}
+		opts.Statements = append(opts.Statements, &template.PolicyStatementOpts{
+			Effect:    effect,
+			Actions:   statement.Actions,
+			Resources: statement.Resources,
+		})
+	}
+	return opts, nil
+}
+
+// validate returns an error if the statement has an unknown effect, or is missing its actions or resources.
+func (s PolicyStatement) validate() error {
+	if s.Effect != nil {
+		if effect := aws.StringValue(s.Effect); effect != policyEffectAllow && effect != policyEffectDeny {
+			return fmt.Errorf(`"effect" %q must be one of %s or %s`, effect, policyEffectAllow, policyEffectDeny)
+		}
+	}
+	if len(s.Actions) == 0 {
+		return errNoPolicyActions
+	}
+	for _, action := range s.Actions {
+		if action != "*" && !strings.Contains(action, ":") {
+			return fmt.Errorf(`action %q must be in the format "service:action"`, action)
+		}
+	}
+	if len(s.Resources) == 0 {
+		return errNoPolicyResources
+	}
+	for _, resource := range s.Resources {
+		if resource == "" {
+			return fmt.Errorf(`"resources" must not contain empty values`)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestPermissions_UnmarshalYAML(t *testing.T) {
+	in := []byte(`managed_policies:
+  - arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess
+statements:
+  - actions: [sqs:SendMessage]
+    resources: ['arn:${AWS::Partition}:sqs:${AWS::Region}:${AWS::AccountId}:orders']
+  - effect: Deny
+    actions:
+      - s3:DeleteObject
+    resources:
+      - '*'
+`)
+
+	var got Permissions
+	require.NoError(t, yaml.Unmarshal(in, &got))
+
+	require.Equal(t, Permissions{
+		ManagedPolicies: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+		Statements: []PolicyStatement{
+			{
+				Actions:   []string{"sqs:SendMessage"},
+				Resources: []string{"arn:${AWS::Partition}:sqs:${AWS::Region}:${AWS::AccountId}:orders"},
+			},
+			{
+				Effect:    aws.String("Deny"),
+				Actions:   []string{"s3:DeleteObject"},
+				Resources: []string{"*"},
+			},
+		},
+	}, got)
+}
+
+func TestPermissions_PermissionsOpts(t *testing.T) {
+	testCases := map[string]struct {
+		in *Permissions
+
+		wanted    *template.PermissionsOpts
+		wantedErr error
+	}{
+		"returns nil if there are no permissions": {},
+		"returns nil if the permissions are empty": {
+			in: &Permissions{},
+		},
+		"errors if a managed policy is not an ARN": {
+			in: &Permissions{
+				ManagedPolicies: []string{"AmazonS3ReadOnlyAccess"},
+			},
+			wantedErr: errors.New(`managed policy "AmazonS3ReadOnlyAccess" must be the ARN of an IAM policy`),
+		},
+		"errors if the effect is unknown": {
+			in: &Permissions{
+				Statements: []PolicyStatement{
+					{
+						Effect:    aws.String("allow"),
+						Actions:   []string{"sqs:SendMessage"},
+						Resources: []string{"*"},
+					},
+				},
+			},
+			wantedErr: errors.New(`validate statement 1: "effect" "allow" must be one of Allow or Deny`),
+		},
+		"errors if the actions are missing": {
+			in: &Permissions{
+				Statements: []PolicyStatement{
+					{
+						Resources: []string{"*"},
+					},
+				},
+			},
+			wantedErr: fmt.Errorf("validate statement 1: %w", errNoPolicyActions),
+		},
+		"errors if an action has no service prefix": {
+			in: &Permissions{
+				Statements: []PolicyStatement{
+					{
+						Actions:   []string{"SendMessage"},
+						Resources: []string{"*"},
+					},
+				},
+			},
+			wantedErr: errors.New(`validate statement 1: action "SendMessage" must be in the format "service:action"`),
+		},
+		"errors if the resources are missing": {
+			in: &Permissions{
+				Statements: []PolicyStatement{
+					{
+						Actions: []string{"sqs:*"},
+					},
+					{
+						Actions: []string{"sqs:SendMessage"},
+					},
+				},
+			},
+			wantedErr: fmt.Errorf("validate statement 1: %w", errNoPolicyResources),
+		},
+		"converts managed policies and statements with the default effect": {
+			in: &Permissions{
+				ManagedPolicies: []string{"arn:aws:iam::123456789012:policy/orders"},
+				Statements: []PolicyStatement{
+					{
+						Actions:   []string{"sqs:SendMessage"},
+						Resources: []string{"arn:aws:sqs:us-west-2:123456789012:orders"},
+					},
+					{
+						Effect:    aws.String("Deny"),
+						Actions:   []string{"*"},
+						Resources: []string{"*"},
+					},
+				},
+			},
+			wanted: &template.PermissionsOpts{
+				ManagedPolicies: []string{"arn:aws:iam::123456789012:policy/orders"},
+				Statements: []*template.PolicyStatementOpts{
+					{
+						Effect:    "Allow",
+						Actions:   []string{"sqs:SendMessage"},
+						Resources: []string{"arn:aws:sqs:us-west-2:123456789012:orders"},
+					},
+					{
+						Effect:    "Deny",
+						Actions:   []string{"*"},
+						Resources: []string{"*"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.PermissionsOpts()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"gopkg.in/yaml.v3"
+)
+
+// Capacity providers that tasks can run on.
+const (
+	CapacityProviderFargate     = "FARGATE"
+	CapacityProviderFargateSpot = "FARGATE_SPOT"
+)
+
+var validCapacityProviders = []string{CapacityProviderFargate, CapacityProviderFargateSpot}
+
+// Operating systems and CPU architectures that tasks can run on.
+const (
+	OSLinux   = "linux"
+	ArchAMD64 = "amd64"
+	ArchX86   = "x86_64"
+	ArchARM64 = "arm64"
+)
+
+var validPlatforms = []string{
+	platformString(OSLinux, ArchAMD64),
+	platformString(OSLinux, ArchX86),
+	platformString(OSLinux, ArchARM64),
+}
+
+// fargateTaskSizes maps the CPU units of a Fargate task to the memory values, in MiB, that it supports.
+var fargateTaskSizes = map[int][]int{
+	256:  {512, 1024, 2048},
+	512:  memoryRange(1024, 4096),
+	1024: memoryRange(2048, 8192),
+	2048: memoryRange(4096, 16384),
+	4096: memoryRange(8192, 30720),
+}
+
+var (
+	errNoCapacityProvider   = errors.New(`"provider" must be specified`)
+	errMultipleBases        = errors.New(`"base" can only be specified for one capacity provider`)
+	errNoCapacityWithWeight = errors.New(`at least one capacity provider must have a "weight" greater than 0`)
+	errArmOnFargateSpot     = errors.New(`tasks with the "arm64" architecture can't run on FARGATE_SPOT`)
+	errUnmarshalPlatform    = errors.New(`can't unmarshal platform field into string or a platform map`)
+)
+
+// PlatformConfig represents the infrastructure that the tasks run on.
+// It supports unmarshaling yaml which can either be of type string, such as "linux/arm64", or type PlatformArgs.
+type PlatformConfig struct {
+	PlatformString *string
+	PlatformArgs   PlatformArgs
+}
+
+// PlatformArgs represents the operating system, CPU architecture and capacity that the tasks run on.
+type PlatformArgs struct {
+	OSFamily     *string                    `yaml:"osfamily"`
+	Architecture *string                    `yaml:"architecture"`
+	Capacity     []CapacityProviderStrategy `yaml:"capacity"`
+}
+
+func (a *PlatformArgs) isEmpty() bool {
+	return a.OSFamily == nil && a.Architecture == nil && len(a.Capacity) == 0
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the PlatformConfig
+// struct, allowing it to perform more complex unmarshaling behavior.
+// This method implements the yaml.Unmarshaler (v2) interface.
+func (p *PlatformConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if err := unmarshal(&p.PlatformArgs); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+
+	if !p.PlatformArgs.isEmpty() {
+		// Unmarshaled successfully to p.PlatformArgs, return.
+		return nil
+	}
+
+	if err := unmarshal(&p.PlatformString); err != nil {
+		return errUnmarshalPlatform
+	}
+	return nil
+}
+
+// applyOverride clears the operating system and architecture if the override specifies them with a different form.
+// The capacity configuration is kept since it can only be specified in the map form.
+func (p *PlatformConfig) applyOverride(override PlatformConfig) {
+	if override.PlatformString != nil {
+		p.PlatformArgs.OSFamily = nil
+		p.PlatformArgs.Architecture = nil
+	}
+	if override.PlatformArgs.OSFamily != nil || override.PlatformArgs.Architecture != nil {
+		p.PlatformString = nil
+	}
+}
+
+// Platform returns the platform of the tasks formatted as "<os>/<arch>", such as "linux/arm64".
+// If neither the operating system nor the architecture is specified, it returns an empty string.
+func (p PlatformConfig) Platform() string {
+	if p.PlatformString != nil {
+		return strings.ToLower(aws.StringValue(p.PlatformString))
+	}
+	if p.PlatformArgs.OSFamily == nil && p.PlatformArgs.Architecture == nil {
+		return ""
+	}
+	os, arch := OSLinux, ArchX86
+	if p.PlatformArgs.OSFamily != nil {
+		os = aws.StringValue(p.PlatformArgs.OSFamily)
+	}
+	if p.PlatformArgs.Architecture != nil {
+		arch = aws.StringValue(p.PlatformArgs.Architecture)
+	}
+	return strings.ToLower(platformString(os, arch))
+}
+
+// ParsePlatform converts a platform formatted as "<os>/<arch>" into a format parsable by the templates pkg.
+func ParsePlatform(platform string) (*template.RuntimePlatformOpts, error) {
+	if !isValidPlatform(platform) {
+		return nil, fmt.Errorf("platform %s must be one of %s", platform, strings.Join(validPlatforms, ", "))
+	}
+	arch := template.ArchX86
+	if strings.HasSuffix(platform, ArchARM64) {
+		arch = template.ArchARM64
+	}
+	return &template.RuntimePlatformOpts{
+		OS:   template.OSLinux,
+		Arch: arch,
+	}, nil
+}
+
+// RuntimePlatformOpts converts the platform of the tasks into a format parsable by the templates pkg.
+// If no platform is specified, it returns nil so that the tasks run on Linux x86_64.
+func (tc TaskConfig) RuntimePlatformOpts() (*template.RuntimePlatformOpts, error) {
+	platform := tc.Platform.Platform()
+	if platform == "" {
+		return nil, nil
+	}
+	opts, err := ParsePlatform(platform)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateFargateTaskSize(aws.IntValue(tc.CPU), aws.IntValue(tc.Memory)); err != nil {
+		return nil, err
+	}
+	if opts.Arch == template.ArchARM64 {
+		for _, strategy := range tc.Platform.PlatformArgs.Capacity {
+			if aws.StringValue(strategy.Provider) == CapacityProviderFargateSpot {
+				return nil, errArmOnFargateSpot
+			}
+		}
+	}
+	return opts, nil
+}
+
+// ValidateFargateTaskSize returns an error if the combination of CPU units and memory, in MiB, is not supported by Fargate.
+func ValidateFargateTaskSize(cpu, memory int) error {
+	sizes, ok := fargateTaskSizes[cpu]
+	if !ok {
+		return fmt.Errorf("cpu %d must be one of 256, 512, 1024, 2048, 4096 to run on Fargate", cpu)
+	}
+	for _, size := range sizes {
+		if memory == size {
+			return nil
+		}
+	}
+	return fmt.Errorf("memory %d is not supported by Fargate for cpu %d", memory, cpu)
+}
+
+// CapacityProviderStrategy represents how tasks are distributed on a capacity provider.
+// The first "base" tasks are launched on the provider, and the remaining tasks are split
+// between the providers proportionally to their "weight".
+type CapacityProviderStrategy struct {
+	Provider *string `yaml:"provider"`
+	Weight   *int    `yaml:"weight"`
+	Base     *int    `yaml:"base"`
+}
+
+// CapacityProviderOpts converts the platform's capacity configuration into a format parsable by the templates pkg.
+// If no capacity is specified, it returns nil so that the tasks are launched on Fargate.
+func (p PlatformConfig) CapacityProviderOpts() ([]*template.CapacityProviderStrategy, error) {
+	if len(p.PlatformArgs.Capacity) == 0 {
+		return nil, nil
+	}
+	var opts []*template.CapacityProviderStrategy
+	var hasBase, hasWeight bool
+	seen := make(map[string]bool)
+	for _, strategy := range p.PlatformArgs.Capacity {
+		provider := aws.StringValue(strategy.Provider)
+		if provider == "" {
+			return nil, errNoCapacityProvider
+		}
+		if !isValidCapacityProvider(provider) {
+			return nil, fmt.Errorf(`capacity provider %s must be one of %s`, provider, strings.Join(validCapacityProviders, ", "))
+		}
+		if seen[provider] {
+			return nil, fmt.Errorf(`capacity provider %s is specified more than once`, provider)
+		}
+		seen[provider] = true
+		weight := 1 // A provider without a weight gets the same share of tasks as the others.
+		if strategy.Weight != nil {
+			weight = *strategy.Weight
+		}
+		if weight < 0 {
+			return nil, fmt.Errorf(`"weight" of capacity provider %s must not be negative`, provider)
+		}
+		if strategy.Base != nil {
+			if *strategy.Base < 0 {
+				return nil, fmt.Errorf(`"base" of capacity provider %s must not be negative`, provider)
+			}
+			if hasBase {
+				return nil, errMultipleBases
+			}
+			hasBase = true
+		}
+		hasWeight = hasWeight || weight > 0
+		opts = append(opts, &template.CapacityProviderStrategy{
+			Provider: provider,
+			Weight:   weight,
+			Base:     strategy.Base,
+		})
+	}
+	if !hasWeight {
+		return nil, errNoCapacityWithWeight
+	}
+	return opts, nil
+}
+
+func isValidCapacityProvider(provider string) bool {
+	for _, valid := range validCapacityProviders {
+		if provider == valid {
+			return true
+		}
+	}
+	return false
+}
+
+func isValidPlatform(platform string) bool {
+	for _, valid := range validPlatforms {
+		if platform == valid {
+			return true
+		}
+	}
+	return false
+}
+
+func platformString(os, arch string) string {
+	return fmt.Sprintf("%s/%s", os, arch)
+}
+
+func memoryRange(min, max int) []int {
+	var sizes []int
+	for size := min; size <= max; size += 1024 {
+		sizes = append(sizes, size)
+	}
+	return sizes
+}
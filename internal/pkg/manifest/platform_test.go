@@ -0,0 +1,317 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestPlatformConfig_UnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wantedStruct PlatformConfig
+		wantedError  error
+	}{
+		"unmarshal platform string": {
+			inContent: []byte(`platform: linux/arm64`),
+
+			wantedStruct: PlatformConfig{
+				PlatformString: aws.String("linux/arm64"),
+			},
+		},
+		"unmarshal platform map": {
+			inContent: []byte(`platform:
+  osfamily: linux
+  architecture: arm64
+  capacity:
+    - provider: FARGATE
+      base: 1
+    - provider: FARGATE_SPOT
+      weight: 3
+`),
+
+			wantedStruct: PlatformConfig{
+				PlatformArgs: PlatformArgs{
+					OSFamily:     aws.String("linux"),
+					Architecture: aws.String("arm64"),
+					Capacity: []CapacityProviderStrategy{
+						{
+							Provider: aws.String("FARGATE"),
+							Base:     aws.Int(1),
+						},
+						{
+							Provider: aws.String("FARGATE_SPOT"),
+							Weight:   aws.Int(3),
+						},
+					},
+				},
+			},
+		},
+		"error if unmarshalable": {
+			inContent: []byte(`platform:
+  - linux/arm64
+`),
+
+			wantedError: errUnmarshalPlatform,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var got struct {
+				Platform PlatformConfig `yaml:"platform"`
+			}
+
+			err := yaml.Unmarshal(tc.inContent, &got)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStruct, got.Platform)
+		})
+	}
+}
+
+func TestPlatformConfig_Platform(t *testing.T) {
+	testCases := map[string]struct {
+		in     PlatformConfig
+		wanted string
+	}{
+		"returns an empty string if the platform is not specified": {
+			in: PlatformConfig{
+				PlatformArgs: PlatformArgs{
+					Capacity: []CapacityProviderStrategy{{Provider: aws.String("FARGATE")}},
+				},
+			},
+		},
+		"returns the platform string": {
+			in: PlatformConfig{
+				PlatformString: aws.String("Linux/ARM64"),
+			},
+			wanted: "linux/arm64",
+		},
+		"defaults the operating system to linux": {
+			in: PlatformConfig{
+				PlatformArgs: PlatformArgs{
+					Architecture: aws.String("arm64"),
+				},
+			},
+			wanted: "linux/arm64",
+		},
+		"defaults the architecture to x86_64": {
+			in: PlatformConfig{
+				PlatformArgs: PlatformArgs{
+					OSFamily: aws.String("linux"),
+				},
+			},
+			wanted: "linux/x86_64",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.Platform())
+		})
+	}
+}
+
+func TestTaskConfig_RuntimePlatformOpts(t *testing.T) {
+	testCases := map[string]struct {
+		in TaskConfig
+
+		wanted    *template.RuntimePlatformOpts
+		wantedErr error
+	}{
+		"returns nil if no platform is specified": {
+			in: TaskConfig{
+				CPU:    aws.Int(100),
+				Memory: aws.Int(100),
+			},
+		},
+		"error if the platform is not supported": {
+			in: TaskConfig{
+				CPU:      aws.Int(256),
+				Memory:   aws.Int(512),
+				Platform: PlatformConfig{PlatformString: aws.String("windows/amd64")},
+			},
+			wantedErr: errors.New("platform windows/amd64 must be one of linux/amd64, linux/x86_64, linux/arm64"),
+		},
+		"error if the cpu is not supported by Fargate": {
+			in: TaskConfig{
+				CPU:      aws.Int(100),
+				Memory:   aws.Int(512),
+				Platform: PlatformConfig{PlatformString: aws.String("linux/arm64")},
+			},
+			wantedErr: errors.New("cpu 100 must be one of 256, 512, 1024, 2048, 4096 to run on Fargate"),
+		},
+		"error if the memory is not supported by Fargate for the cpu": {
+			in: TaskConfig{
+				CPU:      aws.Int(2048),
+				Memory:   aws.Int(2048),
+				Platform: PlatformConfig{PlatformString: aws.String("linux/arm64")},
+			},
+			wantedErr: errors.New("memory 2048 is not supported by Fargate for cpu 2048"),
+		},
+		"error if arm64 tasks run on Fargate Spot": {
+			in: TaskConfig{
+				CPU:    aws.Int(256),
+				Memory: aws.Int(512),
+				Platform: PlatformConfig{
+					PlatformArgs: PlatformArgs{
+						Architecture: aws.String("arm64"),
+						Capacity:     []CapacityProviderStrategy{{Provider: aws.String("FARGATE_SPOT")}},
+					},
+				},
+			},
+			wantedErr: errArmOnFargateSpot,
+		},
+		"returns the arm64 platform": {
+			in: TaskConfig{
+				CPU:      aws.Int(4096),
+				Memory:   aws.Int(30720),
+				Platform: PlatformConfig{PlatformString: aws.String("linux/arm64")},
+			},
+			wanted: &template.RuntimePlatformOpts{
+				OS:   "LINUX",
+				Arch: "ARM64",
+			},
+		},
+		"returns the x86_64 platform": {
+			in: TaskConfig{
+				CPU:      aws.Int(512),
+				Memory:   aws.Int(1024),
+				Platform: PlatformConfig{PlatformString: aws.String("linux/amd64")},
+			},
+			wanted: &template.RuntimePlatformOpts{
+				OS:   "LINUX",
+				Arch: "X86_64",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.RuntimePlatformOpts()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestPlatformConfig_CapacityProviderOpts(t *testing.T) {
+	testCases := map[string]struct {
+		in PlatformConfig
+
+		wanted    []*template.CapacityProviderStrategy
+		wantedErr error
+	}{
+		"returns nil if no capacity is specified": {
+			in: PlatformConfig{},
+		},
+		"error if the provider is missing": {
+			in: PlatformConfig{
+				PlatformArgs: PlatformArgs{
+					Capacity: []CapacityProviderStrategy{{Weight: aws.Int(1)}},
+				},
+			},
+			wantedErr: errNoCapacityProvider,
+		},
+		"error if the provider is not supported": {
+			in: PlatformConfig{
+				PlatformArgs: PlatformArgs{
+					Capacity: []CapacityProviderStrategy{{Provider: aws.String("EC2")}},
+				},
+			},
+			wantedErr: errors.New("capacity provider EC2 must be one of FARGATE, FARGATE_SPOT"),
+		},
+		"error if a provider is specified twice": {
+			in: PlatformConfig{
+				PlatformArgs: PlatformArgs{
+					Capacity: []CapacityProviderStrategy{
+						{Provider: aws.String("FARGATE_SPOT")},
+						{Provider: aws.String("FARGATE_SPOT")},
+					},
+				},
+			},
+			wantedErr: errors.New("capacity provider FARGATE_SPOT is specified more than once"),
+		},
+		"error if the weight is negative": {
+			in: PlatformConfig{
+				PlatformArgs: PlatformArgs{
+					Capacity: []CapacityProviderStrategy{{Provider: aws.String("FARGATE"), Weight: aws.Int(-1)}},
+				},
+			},
+			wantedErr: errors.New(`"weight" of capacity provider FARGATE must not be negative`),
+		},
+		"error if more than one provider has a base": {
+			in: PlatformConfig{
+				PlatformArgs: PlatformArgs{
+					Capacity: []CapacityProviderStrategy{
+						{Provider: aws.String("FARGATE"), Base: aws.Int(1)},
+						{Provider: aws.String("FARGATE_SPOT"), Base: aws.Int(1)},
+					},
+				},
+			},
+			wantedErr: errMultipleBases,
+		},
+		"error if no provider has a weight": {
+			in: PlatformConfig{
+				PlatformArgs: PlatformArgs{
+					Capacity: []CapacityProviderStrategy{
+						{Provider: aws.String("FARGATE"), Weight: aws.Int(0), Base: aws.Int(2)},
+					},
+				},
+			},
+			wantedErr: errNoCapacityWithWeight,
+		},
+		"defaults the weight to 1": {
+			in: PlatformConfig{
+				PlatformArgs: PlatformArgs{
+					Capacity: []CapacityProviderStrategy{
+						{Provider: aws.String("FARGATE"), Base: aws.Int(1)},
+						{Provider: aws.String("FARGATE_SPOT"), Weight: aws.Int(3)},
+					},
+				},
+			},
+			wanted: []*template.CapacityProviderStrategy{
+				{
+					Provider: "FARGATE",
+					Weight:   1,
+					Base:     aws.Int(1),
+				},
+				{
+					Provider: "FARGATE_SPOT",
+					Weight:   3,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.CapacityProviderOpts()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"gopkg.in/yaml.v3"
+)
+
+// UnmarshalOption resolves the manifest of a service before UnmarshalService deserializes it.
+type UnmarshalOption func(*unmarshalOpts)
+
+type unmarshalOpts struct {
+	defaults     []byte
+	interpolator *Interpolator
+}
+
+// WithDefaults merges the defaults shared by the services of the workspace under the manifest.
+// The values of the manifest take precedence over the defaults.
+func WithDefaults(defaults []byte) UnmarshalOption {
+	return func(opts *unmarshalOpts) {
+		opts.defaults = defaults
+	}
+}
+
+// WithInterpolator substitutes the variables referenced by the manifest and by the defaults before they're merged.
+func WithInterpolator(interpolator *Interpolator) UnmarshalOption {
+	return func(opts *unmarshalOpts) {
+		opts.interpolator = interpolator
+	}
+}
+
+// resolveService returns the manifest of a service with its variables interpolated and the defaults of the workspace merged.
+// The resolved manifest is re-encoded, so the unknown fields and the undefined variables are checked in the manifest
+// and in the defaults beforehand to report the line where they're written.
+func resolveService(in []byte, opts unmarshalOpts) ([]byte, error) {
+	if err := checkServiceFields(in, in); err != nil {
+		return nil, err
+	}
+	if err := checkServiceFields(in, opts.defaults); err != nil {
+		return nil, fmt.Errorf("defaults: %w", err)
+	}
+	defaults := opts.defaults
+	if opts.interpolator != nil {
+		var err error
+		in, err = opts.interpolator.Interpolate(in)
+		if err != nil {
+			return nil, err
+		}
+		defaults, err = opts.interpolator.Interpolate(defaults)
+		if err != nil {
+			return nil, fmt.Errorf("defaults: %w", err)
+		}
+	}
+	out, err := MergeDefaults(defaults, in)
+	if err != nil {
+		return nil, fmt.Errorf("merge defaults: %w", err)
+	}
+	return out, nil
+}
+
+// checkServiceFields returns an ErrUnknownField if the document has a key that isn't a field of the type of the manifest.
+// Documents that can't be parsed and manifests of an unknown type are left for UnmarshalService to report.
+func checkServiceFields(mft, doc []byte) error {
+	var svc Service
+	if err := yaml.Unmarshal(mft, &svc); err != nil {
+		return nil
+	}
+	typ := serviceManifestType(aws.StringValue(svc.Type))
+	if typ == nil {
+		return nil
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(doc, &node); err != nil {
+		return nil
+	}
+	return knownFields(&node, typ, "")
+}
+
+func serviceManifestType(typ string) reflect.Type {
+	switch typ {
+	case LoadBalancedWebServiceType:
+		return reflect.TypeOf(LoadBalancedWebService{})
+	case BackendServiceType:
+		return reflect.TypeOf(BackendService{})
+	case ScheduledJobType:
+		return reflect.TypeOf(ScheduledJob{})
+	case WorkerServiceType:
+		return reflect.TypeOf(WorkerService{})
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveService(t *testing.T) {
+	const defaults = `# Defaults shared by the services.
+variables:
+  LOG_LEVEL: info
+`
+	testCases := map[string]struct {
+		inDefaults       string
+		inManifest       string
+		inNoInterpolator bool
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"interpolates the manifest and merges the defaults": {
+			inDefaults: defaults,
+			inManifest: `name: api
+type: Backend Service
+image:
+  location: ${COPILOT_APPLICATION_NAME}/api
+`,
+			wantedContent: `name: api
+type: Backend Service
+image:
+  location: phonetool/api
+variables:
+  LOG_LEVEL: info
+`,
+		},
+		"merges the defaults without an interpolator": {
+			inDefaults: defaults,
+			inManifest: `name: api
+type: Backend Service
+`,
+			wantedContent: `name: api
+type: Backend Service
+variables:
+  LOG_LEVEL: info
+`,
+			inNoInterpolator: true,
+		},
+		"reports the line of an unknown field in the manifest": {
+			inDefaults: defaults,
+			inManifest: `# The API service.
+
+name: api
+type: Backend Service
+image:
+  location: ${COPILOT_APPLICATION_NAME}/api
+  prot: 80
+`,
+			wantedErr: errors.New(`line 7, column 3: unknown field "image.prot"`),
+		},
+		"reports the line of an unknown field in the defaults": {
+			inDefaults: `# Defaults shared by the services.
+variable:
+  LOG_LEVEL: info
+`,
+			inManifest: `name: api
+type: Backend Service
+`,
+			wantedErr: errors.New(`defaults: line 2, column 1: unknown field "variable"`),
+		},
+		"reports the line of an undefined variable in the manifest": {
+			inDefaults: defaults,
+			inManifest: `# The API service.
+
+name: api
+type: Backend Service
+image:
+  location: ${COPILOT_RESOLVE_UNDEFINED_VARIABLE}/api
+`,
+			wantedErr: errors.New("line 6: variable COPILOT_RESOLVE_UNDEFINED_VARIABLE is not defined"),
+		},
+		"reports the line of an undefined variable in the defaults": {
+			inDefaults: `# Defaults shared by the services.
+variables:
+  LOG_LEVEL: ${COPILOT_RESOLVE_UNDEFINED_VARIABLE}
+`,
+			inManifest: `name: api
+type: Backend Service
+`,
+			wantedErr: errors.New("defaults: line 3: variable COPILOT_RESOLVE_UNDEFINED_VARIABLE is not defined"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			interpolator := NewInterpolator("phonetool", "test")
+			interpolator.lookupEnv = func(key string) (string, bool) { return "", false }
+			if tc.inNoInterpolator {
+				interpolator = nil
+			}
+
+			got, err := resolveService([]byte(tc.inManifest), unmarshalOpts{
+				defaults:     []byte(tc.inDefaults),
+				interpolator: interpolator,
+			})
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, string(got))
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/imdario/mergo"
+)
+
+const (
+	scheduledJobManifestPath = "services/scheduled-job/manifest.yml"
+)
+
+// ScheduledJobProps contains properties for creating a new scheduled job manifest.
+type ScheduledJobProps struct {
+	*ServiceProps
+	Schedule string
+	Timeout  string
+	Retries  int
+}
+
+// ScheduledJob holds the configuration to create a task that runs on a schedule with AWS Fargate as the compute engine.
+type ScheduledJob struct {
+	Service            `yaml:",inline"`
+	ScheduledJobConfig `yaml:",inline"`
+	// Use *ScheduledJobConfig because of https://github.com/imdario/mergo/issues/146
+	Environments map[string]*ScheduledJobConfig `yaml:",flow"` // Fields to override per environment.
+
+	parser template.Parser
+}
+
+// ScheduledJobConfig holds the configuration for a scheduled job that can be overriden per environment.
+type ScheduledJobConfig struct {
+	Image      ServiceImage `yaml:",flow"`
+	TaskConfig `yaml:",inline"`
+	*LogConfig `yaml:"logging,flow"`
+	Sidecar    `yaml:",inline"`
+
+	// Schedule is a cron expression, a rate expression or a predefined schedule such as "@daily".
+	Schedule *string `yaml:"schedule"`
+	// Timeout is the maximum duration the job is allowed to run, e.g. "1h30m".
+	Timeout *string `yaml:"timeout"`
+	// Retries is the number of times the job is re-run if it fails.
+	Retries *int `yaml:"retries"`
+	// CFNOverrides are applied to the generated CloudFormation template for fields that the manifest doesn't support.
+	CFNOverrides []CFNOverride `yaml:"cfn_overrides"`
+}
+
+// LogConfigOpts converts the job's Firelens configuration into a format parsable by the templates pkg.
+func (jc *ScheduledJobConfig) LogConfigOpts() *template.LogConfigOpts {
+	if jc.LogConfig == nil {
+		return nil
+	}
+	return jc.logConfigOpts()
+}
+
+// NewScheduledJob creates a new scheduled job that runs a single task with minimal CPU and memory thresholds on the given schedule.
+func NewScheduledJob(props *ScheduledJobProps) *ScheduledJob {
+	job := newDefaultScheduledJob()
+	// Apply overrides.
+	job.Name = aws.String(props.Name)
+	job.Image.Build.BuildArgs.Dockerfile = aws.String(props.Dockerfile)
+	job.Schedule = aws.String(props.Schedule)
+	if props.Timeout != "" {
+		job.Timeout = aws.String(props.Timeout)
+	}
+	if props.Retries != 0 {
+		job.Retries = aws.Int(props.Retries)
+	}
+	job.parser = template.New()
+	return job
+}
+
+// newDefaultScheduledJob returns an empty ScheduledJob with only the default values set.
+func newDefaultScheduledJob() *ScheduledJob {
+	return &ScheduledJob{
+		Service: Service{
+			Type: aws.String(ScheduledJobType),
+		},
+		ScheduledJobConfig: ScheduledJobConfig{
+			Image: ServiceImage{},
+			TaskConfig: TaskConfig{
+				CPU:    aws.Int(256),
+				Memory: aws.Int(512),
+			},
+		},
+	}
+}
+
+// MarshalBinary serializes the manifest object into a binary YAML document.
+// Implements the encoding.BinaryMarshaler interface.
+func (j *ScheduledJob) MarshalBinary() ([]byte, error) {
+	content, err := j.parser.Parse(scheduledJobManifestPath, *j, template.WithFuncs(map[string]interface{}{
+		"dirName": tplDirName,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// BuildArgs returns a docker.BuildArguments object for the job given a workspace root directory.
+func (j *ScheduledJob) BuildArgs(wsRoot string) *DockerBuildArgs {
+	return j.Image.BuildConfig(wsRoot)
+}
+
+// MainContainer returns the configuration of the main container that the sidecars of the job can depend on.
+func (j *ScheduledJob) MainContainer() MainContainer {
+	return MainContainer{
+		Name:      aws.StringValue(j.Name),
+		DependsOn: j.Image.DependsOn,
+	}
+}
+
+// ApplyEnv returns the job manifest with environment overrides.
+// If the environment passed in does not have any overrides then it returns itself.
+func (j ScheduledJob) ApplyEnv(envName string) (*ScheduledJob, error) {
+	overrideConfig, ok := j.Environments[envName]
+	if !ok {
+		return &j, nil
+	}
+	// Apply overrides to the original job j.
+	err := mergo.Merge(&j, ScheduledJob{
+		ScheduledJobConfig: *overrideConfig,
+	}, mergo.WithOverride, mergo.WithOverwriteWithEmptyValue, mergo.WithTransformers(sliceOverrideTransformer{}))
+	if err != nil {
+		return nil, err
+	}
+	j.Image.applyOverride(overrideConfig.Image)
+	j.Platform.applyOverride(overrideConfig.Platform)
+	j.Environments = nil
+	return &j, nil
+}
+
+// EnvWorkload returns the configuration of the job in the environment after applying the environment's overrides.
+func (j ScheduledJob) EnvWorkload(envName string) (*WorkloadConfig, error) {
+	job, err := j.ApplyEnv(envName)
+	if err != nil {
+		return nil, err
+	}
+	return &WorkloadConfig{
+		Image:    job.Image,
+		Task:     job.TaskConfig,
+		Sidecars: job.Sidecars,
+	}, nil
+}
@@ -0,0 +1,289 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewScheduledJob(t *testing.T) {
+	testCases := map[string]struct {
+		inProps ScheduledJobProps
+
+		wantedManifest *ScheduledJob
+	}{
+		"without timeout or retries": {
+			inProps: ScheduledJobProps{
+				ServiceProps: &ServiceProps{
+					Name:       "mailer",
+					Dockerfile: "./mailer/Dockerfile",
+				},
+				Schedule: "@daily",
+			},
+			wantedManifest: &ScheduledJob{
+				Service: Service{
+					Name: aws.String("mailer"),
+					Type: aws.String(ScheduledJobType),
+				},
+				ScheduledJobConfig: ScheduledJobConfig{
+					Image: ServiceImage{
+						Build: BuildArgsOrString{
+							BuildArgs: DockerBuildArgs{
+								Dockerfile: aws.String("./mailer/Dockerfile"),
+							},
+						},
+					},
+					TaskConfig: TaskConfig{
+						CPU:    aws.Int(256),
+						Memory: aws.Int(512),
+					},
+					Schedule: aws.String("@daily"),
+				},
+			},
+		},
+		"with timeout and retries": {
+			inProps: ScheduledJobProps{
+				ServiceProps: &ServiceProps{
+					Name:       "mailer",
+					Dockerfile: "./mailer/Dockerfile",
+				},
+				Schedule: "@every 5m",
+				Timeout:  "1h",
+				Retries:  2,
+			},
+			wantedManifest: &ScheduledJob{
+				Service: Service{
+					Name: aws.String("mailer"),
+					Type: aws.String(ScheduledJobType),
+				},
+				ScheduledJobConfig: ScheduledJobConfig{
+					Image: ServiceImage{
+						Build: BuildArgsOrString{
+							BuildArgs: DockerBuildArgs{
+								Dockerfile: aws.String("./mailer/Dockerfile"),
+							},
+						},
+					},
+					TaskConfig: TaskConfig{
+						CPU:    aws.Int(256),
+						Memory: aws.Int(512),
+					},
+					Schedule: aws.String("@every 5m"),
+					Timeout:  aws.String("1h"),
+					Retries:  aws.Int(2),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			job := NewScheduledJob(&tc.inProps)
+
+			// THEN
+			require.Equal(t, tc.wantedManifest.Service, job.Service)
+			require.Equal(t, tc.wantedManifest.ScheduledJobConfig, job.ScheduledJobConfig)
+		})
+	}
+}
+
+func TestScheduledJob_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(ctrl *gomock.Controller, manifest *ScheduledJob)
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error parsing template": {
+			mockDependencies: func(ctrl *gomock.Controller, manifest *ScheduledJob) {
+				m := mocks.NewMockParser(ctrl)
+				manifest.parser = m
+				m.EXPECT().Parse(scheduledJobManifestPath, *manifest, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns rendered content": {
+			mockDependencies: func(ctrl *gomock.Controller, manifest *ScheduledJob) {
+				m := mocks.NewMockParser(ctrl)
+				manifest.parser = m
+				m.EXPECT().Parse(scheduledJobManifestPath, *manifest, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("hello")}, nil)
+			},
+
+			wantedBinary: []byte("hello"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			manifest := &ScheduledJob{}
+			tc.mockDependencies(ctrl, manifest)
+
+			// WHEN
+			b, err := manifest.MarshalBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedBinary, b)
+		})
+	}
+}
+
+func TestScheduledJob_ApplyEnv(t *testing.T) {
+	mft := &ScheduledJob{
+		Service: Service{
+			Name: aws.String("mailer"),
+			Type: aws.String(ScheduledJobType),
+		},
+		ScheduledJobConfig: ScheduledJobConfig{
+			TaskConfig: TaskConfig{
+				CPU:    aws.Int(256),
+				Memory: aws.Int(512),
+			},
+			Schedule: aws.String("@daily"),
+			Retries:  aws.Int(1),
+		},
+		Environments: map[string]*ScheduledJobConfig{
+			"prod": {
+				Schedule: aws.String("@hourly"),
+				TaskConfig: TaskConfig{
+					Memory: aws.Int(1024),
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		envToApply string
+
+		wanted ScheduledJobConfig
+	}{
+		"without overrides": {
+			envToApply: "test",
+			wanted:     mft.ScheduledJobConfig,
+		},
+		"with overrides": {
+			envToApply: "prod",
+			wanted: ScheduledJobConfig{
+				TaskConfig: TaskConfig{
+					CPU:    aws.Int(256),
+					Memory: aws.Int(1024),
+				},
+				Schedule: aws.String("@hourly"),
+				Retries:  aws.Int(1),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := mft.ApplyEnv(tc.envToApply)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got.ScheduledJobConfig)
+		})
+	}
+}
+
+func TestScheduledJob_EnvWorkload(t *testing.T) {
+	mft := &ScheduledJob{
+		Service: Service{
+			Name: aws.String("mailer"),
+			Type: aws.String(ScheduledJobType),
+		},
+		ScheduledJobConfig: ScheduledJobConfig{
+			Image: ServiceImage{
+				Location: aws.String("mailer:latest"),
+			},
+			TaskConfig: TaskConfig{
+				CPU:    aws.Int(256),
+				Memory: aws.Int(512),
+			},
+			Sidecar: Sidecar{
+				Sidecars: map[string]*SidecarConfig{
+					"xray": {
+						Image: aws.String("amazon/aws-xray-daemon"),
+					},
+				},
+			},
+			Schedule: aws.String("@daily"),
+		},
+		Environments: map[string]*ScheduledJobConfig{
+			"prod": {
+				Image: ServiceImage{
+					Location: aws.String("mailer:stable"),
+				},
+				TaskConfig: TaskConfig{
+					Memory: aws.Int(1024),
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		envToApply string
+
+		wanted *WorkloadConfig
+	}{
+		"without overrides": {
+			envToApply: "test",
+			wanted: &WorkloadConfig{
+				Image: ServiceImage{
+					Location: aws.String("mailer:latest"),
+				},
+				Task: TaskConfig{
+					CPU:    aws.Int(256),
+					Memory: aws.Int(512),
+				},
+				Sidecars: map[string]*SidecarConfig{
+					"xray": {
+						Image: aws.String("amazon/aws-xray-daemon"),
+					},
+				},
+			},
+		},
+		"with overrides": {
+			envToApply: "prod",
+			wanted: &WorkloadConfig{
+				Image: ServiceImage{
+					Location: aws.String("mailer:stable"),
+				},
+				Task: TaskConfig{
+					CPU:    aws.Int(256),
+					Memory: aws.Int(1024),
+				},
+				Sidecars: map[string]*SidecarConfig{
+					"xray": {
+						Image: aws.String("amazon/aws-xray-daemon"),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := mft.EnvWorkload(tc.envToApply)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	secretsManagerService = "secretsmanager"
+	// Number of colon separated fields of a Secrets Manager secret ARN, "arn:partition:secretsmanager:region:account:secret:name",
+	// before the optional "json-key:version-stage:version-id" suffix.
+	secretsManagerARNFields = 7
+)
+
+var (
+	errUnmarshalSecret = errors.New(`can't unmarshal secret field into string or a "secretsmanager" map`)
+	errEmptySecret     = errors.New(`secret must be the name or ARN of an SSM parameter or a Secrets Manager secret`)
+)
+
+// Secret represents where the value of a secret environment variable is retrieved from.
+// It supports unmarshaling yaml which can either be of type string or type SecretsManagerSecret.
+// A string is the name or ARN of an SSM parameter, or the ARN of a Secrets Manager secret.
+type Secret struct {
+	From               *string
+	FromSecretsManager SecretsManagerSecret
+}
+
+// SecretsManagerSecret represents the name or ARN of a secret in AWS Secrets Manager,
+// optionally followed by ":json-key:version-stage:version-id" to retrieve a specific key or version of the secret.
+type SecretsManagerSecret struct {
+	Name *string `yaml:"secretsmanager"`
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the Secret
+// struct, allowing it to perform more complex unmarshaling behavior.
+// This method implements the yaml.Unmarshaler (v2) interface.
+func (s *Secret) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if err := unmarshal(&s.FromSecretsManager); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+
+	if s.FromSecretsManager.Name != nil {
+		// Unmarshaled successfully to s.FromSecretsManager, return.
+		return nil
+	}
+
+	if err := unmarshal(&s.From); err != nil {
+		return errUnmarshalSecret
+	}
+	return nil
+}
+
+// IsSecretsManager returns true if the secret is stored in AWS Secrets Manager instead of SSM Parameter Store.
+func (s Secret) IsSecretsManager() bool {
+	return s.FromSecretsManager.Name != nil || isSecretsManagerARN(aws.StringValue(s.From))
+}
+
+// Value returns the name or ARN of the secret, including the optional suffix of a Secrets Manager secret.
+func (s Secret) Value() string {
+	if s.FromSecretsManager.Name != nil {
+		return aws.StringValue(s.FromSecretsManager.Name)
+	}
+	return aws.StringValue(s.From)
+}
+
+// SecretsManagerID splits the value of a Secrets Manager secret into the name or ARN of the secret,
+// and the JSON key, version stage and version ID to retrieve. Each of them can be empty.
+func (s Secret) SecretsManagerID() (id, jsonKey, versionStage, versionID string) {
+	value := s.Value()
+	idFields := 1
+	if isSecretsManagerARN(value) {
+		idFields = secretsManagerARNFields // The ARN of a secret always has the "secret:name" resource.
+	}
+	fields := strings.SplitN(value, ":", idFields+3)
+	id = strings.Join(fields[:idFields], ":")
+	suffix := make([]string, 3)
+	if len(fields) > idFields {
+		copy(suffix, fields[idFields:])
+	}
+	return id, suffix[0], suffix[1], suffix[2]
+}
+
+// SecretOpts converts the secrets of the main container into a format parsable by the templates pkg.
+func (tc TaskConfig) SecretOpts() (map[string]template.Secret, error) {
+	return secretOpts(tc.Secrets)
+}
+
+// secretOpts converts the secrets of a container into a format parsable by the templates pkg.
+func secretOpts(secrets map[string]Secret) (map[string]template.Secret, error) {
+	if len(secrets) == 0 {
+		return nil, nil
+	}
+	opts := make(map[string]template.Secret, len(secrets))
+	for name, secret := range secrets {
+		value := secret.Value()
+		if value == "" {
+			return nil, fmt.Errorf("secret %s: %w", name, errEmptySecret)
+		}
+		if !secret.IsSecretsManager() {
+			opts[name] = template.Secret{ValueFrom: value}
+			continue
+		}
+		id, _, _, _ := secret.SecretsManagerID()
+		sm := &template.SecretsManagerOpts{Name: id}
+		if isSecretsManagerARN(value) {
+			sm = &template.SecretsManagerOpts{ARN: id}
+		} else if strings.HasPrefix(value, "arn:") {
+			return nil, fmt.Errorf("secret %s: %s is not the ARN of a Secrets Manager secret", name, value)
+		}
+		opts[name] = template.Secret{
+			ValueFrom:      value,
+			SecretsManager: sm,
+		}
+	}
+	return opts, nil
+}
+
+func isSecretsManagerARN(value string) bool {
+	parsed, err := arn.Parse(value)
+	if err != nil {
+		return false
+	}
+	return parsed.Service == secretsManagerService && strings.HasPrefix(parsed.Resource, "secret:")
+}
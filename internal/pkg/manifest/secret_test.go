@@ -0,0 +1,169 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSecret_UnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wantedStruct map[string]Secret
+		wantedError  error
+	}{
+		"unmarshal SSM parameter and Secrets Manager ARN strings": {
+			inContent: []byte(`secrets:
+  GITHUB_TOKEN: GH_TOKEN_SECRET
+  DB_PASSWORD: 'arn:aws:secretsmanager:us-west-2:123456789012:secret:db-AbCdEf:password::'
+`),
+
+			wantedStruct: map[string]Secret{
+				"GITHUB_TOKEN": {From: aws.String("GH_TOKEN_SECRET")},
+				"DB_PASSWORD":  {From: aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:db-AbCdEf:password::")},
+			},
+		},
+		"unmarshal Secrets Manager name": {
+			inContent: []byte(`secrets:
+  DB_PASSWORD:
+    secretsmanager: 'db:password::'
+`),
+
+			wantedStruct: map[string]Secret{
+				"DB_PASSWORD": {FromSecretsManager: SecretsManagerSecret{Name: aws.String("db:password::")}},
+			},
+		},
+		"error if unmarshalable": {
+			inContent: []byte(`secrets:
+  DB_PASSWORD:
+    - db
+`),
+
+			wantedError: errUnmarshalSecret,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var got struct {
+				Secrets map[string]Secret `yaml:"secrets"`
+			}
+
+			err := yaml.Unmarshal(tc.inContent, &got)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStruct, got.Secrets)
+		})
+	}
+}
+
+func TestSecret_SecretsManagerID(t *testing.T) {
+	testCases := map[string]struct {
+		in Secret
+
+		wantedID           string
+		wantedJSONKey      string
+		wantedVersionStage string
+		wantedVersionID    string
+	}{
+		"name without suffix": {
+			in:       Secret{FromSecretsManager: SecretsManagerSecret{Name: aws.String("db")}},
+			wantedID: "db",
+		},
+		"name with JSON key": {
+			in:            Secret{FromSecretsManager: SecretsManagerSecret{Name: aws.String("db:password::")}},
+			wantedID:      "db",
+			wantedJSONKey: "password",
+		},
+		"ARN without suffix": {
+			in:       Secret{From: aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:db-AbCdEf")},
+			wantedID: "arn:aws:secretsmanager:us-west-2:123456789012:secret:db-AbCdEf",
+		},
+		"ARN with JSON key, version stage and version ID": {
+			in:                 Secret{From: aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:db-AbCdEf:password:AWSPREVIOUS:1234")},
+			wantedID:           "arn:aws:secretsmanager:us-west-2:123456789012:secret:db-AbCdEf",
+			wantedJSONKey:      "password",
+			wantedVersionStage: "AWSPREVIOUS",
+			wantedVersionID:    "1234",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			id, jsonKey, versionStage, versionID := tc.in.SecretsManagerID()
+
+			require.Equal(t, tc.wantedID, id)
+			require.Equal(t, tc.wantedJSONKey, jsonKey)
+			require.Equal(t, tc.wantedVersionStage, versionStage)
+			require.Equal(t, tc.wantedVersionID, versionID)
+		})
+	}
+}
+
+func TestTaskConfig_SecretOpts(t *testing.T) {
+	testCases := map[string]struct {
+		in map[string]Secret
+
+		wanted    map[string]template.Secret
+		wantedErr error
+	}{
+		"returns nil if there are no secrets": {},
+		"error if a secret is empty": {
+			in: map[string]Secret{
+				"DB_PASSWORD": {},
+			},
+			wantedErr: errors.New("secret DB_PASSWORD: secret must be the name or ARN of an SSM parameter or a Secrets Manager secret"),
+		},
+		"error if a Secrets Manager secret is the ARN of another service": {
+			in: map[string]Secret{
+				"DB_PASSWORD": {FromSecretsManager: SecretsManagerSecret{Name: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/db")}},
+			},
+			wantedErr: errors.New("secret DB_PASSWORD: arn:aws:ssm:us-west-2:123456789012:parameter/db is not the ARN of a Secrets Manager secret"),
+		},
+		"converts secrets from each source": {
+			in: map[string]Secret{
+				"GITHUB_TOKEN": {From: aws.String("GH_TOKEN_SECRET")},
+				"API_KEY":      {From: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/api-key")},
+				"DB_PASSWORD":  {FromSecretsManager: SecretsManagerSecret{Name: aws.String("db:password::")}},
+				"DB_USER":      {From: aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:db-AbCdEf:username::")},
+			},
+			wanted: map[string]template.Secret{
+				"GITHUB_TOKEN": {ValueFrom: "GH_TOKEN_SECRET"},
+				"API_KEY":      {ValueFrom: "arn:aws:ssm:us-west-2:123456789012:parameter/api-key"},
+				"DB_PASSWORD": {
+					ValueFrom:      "db:password::",
+					SecretsManager: &template.SecretsManagerOpts{Name: "db"},
+				},
+				"DB_USER": {
+					ValueFrom:      "arn:aws:secretsmanager:us-west-2:123456789012:secret:db-AbCdEf:username::",
+					SecretsManager: &template.SecretsManagerOpts{ARN: "arn:aws:secretsmanager:us-west-2:123456789012:secret:db-AbCdEf"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := TaskConfig{Secrets: tc.in}.SecretOpts()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
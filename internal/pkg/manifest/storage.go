@@ -0,0 +1,140 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+)
+
+const (
+	efsIAMEnabled  = "ENABLED"
+	efsIAMDisabled = "DISABLED"
+	efsRootDir     = "/"
+)
+
+var (
+	errNoContainerPath        = errors.New(`"path" must be specified`)
+	errNoFilesystemID         = errors.New(`"id" must be specified in "efs"`)
+	errAccessPointWithRootDir = errors.New(`"root_dir" must be empty or "/" when "access_point_id" is specified`)
+)
+
+// Storage represents the volumes that the task's containers can mount.
+type Storage struct {
+	Volumes map[string]Volume `yaml:"volumes"`
+}
+
+// Volume represents a task volume mounted into the service container.
+// Volumes without an "efs" configuration are scratch space that's only available for the lifetime of the task.
+type Volume struct {
+	EFS           *EFSVolumeConfiguration `yaml:"efs"`
+	ContainerPath *string                 `yaml:"path"`
+	ReadOnly      *bool                   `yaml:"read_only"`
+}
+
+// EFSVolumeConfiguration holds the configuration of an existing EFS file system to mount.
+// "svc deploy" allows NFS traffic from the environment's security group to the file system's mount targets if they don't yet.
+type EFSVolumeConfiguration struct {
+	FileSystemID  *string                 `yaml:"id"`
+	RootDirectory *string                 `yaml:"root_dir"`
+	AuthConfig    *EFSAuthorizationConfig `yaml:"auth"`
+}
+
+// EFSAuthorizationConfig holds the options to access the file system with IAM or an access point.
+type EFSAuthorizationConfig struct {
+	IAM           *bool   `yaml:"iam"` // Mount the file system with the task role.
+	AccessPointID *string `yaml:"access_point_id"`
+}
+
+// StorageOpts converts the task's storage configuration into a format parsable by the templates pkg.
+func (s *Storage) StorageOpts() (*template.StorageOpts, error) {
+	if s == nil || len(s.Volumes) == 0 {
+		return nil, nil
+	}
+	// Sort the names so that the generated template is stable between deployments.
+	names := make([]string, 0, len(s.Volumes))
+	for name := range s.Volumes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	opts := &template.StorageOpts{}
+	for _, name := range names {
+		vol := s.Volumes[name]
+		if err := vol.validate(); err != nil {
+			return nil, fmt.Errorf("validate volume %s: %w", name, err)
+		}
+		opts.MountPoints = append(opts.MountPoints, &template.MountPointOpts{
+			SourceVolume:  aws.String(name),
+			ContainerPath: vol.ContainerPath,
+			ReadOnly:      vol.ReadOnly,
+		})
+		if vol.EFS == nil {
+			opts.Volumes = append(opts.Volumes, &template.VolumeOpts{
+				Name: aws.String(name),
+			})
+			continue
+		}
+		opts.Volumes = append(opts.Volumes, &template.VolumeOpts{
+			Name: aws.String(name),
+			EFS:  vol.EFS.volumeOpts(),
+		})
+		if vol.EFS.usesIAM() {
+			opts.EFSPerms = append(opts.EFSPerms, &template.EFSPermission{
+				FilesystemID:  vol.EFS.FileSystemID,
+				AccessPointID: vol.EFS.accessPointID(),
+				Write:         !aws.BoolValue(vol.ReadOnly),
+			})
+		}
+	}
+	return opts, nil
+}
+
+func (v Volume) validate() error {
+	if v.ContainerPath == nil {
+		return errNoContainerPath
+	}
+	if v.EFS == nil {
+		return nil
+	}
+	return v.EFS.validate()
+}
+
+func (e *EFSVolumeConfiguration) validate() error {
+	if e.FileSystemID == nil {
+		return errNoFilesystemID
+	}
+	if e.accessPointID() != nil && e.RootDirectory != nil && *e.RootDirectory != efsRootDir {
+		return errAccessPointWithRootDir
+	}
+	return nil
+}
+
+func (e *EFSVolumeConfiguration) volumeOpts() *template.EFSVolumeConfiguration {
+	iam := efsIAMDisabled
+	if e.usesIAM() {
+		iam = efsIAMEnabled
+	}
+	return &template.EFSVolumeConfiguration{
+		Filesystem:    e.FileSystemID,
+		RootDirectory: e.RootDirectory,
+		AccessPointID: e.accessPointID(),
+		IAM:           aws.String(iam),
+	}
+}
+
+func (e *EFSVolumeConfiguration) usesIAM() bool {
+	return e.AuthConfig != nil && aws.BoolValue(e.AuthConfig.IAM)
+}
+
+func (e *EFSVolumeConfiguration) accessPointID() *string {
+	if e.AuthConfig == nil {
+		return nil
+	}
+	return e.AuthConfig.AccessPointID
+}
@@ -0,0 +1,184 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestStorage_UnmarshalYAML(t *testing.T) {
+	in := []byte(`volumes:
+  data:
+    path: /var/data
+    read_only: true
+    efs:
+      id: fs-1234
+      root_dir: /
+      auth:
+        iam: true
+        access_point_id: fsap-1234
+`)
+	var got Storage
+
+	err := yaml.Unmarshal(in, &got)
+
+	require.NoError(t, err)
+	require.Equal(t, Storage{
+		Volumes: map[string]Volume{
+			"data": {
+				ContainerPath: aws.String("/var/data"),
+				ReadOnly:      aws.Bool(true),
+				EFS: &EFSVolumeConfiguration{
+					FileSystemID:  aws.String("fs-1234"),
+					RootDirectory: aws.String("/"),
+					AuthConfig: &EFSAuthorizationConfig{
+						IAM:           aws.Bool(true),
+						AccessPointID: aws.String("fsap-1234"),
+					},
+				},
+			},
+		},
+	}, got)
+}
+
+func TestStorage_StorageOpts(t *testing.T) {
+	testCases := map[string]struct {
+		in *Storage
+
+		wanted    *template.StorageOpts
+		wantedErr error
+	}{
+		"returns nil if there is no storage": {},
+		"errors if the path is missing": {
+			in: &Storage{
+				Volumes: map[string]Volume{
+					"data": {},
+				},
+			},
+
+			wantedErr: fmt.Errorf("validate volume data: %w", errNoContainerPath),
+		},
+		"errors if the file system ID is missing": {
+			in: &Storage{
+				Volumes: map[string]Volume{
+					"data": {
+						ContainerPath: aws.String("/var/data"),
+						EFS:           &EFSVolumeConfiguration{},
+					},
+				},
+			},
+
+			wantedErr: fmt.Errorf("validate volume data: %w", errNoFilesystemID),
+		},
+		"errors if an access point is used with a root directory": {
+			in: &Storage{
+				Volumes: map[string]Volume{
+					"data": {
+						ContainerPath: aws.String("/var/data"),
+						EFS: &EFSVolumeConfiguration{
+							FileSystemID:  aws.String("fs-1234"),
+							RootDirectory: aws.String("/files"),
+							AuthConfig: &EFSAuthorizationConfig{
+								AccessPointID: aws.String("fsap-1234"),
+							},
+						},
+					},
+				},
+			},
+
+			wantedErr: fmt.Errorf("validate volume data: %w", errAccessPointWithRootDir),
+		},
+		"converts EFS and scratch volumes": {
+			in: &Storage{
+				Volumes: map[string]Volume{
+					"scratch": {
+						ContainerPath: aws.String("/tmp/scratch"),
+					},
+					"data": {
+						ContainerPath: aws.String("/var/data"),
+						EFS: &EFSVolumeConfiguration{
+							FileSystemID: aws.String("fs-1234"),
+							AuthConfig: &EFSAuthorizationConfig{
+								IAM:           aws.Bool(true),
+								AccessPointID: aws.String("fsap-1234"),
+							},
+						},
+					},
+					"config": {
+						ContainerPath: aws.String("/etc/config"),
+						ReadOnly:      aws.Bool(true),
+						EFS: &EFSVolumeConfiguration{
+							FileSystemID:  aws.String("fs-5678"),
+							RootDirectory: aws.String("/config"),
+						},
+					},
+				},
+			},
+
+			wanted: &template.StorageOpts{
+				Volumes: []*template.VolumeOpts{
+					{
+						Name: aws.String("config"),
+						EFS: &template.EFSVolumeConfiguration{
+							Filesystem:    aws.String("fs-5678"),
+							RootDirectory: aws.String("/config"),
+							IAM:           aws.String("DISABLED"),
+						},
+					},
+					{
+						Name: aws.String("data"),
+						EFS: &template.EFSVolumeConfiguration{
+							Filesystem:    aws.String("fs-1234"),
+							AccessPointID: aws.String("fsap-1234"),
+							IAM:           aws.String("ENABLED"),
+						},
+					},
+					{
+						Name: aws.String("scratch"),
+					},
+				},
+				MountPoints: []*template.MountPointOpts{
+					{
+						SourceVolume:  aws.String("config"),
+						ContainerPath: aws.String("/etc/config"),
+						ReadOnly:      aws.Bool(true),
+					},
+					{
+						SourceVolume:  aws.String("data"),
+						ContainerPath: aws.String("/var/data"),
+					},
+					{
+						SourceVolume:  aws.String("scratch"),
+						ContainerPath: aws.String("/tmp/scratch"),
+					},
+				},
+				EFSPerms: []*template.EFSPermission{
+					{
+						FilesystemID:  aws.String("fs-1234"),
+						AccessPointID: aws.String("fsap-1234"),
+						Write:         true,
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.StorageOpts()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
@@ -8,11 +8,14 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"gopkg.in/yaml.v3"
 )
@@ -22,13 +25,30 @@ const (
 	LoadBalancedWebServiceType = "Load Balanced Web Service"
 	// BackendServiceType is a service that cannot be accessed from the internet but can be reached from other services.
 	BackendServiceType = "Backend Service"
+	// ScheduledJobType is a task that runs to completion on a schedule with Fargate as compute.
+	ScheduledJobType = "Scheduled Job"
+	// WorkerServiceType is a service that processes messages from an SQS queue with Fargate as compute.
+	WorkerServiceType = "Worker Service"
 
 	defaultSidecarPort    = "80"
 	defaultFluentbitImage = "amazon/aws-for-fluent-bit:latest"
 )
 
+// Conditions that a sidecar container waits for on the containers it depends on.
+const (
+	dependsOnStart    = "START"
+	dependsOnComplete = "COMPLETE"
+	dependsOnSuccess  = "SUCCESS"
+	dependsOnHealthy  = "HEALTHY"
+)
+
+var validContainerDependencyConditions = []string{dependsOnStart, dependsOnComplete, dependsOnSuccess, dependsOnHealthy}
+
 var (
-	errUnmarshalBuildOpts = errors.New("can't unmarshal build field into string or compose-style map")
+	errUnmarshalBuildOpts    = errors.New("can't unmarshal build field into string or compose-style map")
+	errUnmarshalCountOpts    = errors.New(`can't unmarshal count field into an integer or an autoscaling map`)
+	errInvalidRangeFormat    = errors.New(`range must be in the format "min-max"`)
+	errImageBuildAndLocation = errors.New(`must specify one of "build" and "location" in "image", not both`)
 )
 
 var dockerfileDefaultName = "Dockerfile"
@@ -37,6 +57,8 @@ var dockerfileDefaultName = "Dockerfile"
 var ServiceTypes = []string{
 	LoadBalancedWebServiceType,
 	BackendServiceType,
+	ScheduledJobType,
+	WorkerServiceType,
 }
 
 // Service holds the basic data that every service manifest file needs to have.
@@ -47,7 +69,9 @@ type Service struct {
 
 // ServiceImage represents the service's container image.
 type ServiceImage struct {
-	Build BuildArgsOrString `yaml:"build"` // Path to the Dockerfile.
+	Build     BuildArgsOrString `yaml:"build"`      // Path to the Dockerfile.
+	Location  *string           `yaml:"location"`   // Use an existing image instead.
+	DependsOn map[string]string `yaml:"depends_on"` // Conditions keyed by the name of the sidecar to depend on.
 }
 
 // BuildConfig populates a docker.BuildArguments struct from the fields available in the manifest.
@@ -87,6 +111,25 @@ func (s *ServiceImage) BuildConfig(rootDirectory string) *DockerBuildArgs {
 	}
 }
 
+// validate returns an error if the image is both built from a Dockerfile and pulled from an existing location.
+func (s *ServiceImage) validate() error {
+	if s.Location != nil && !s.Build.isEmpty() {
+		return errImageBuildAndLocation
+	}
+	return nil
+}
+
+// applyOverride clears the build configuration if the override deploys an existing image, and vice versa,
+// so that the overridden image keeps only one of them.
+func (s *ServiceImage) applyOverride(override ServiceImage) {
+	if override.Location != nil {
+		s.Build = BuildArgsOrString{}
+	}
+	if !override.Build.isEmpty() {
+		s.Location = nil
+	}
+}
+
 // dockerfile returns the path to the service's Dockerfile. If no dockerfile is specified,
 // returns "".
 func (s *ServiceImage) dockerfile() string {
@@ -122,6 +165,10 @@ type BuildArgsOrString struct {
 	BuildArgs   DockerBuildArgs
 }
 
+func (b *BuildArgsOrString) isEmpty() bool {
+	return b.BuildString == nil && b.BuildArgs.isEmpty()
+}
+
 // UnmarshalYAML overrides the default YAML unmarshaling logic for the BuildArgsOrString
 // struct, allowing it to perform more complex unmarshaling behavior.
 // This method implements the yaml.Unmarshaler (v2) interface.
@@ -207,8 +254,23 @@ type Sidecar struct {
 	Sidecars map[string]*SidecarConfig `yaml:"sidecars"`
 }
 
+// WorkloadConfig holds the configuration, shared by every service and job manifest, of a workload in an environment.
+type WorkloadConfig struct {
+	Image    ServiceImage
+	Port     *uint16 // Nil if the workload doesn't expose a port.
+	Task     TaskConfig
+	Sidecars map[string]*SidecarConfig
+}
+
+// MainContainer holds the configuration of the service container that sidecars can depend on.
+type MainContainer struct {
+	Name           string
+	DependsOn      map[string]string // Conditions keyed by the name of the sidecar to depend on.
+	HasHealthCheck bool              // Sidecars can only wait for the container to be HEALTHY if it has a healthcheck.
+}
+
 // SidecarsOpts converts the service's sidecar configuration into a format parsable by the templates pkg.
-func (s *Sidecar) SidecarsOpts() ([]*template.SidecarOpts, error) {
+func (s *Sidecar) SidecarsOpts(main MainContainer) ([]*template.SidecarOpts, error) {
 	if s.Sidecars == nil {
 		return nil, nil
 	}
@@ -218,31 +280,307 @@ func (s *Sidecar) SidecarsOpts() ([]*template.SidecarOpts, error) {
 		if err != nil {
 			return nil, err
 		}
+		dependsOn, err := s.dependsOnOpts(name, config.DependsOn, main)
+		if err != nil {
+			return nil, fmt.Errorf("sidecar %s: %w", name, err)
+		}
+		secrets, err := secretOpts(config.Secrets)
+		if err != nil {
+			return nil, fmt.Errorf("sidecar %s: %w", name, err)
+		}
+		var healthCheck *ecs.HealthCheck
+		if config.HealthCheck != nil {
+			hc := *config.HealthCheck
+			hc.applyIfNotSet(newDefaultContainerHealthCheck())
+			healthCheck = hc.healthCheckOpts()
+		}
+		var mountPoints []*template.MountPointOpts
+		for _, mp := range config.MountPoints {
+			mountPoints = append(mountPoints, &template.MountPointOpts{
+				SourceVolume:  mp.SourceVolume,
+				ContainerPath: mp.ContainerPath,
+				ReadOnly:      mp.ReadOnly,
+			})
+		}
 		sidecars = append(sidecars, &template.SidecarOpts{
-			Name:       aws.String(name),
-			Image:      config.Image,
-			Port:       port,
-			Protocol:   protocol,
-			CredsParam: config.CredsParam,
+			Name:        aws.String(name),
+			Image:       config.Image,
+			Port:        port,
+			Protocol:    protocol,
+			CredsParam:  config.CredsParam,
+			Essential:   config.Essential,
+			Variables:   config.Variables,
+			Secrets:     secrets,
+			MountPoints: mountPoints,
+			DependsOn:   dependsOn,
+			HealthCheck: healthCheck,
 		})
 	}
+	if err := s.validateNoDependencyCycle(main); err != nil {
+		return nil, err
+	}
 	return sidecars, nil
 }
 
+// MainContainerDependsOnOpts validates the sidecars that the main container depends on and returns the conditions
+// keyed by container name in the format expected by ECS.
+func (s *Sidecar) MainContainerDependsOnOpts(main MainContainer) (map[string]string, error) {
+	dependsOn, err := s.dependsOnOpts(main.Name, main.DependsOn, main)
+	if err != nil {
+		return nil, fmt.Errorf(`validate "image.depends_on": %w`, err)
+	}
+	return dependsOn, nil
+}
+
+// dependsOnOpts validates the containers that a container of the task depends on and returns the conditions
+// keyed by container name in the format expected by ECS.
+func (s *Sidecar) dependsOnOpts(name string, conditions map[string]string, main MainContainer) (map[string]string, error) {
+	if conditions == nil {
+		return nil, nil
+	}
+	dependsOn := make(map[string]string)
+	for container, condition := range conditions {
+		if container == name {
+			return nil, fmt.Errorf("container %s cannot depend on itself", name)
+		}
+		cond := strings.ToUpper(condition)
+		if !isValidContainerDependencyCondition(cond) {
+			return nil, fmt.Errorf("container dependency condition %s for container %s must be one of %s",
+				condition, container, strings.Join(validContainerDependencyConditions, ", "))
+		}
+		if container == main.Name {
+			// The service container is always essential.
+			if cond == dependsOnComplete || cond == dependsOnSuccess {
+				return nil, fmt.Errorf("cannot depend on essential container %s with condition %s", container, cond)
+			}
+			if cond == dependsOnHealthy && !main.HasHealthCheck {
+				return nil, fmt.Errorf("cannot depend on container %s with condition %s without %s", container, cond, "`image.healthcheck`")
+			}
+			dependsOn[container] = cond
+			continue
+		}
+		target, ok := s.Sidecars[container]
+		if !ok {
+			return nil, fmt.Errorf("container %s in depends_on does not exist", container)
+		}
+		if (cond == dependsOnComplete || cond == dependsOnSuccess) && target.isEssential() {
+			return nil, fmt.Errorf("cannot depend on essential container %s with condition %s, set %s to false", container, cond, "`essential`")
+		}
+		if cond == dependsOnHealthy && target.HealthCheck == nil {
+			return nil, fmt.Errorf("cannot depend on container %s with condition %s without a healthcheck", container, cond)
+		}
+		dependsOn[container] = cond
+	}
+	return dependsOn, nil
+}
+
+// validateNoDependencyCycle returns an error if the main container and the sidecars depend on each other in a cycle,
+// since ECS would never start the containers of the cycle.
+func (s *Sidecar) validateNoDependencyCycle(main MainContainer) error {
+	dependencies := map[string]map[string]string{
+		main.Name: main.DependsOn,
+	}
+	for name, config := range s.Sidecars {
+		dependencies[name] = config.DependsOn
+	}
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int)
+	var visit func(container string, path []string) error
+	visit = func(container string, path []string) error {
+		switch state[container] {
+		case visiting:
+			return fmt.Errorf("containers can't depend on each other in a cycle: %s", strings.Join(append(path, container), " -> "))
+		case visited:
+			return nil
+		}
+		state[container] = visiting
+		for _, dependency := range sortedKeys(dependencies[container]) {
+			if err := visit(dependency, append(path, container)); err != nil {
+				return err
+			}
+		}
+		state[container] = visited
+		return nil
+	}
+	for _, container := range sortedKeys(dependencies) {
+		if err := visit(container, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isValidContainerDependencyCondition(cond string) bool {
+	for _, valid := range validContainerDependencyConditions {
+		if cond == valid {
+			return true
+		}
+	}
+	return false
+}
+
 // SidecarConfig represents the configurable options for setting up a sidecar container.
 type SidecarConfig struct {
-	Port       *string `yaml:"port"`
-	Image      *string `yaml:"image"`
-	CredsParam *string `yaml:"credentialsParameter"`
+	Port        *string               `yaml:"port"`
+	Image       *string               `yaml:"image"`
+	CredsParam  *string               `yaml:"credentialsParameter"`
+	Essential   *bool                 `yaml:"essential"`
+	Variables   map[string]string     `yaml:"variables"`
+	Secrets     map[string]Secret     `yaml:"secrets"`
+	MountPoints []SidecarMountPoint   `yaml:"mount_points"`
+	DependsOn   map[string]string     `yaml:"depends_on"` // Conditions keyed by the name of the container to depend on.
+	HealthCheck *ContainerHealthCheck `yaml:"healthcheck"`
+}
+
+// isEssential returns true if the task stops when the sidecar container exits.
+// Sidecars are essential unless specified otherwise.
+func (c *SidecarConfig) isEssential() bool {
+	return c.Essential == nil || *c.Essential
+}
+
+// ExposedPort returns the port that the sidecar container exposes, without its protocol.
+func (c *SidecarConfig) ExposedPort() (string, error) {
+	port, _, err := parsePortMapping(c.Port)
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(port), nil
+}
+
+// SidecarMountPoint represents a volume mounted into a sidecar container.
+type SidecarMountPoint struct {
+	SourceVolume  *string `yaml:"source_volume"`
+	ContainerPath *string `yaml:"path"`
+	ReadOnly      *bool   `yaml:"read_only"`
 }
 
 // TaskConfig represents the resource boundaries and environment variables for the containers in the task.
 type TaskConfig struct {
-	CPU       *int              `yaml:"cpu"`
-	Memory    *int              `yaml:"memory"`
-	Count     *int              `yaml:"count"` // 0 is a valid value, so we want the default value to be nil.
-	Variables map[string]string `yaml:"variables"`
-	Secrets   map[string]string `yaml:"secrets"`
+	CPU         *int              `yaml:"cpu"`
+	Memory      *int              `yaml:"memory"`
+	Count       Count             `yaml:"count"`
+	Variables   map[string]string `yaml:"variables"`
+	EnvFile     *string           `yaml:"env_file"`
+	Secrets     map[string]Secret `yaml:"secrets"`
+	Storage     *Storage          `yaml:"storage"`
+	Platform    PlatformConfig    `yaml:"platform"`
+	Permissions *Permissions      `yaml:"permissions"`
+}
+
+// Count is a custom type which supports unmarshaling yaml which
+// can either be of type int or type Autoscaling.
+type Count struct {
+	Value       *int // 0 is a valid value, so we want the default value to be nil.
+	Autoscaling Autoscaling
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the Count
+// struct, allowing it to perform more complex unmarshaling behavior.
+// This method implements the yaml.Unmarshaler (v2) interface.
+func (c *Count) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if err := unmarshal(&c.Autoscaling); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+
+	if !c.Autoscaling.IsEmpty() {
+		// Unmarshaled successfully to c.Autoscaling, return.
+		return nil
+	}
+
+	if err := unmarshal(&c.Value); err != nil {
+		return errUnmarshalCountOpts
+	}
+	return nil
+}
+
+// Desired returns the number of tasks a deployment starts with.
+// If autoscaling is configured, then it's the minimum of the range, otherwise the fixed count.
+func (c *Count) Desired() (*int, error) {
+	if c.Autoscaling.Range == nil {
+		return c.Value, nil
+	}
+	min, _, err := c.Autoscaling.Range.Parse()
+	if err != nil {
+		return nil, fmt.Errorf("parse task count range %s: %w", string(*c.Autoscaling.Range), err)
+	}
+	return &min, nil
+}
+
+// applyOverride drops the autoscaling configuration if the override replaces it with a fixed number of tasks.
+// The rest of the fields are expected to be already merged.
+func (c *Count) applyOverride(override Count) {
+	if override.Value != nil && override.Autoscaling.IsEmpty() {
+		c.Autoscaling = Autoscaling{}
+	}
+}
+
+// Autoscaling represents the configurable options to scale the number of tasks of a service.
+type Autoscaling struct {
+	Range    *Range `yaml:"range"`               // Minimum and maximum number of tasks, such as "1-10".
+	CPU      *int   `yaml:"cpu_percentage"`      // Target average CPU utilization of the service.
+	Memory   *int   `yaml:"memory_percentage"`   // Target average memory utilization of the service.
+	Requests *int   `yaml:"requests_per_target"` // Target number of requests per task, only for load balanced services.
+}
+
+// IsEmpty returns true if none of the autoscaling fields are set.
+func (a *Autoscaling) IsEmpty() bool {
+	return a.Range == nil && a.CPU == nil && a.Memory == nil && a.Requests == nil
+}
+
+// DeploymentConfig represents the configurable options to roll out new tasks of a service.
+type DeploymentConfig struct {
+	CircuitBreaker    *bool    `yaml:"circuit_breaker"`     // Roll back to the last completed deployment if tasks keep failing to start.
+	MinHealthyPercent *int     `yaml:"min_healthy_percent"` // Lower limit on the number of running tasks as a percentage of the desired count.
+	MaxPercent        *int     `yaml:"max_percent"`         // Upper limit on the number of running tasks as a percentage of the desired count.
+	RollbackAlarms    []string `yaml:"rollback_alarms"`     // Names of CloudWatch alarms that roll back the deployment when they go into alarm.
+}
+
+// Range represents an inclusive range of integers in the format "min-max", such as "1-10".
+type Range string
+
+// Parse extracts the minimum and maximum values from the range.
+func (r Range) Parse() (min int, max int, err error) {
+	minMax := strings.Split(string(r), "-")
+	if len(minMax) != 2 {
+		return 0, 0, errInvalidRangeFormat
+	}
+	min, err = strconv.Atoi(strings.TrimSpace(minMax[0]))
+	if err != nil {
+		return 0, 0, errInvalidRangeFormat
+	}
+	max, err = strconv.Atoi(strings.TrimSpace(minMax[1]))
+	if err != nil {
+		return 0, 0, errInvalidRangeFormat
+	}
+	if min > max {
+		return 0, 0, fmt.Errorf("minimum %d can't be greater than maximum %d", min, max)
+	}
+	return min, max, nil
+}
+
+// sliceOverrideTransformer keeps the original value of a slice field when an environment doesn't override it.
+// Without it, merging with mergo.WithOverwriteWithEmptyValue replaces the original slice with nil.
+type sliceOverrideTransformer struct{}
+
+// Transformer implements the mergo.Transformers interface.
+func (t sliceOverrideTransformer) Transformer(typ reflect.Type) func(dst, src reflect.Value) error {
+	if typ.Kind() != reflect.Slice {
+		return nil
+	}
+	return func(dst, src reflect.Value) error {
+		if !src.IsNil() && dst.CanSet() {
+			dst.Set(src)
+		}
+		return nil
+	}
 }
 
 // ServiceProps contains properties for creating a new service manifest.
@@ -252,9 +590,21 @@ type ServiceProps struct {
 }
 
 // UnmarshalService deserializes the YAML input stream into a service manifest object.
+// The options merge the defaults of the workspace and interpolate variables before deserializing the manifest.
 // If an error occurs during deserialization, then returns the error.
 // If the service type in the manifest is invalid, then returns an ErrInvalidManifestType.
-func UnmarshalService(in []byte) (interface{}, error) {
+func UnmarshalService(in []byte, opts ...UnmarshalOption) (interface{}, error) {
+	if len(opts) != 0 {
+		var resolveOpts unmarshalOpts
+		for _, opt := range opts {
+			opt(&resolveOpts)
+		}
+		resolved, err := resolveService(in, resolveOpts)
+		if err != nil {
+			return nil, err
+		}
+		in = resolved
+	}
 	am := Service{}
 	if err := yaml.Unmarshal(in, &am); err != nil {
 		return nil, fmt.Errorf("unmarshal to service manifest: %w", err)
@@ -264,25 +614,125 @@ func UnmarshalService(in []byte) (interface{}, error) {
 	switch typeVal {
 	case LoadBalancedWebServiceType:
 		m := newDefaultLoadBalancedWebService()
-		if err := yaml.Unmarshal(in, m); err != nil {
+		if err := unmarshalStrict(in, m); err != nil {
 			return nil, fmt.Errorf("unmarshal to load balanced web service: %w", err)
 		}
+		if err := m.validate(); err != nil {
+			return nil, fmt.Errorf("validate load balanced web service: %w", err)
+		}
+		if err := validateEnvOverrides("load balanced web service", sortedKeys(m.Environments), func(env string) (*ServiceImage, validator, error) {
+			cp := newDefaultLoadBalancedWebService()
+			if err := yaml.Unmarshal(in, cp); err != nil {
+				return nil, nil, err
+			}
+			envManifest, err := cp.ApplyEnv(env)
+			return &m.Environments[env].Image.ServiceImage, envManifest, err
+		}); err != nil {
+			return nil, err
+		}
 		return m, nil
 	case BackendServiceType:
 		m := newDefaultBackendService()
-		if err := yaml.Unmarshal(in, m); err != nil {
+		if err := unmarshalStrict(in, m); err != nil {
 			return nil, fmt.Errorf("unmarshal to backend service: %w", err)
 		}
 		if m.BackendServiceConfig.Image.HealthCheck != nil {
 			// Make sure that unset fields in the healthcheck gets a default value.
 			m.BackendServiceConfig.Image.HealthCheck.applyIfNotSet(newDefaultContainerHealthCheck())
 		}
+		if err := m.validate(); err != nil {
+			return nil, fmt.Errorf("validate backend service: %w", err)
+		}
+		if err := validateEnvOverrides("backend service", sortedKeys(m.Environments), func(env string) (*ServiceImage, validator, error) {
+			cp := newDefaultBackendService()
+			if err := yaml.Unmarshal(in, cp); err != nil {
+				return nil, nil, err
+			}
+			envManifest, err := cp.ApplyEnv(env)
+			return &m.Environments[env].Image.ServiceImage, envManifest, err
+		}); err != nil {
+			return nil, err
+		}
+		return m, nil
+	case ScheduledJobType:
+		m := newDefaultScheduledJob()
+		if err := unmarshalStrict(in, m); err != nil {
+			return nil, fmt.Errorf("unmarshal to scheduled job: %w", err)
+		}
+		if err := m.validate(); err != nil {
+			return nil, fmt.Errorf("validate scheduled job: %w", err)
+		}
+		if err := validateEnvOverrides("scheduled job", sortedKeys(m.Environments), func(env string) (*ServiceImage, validator, error) {
+			cp := newDefaultScheduledJob()
+			if err := yaml.Unmarshal(in, cp); err != nil {
+				return nil, nil, err
+			}
+			envManifest, err := cp.ApplyEnv(env)
+			return &m.Environments[env].Image, envManifest, err
+		}); err != nil {
+			return nil, err
+		}
+		return m, nil
+	case WorkerServiceType:
+		m := newDefaultWorkerService()
+		if err := unmarshalStrict(in, m); err != nil {
+			return nil, fmt.Errorf("unmarshal to worker service: %w", err)
+		}
+		if err := m.validate(); err != nil {
+			return nil, fmt.Errorf("validate worker service: %w", err)
+		}
+		if err := validateEnvOverrides("worker service", sortedKeys(m.Environments), func(env string) (*ServiceImage, validator, error) {
+			cp := newDefaultWorkerService()
+			if err := yaml.Unmarshal(in, cp); err != nil {
+				return nil, nil, err
+			}
+			envManifest, err := cp.ApplyEnv(env)
+			return &m.Environments[env].Image, envManifest, err
+		}); err != nil {
+			return nil, err
+		}
 		return m, nil
 	default:
 		return nil, &ErrInvalidSvcManifestType{Type: typeVal}
 	}
 }
 
+type validator interface {
+	validate() error
+}
+
+// validateEnvOverrides validates the environment overrides of a manifest of the given kind.
+// override returns the image of an environment's override, and the manifest with the override applied to a separate copy,
+// since ApplyEnv merges the override into the pointers of the manifest.
+// The image is validated on its own because its location or build replaces the one of the manifest.
+func validateEnvOverrides(kind string, envs []string, override func(env string) (*ServiceImage, validator, error)) error {
+	for _, env := range envs {
+		image, envManifest, err := override(env)
+		if err != nil {
+			return fmt.Errorf("apply environment %s override: %w", env, err)
+		}
+		if err := image.validate(); err != nil {
+			return fmt.Errorf("validate %s override for environment %s: %w", kind, env, err)
+		}
+		if err := envManifest.validate(); err != nil {
+			return fmt.Errorf("validate %s override for environment %s: %w", kind, env, err)
+		}
+	}
+	return nil
+}
+
+// sortedKeys returns the keys of a map keyed by strings in increasing order,
+// so that the environment overrides are validated in the same order every time.
+func sortedKeys(m interface{}) []string {
+	keys := reflect.ValueOf(m).MapKeys()
+	names := make([]string, len(keys))
+	for i, key := range keys {
+		names[i] = key.String()
+	}
+	sort.Strings(names)
+	return names
+}
+
 func durationp(v time.Duration) *time.Duration {
 	return &v
 }
@@ -4,11 +4,15 @@
 package manifest
 
 import (
+	"errors"
+	"fmt"
 	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
 )
@@ -22,7 +26,6 @@ func TestUnmarshalSvc(t *testing.T) {
 	}{
 		"load balanced web service": {
 			inContent: `
-version: 1.0
 name: frontend
 type: "Load Balanced Web Service"
 image:
@@ -66,19 +69,21 @@ environments:
 							BuildString: aws.String("frontend/Dockerfile"),
 						}}, Port: aws.Uint16(80)},
 						RoutingRule: RoutingRule{
-							Path:            aws.String("svc"),
-							HealthCheckPath: aws.String("/"),
+							Path: aws.String("svc"),
+							HealthCheck: HealthCheckArgsOrString{
+								HealthCheckPath: aws.String("/"),
+							},
 							TargetContainer: aws.String("frontend"),
 						},
 						TaskConfig: TaskConfig{
 							CPU:    aws.Int(512),
 							Memory: aws.Int(1024),
-							Count:  aws.Int(1),
+							Count:  Count{Value: aws.Int(1)},
 							Variables: map[string]string{
 								"LOG_LEVEL": "WARN",
 							},
-							Secrets: map[string]string{
-								"DB_PASSWORD": "MYSQL_DB_PASSWORD",
+							Secrets: map[string]Secret{
+								"DB_PASSWORD": {From: aws.String("MYSQL_DB_PASSWORD")},
 							},
 						},
 						Sidecar: Sidecar{
@@ -106,7 +111,7 @@ environments:
 					Environments: map[string]*LoadBalancedWebServiceConfig{
 						"test": {
 							TaskConfig: TaskConfig{
-								Count: aws.Int(3),
+								Count: Count{Value: aws.Int(3)},
 							},
 						},
 					},
@@ -124,7 +129,7 @@ image:
   healthcheck:
     command: ['CMD-SHELL', 'curl http://localhost:5000/ || exit 1']
 cpu: 1024
-memory: 1024
+memory: 2048
 secrets:
   API_TOKEN: SUBS_API_TOKEN`,
 			requireCorrectValues: func(t *testing.T, i interface{}) {
@@ -155,10 +160,10 @@ secrets:
 						},
 						TaskConfig: TaskConfig{
 							CPU:    aws.Int(1024),
-							Memory: aws.Int(1024),
-							Count:  aws.Int(1),
-							Secrets: map[string]string{
-								"API_TOKEN": "SUBS_API_TOKEN",
+							Memory: aws.Int(2048),
+							Count:  Count{Value: aws.Int(1)},
+							Secrets: map[string]Secret{
+								"API_TOKEN": {From: aws.String("SUBS_API_TOKEN")},
 							},
 						},
 					},
@@ -166,6 +171,275 @@ secrets:
 				require.Equal(t, wantedManifest, actualManifest)
 			},
 		},
+		"scheduled job": {
+			inContent: `
+name: mailer
+type: Scheduled Job
+image:
+  build: mailer/Dockerfile
+schedule: '0 9 * * MON-FRI'
+timeout: 1h
+retries: 3
+environments:
+  prod:
+    schedule: '@hourly'
+`,
+			requireCorrectValues: func(t *testing.T, i interface{}) {
+				actualManifest, ok := i.(*ScheduledJob)
+				require.True(t, ok)
+				wantedManifest := &ScheduledJob{
+					Service: Service{Name: aws.String("mailer"), Type: aws.String(ScheduledJobType)},
+					ScheduledJobConfig: ScheduledJobConfig{
+						Image: ServiceImage{
+							Build: BuildArgsOrString{
+								BuildString: aws.String("mailer/Dockerfile"),
+							},
+						},
+						TaskConfig: TaskConfig{
+							CPU:    aws.Int(256),
+							Memory: aws.Int(512),
+						},
+						Schedule: aws.String("0 9 * * MON-FRI"),
+						Timeout:  aws.String("1h"),
+						Retries:  aws.Int(3),
+					},
+					Environments: map[string]*ScheduledJobConfig{
+						"prod": {
+							Schedule: aws.String("@hourly"),
+						},
+					},
+				}
+				require.Equal(t, wantedManifest, actualManifest)
+			},
+		},
+		"worker service": {
+			inContent: `
+name: orders
+type: Worker Service
+image:
+  build: orders/Dockerfile
+queue:
+  retention: 96h
+  timeout: 45s
+  dead_letter:
+    tries: 5
+scaling:
+  range: 1-10
+  messages_per_task: 100
+environments:
+  prod:
+    scaling:
+      range: 2-20
+`,
+			requireCorrectValues: func(t *testing.T, i interface{}) {
+				actualManifest, ok := i.(*WorkerService)
+				require.True(t, ok)
+				wantedRange := Range("1-10")
+				wantedProdRange := Range("2-20")
+				wantedManifest := &WorkerService{
+					Service: Service{Name: aws.String("orders"), Type: aws.String(WorkerServiceType)},
+					WorkerServiceConfig: WorkerServiceConfig{
+						Image: ServiceImage{
+							Build: BuildArgsOrString{
+								BuildString: aws.String("orders/Dockerfile"),
+							},
+						},
+						TaskConfig: TaskConfig{
+							CPU:    aws.Int(256),
+							Memory: aws.Int(512),
+							Count:  Count{Value: aws.Int(1)},
+						},
+						Queue: SQSQueue{
+							Retention: durationp(96 * time.Hour),
+							Timeout:   durationp(45 * time.Second),
+							DeadLetter: &DeadLetterQueue{
+								Tries: aws.Int(5),
+							},
+						},
+						Scaling: &QueueScaling{
+							Range:           &wantedRange,
+							MessagesPerTask: aws.Int(100),
+						},
+					},
+					Environments: map[string]*WorkerServiceConfig{
+						"prod": {
+							Scaling: &QueueScaling{
+								Range: &wantedProdRange,
+							},
+						},
+					},
+				}
+				require.Equal(t, wantedManifest, actualManifest)
+			},
+		},
+		"worker service with an existing image": {
+			inContent: `
+name: orders
+type: Worker Service
+image:
+  location: 123456789012.dkr.ecr.us-west-2.amazonaws.com/orders:v1.2.0
+`,
+			requireCorrectValues: func(t *testing.T, i interface{}) {
+				actualManifest, ok := i.(*WorkerService)
+				require.True(t, ok)
+				require.Equal(t, ServiceImage{
+					Location: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/orders:v1.2.0"),
+				}, actualManifest.Image)
+			},
+		},
+		"image with both build and location": {
+			inContent: `
+name: frontend
+type: Load Balanced Web Service
+image:
+  build: frontend/Dockerfile
+  location: nginx:latest
+  port: 80
+`,
+			wantedErr: fmt.Errorf("validate load balanced web service: %w", errImageBuildAndLocation),
+		},
+		"environment override with both build and location": {
+			inContent: `
+name: jobs
+type: Scheduled Job
+image:
+  location: busybox
+schedule: "@daily"
+environments:
+  test:
+    image:
+      build: jobs/Dockerfile
+      location: busybox
+`,
+			wantedErr: fmt.Errorf("validate scheduled job override for environment test: %w", errImageBuildAndLocation),
+		},
+		"unknown field": {
+			inContent: `
+name: frontend
+type: Load Balanced Web Service
+image:
+  build: frontend/Dockerfile
+  port: 80
+http:
+  path: "/"
+  healtcheck: "/health"
+`,
+			wantedErr: fmt.Errorf("unmarshal to load balanced web service: %w", &ErrUnknownField{
+				Field:  "http.healtcheck",
+				Line:   9,
+				Column: 3,
+			}),
+		},
+		"unknown field in an environment override": {
+			inContent: `
+name: orders
+type: Worker Service
+image:
+  build: orders/Dockerfile
+environments:
+  prod:
+    cpus: 1024
+`,
+			wantedErr: fmt.Errorf("unmarshal to worker service: %w", &ErrUnknownField{
+				Field:  "environments.prod.cpus",
+				Line:   8,
+				Column: 5,
+			}),
+		},
+		"invalid fargate task size": {
+			inContent: `
+name: subscribers
+type: Backend Service
+image:
+  build: subscribers/Dockerfile
+cpu: 1024
+memory: 1024
+`,
+			wantedErr: errors.New("validate backend service: memory 1024 is not supported by Fargate for cpu 1024"),
+		},
+		"invalid fargate task size in an environment override": {
+			inContent: `
+name: frontend
+type: Load Balanced Web Service
+image:
+  build: frontend/Dockerfile
+  port: 80
+cpu: 256
+memory: 512
+environments:
+  prod:
+    cpu: 2048
+`,
+			wantedErr: errors.New("validate load balanced web service override for environment prod: memory 512 is not supported by Fargate for cpu 2048"),
+		},
+		"cfn overrides": {
+			inContent: `
+name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+cfn_overrides:
+  - path: /Resources/TaskDefinition/Properties/ContainerDefinitions/0/Ulimits
+    value:
+      - Name: nofile
+        HardLimit: !Ref MaxFiles
+environments:
+  prod:
+    cfn_overrides:
+      - path: /Resources/Service/Properties/PlatformVersion
+        value: 1.4.0
+`,
+			requireCorrectValues: func(t *testing.T, i interface{}) {
+				actualManifest, ok := i.(*BackendService)
+				require.True(t, ok)
+				require.Len(t, actualManifest.CFNOverrides, 1)
+				override := actualManifest.CFNOverrides[0]
+				require.Equal(t, []string{"Resources", "TaskDefinition", "Properties", "ContainerDefinitions", "0", "Ulimits"}, override.Segments())
+				require.Equal(t, yaml.SequenceNode, override.Value.Kind)
+				require.Equal(t, "!Ref", override.Value.Content[0].Content[3].Tag)
+
+				prod, err := actualManifest.ApplyEnv("prod")
+				require.NoError(t, err)
+				require.Len(t, prod.CFNOverrides, 1)
+				require.Equal(t, "/Resources/Service/Properties/PlatformVersion", prod.CFNOverrides[0].Path)
+			},
+		},
+		"cfn override without a leading slash": {
+			inContent: `
+name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+cfn_overrides:
+  - path: Resources/Service
+    value: {}
+`,
+			wantedErr: errors.New(`validate backend service: "cfn_overrides" 1: path "Resources/Service" must start with "/" and reference a field of the template`),
+		},
+		"cfn override with an unknown operation": {
+			inContent: `
+name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+cfn_overrides:
+  - op: remove
+    path: /Resources/Service
+    value: {}
+`,
+			wantedErr: errors.New(`validate backend service: "cfn_overrides" 1: op "remove" must be one of "replace" or "add"`),
+		},
+		"cfn override without a value": {
+			inContent: `
+name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+cfn_overrides:
+  - path: /Resources/Service
+`,
+			wantedErr: errors.New(`validate backend service: "cfn_overrides" 1: path /Resources/Service: "value" must be specified`),
+		},
 		"invalid svc type": {
 			inContent: `
 name: CowSvc
@@ -255,6 +529,112 @@ func TestBuildArgsUnmarshalYAML(t *testing.T) {
 	}
 }
 
+func TestCountUnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wantedStruct Count
+		wantedError  error
+	}{
+		"legacy case: simple task count": {
+			inContent: []byte(`count: 1`),
+
+			wantedStruct: Count{
+				Value: aws.Int(1),
+			},
+		},
+		"zero is a valid task count": {
+			inContent: []byte(`count: 0`),
+
+			wantedStruct: Count{
+				Value: aws.Int(0),
+			},
+		},
+		"autoscaling specified": {
+			inContent: []byte(`count:
+  range: 1-10
+  cpu_percentage: 70
+  memory_percentage: 80
+  requests_per_target: 1000`),
+
+			wantedStruct: Count{
+				Autoscaling: Autoscaling{
+					Range:    rangep("1-10"),
+					CPU:      aws.Int(70),
+					Memory:   aws.Int(80),
+					Requests: aws.Int(1000),
+				},
+			},
+		},
+		"Error if unmarshalable": {
+			inContent: []byte(`count:
+  badfield: OH NOES`),
+			wantedError: errUnmarshalCountOpts,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var taskConfig TaskConfig
+			err := yaml.Unmarshal(tc.inContent, &taskConfig)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedStruct, taskConfig.Count)
+			}
+		})
+	}
+}
+
+func TestCount_Desired(t *testing.T) {
+	testCases := map[string]struct {
+		in Count
+
+		wanted    *int
+		wantedErr error
+	}{
+		"fixed number of tasks": {
+			in:     Count{Value: aws.Int(3)},
+			wanted: aws.Int(3),
+		},
+		"minimum of the autoscaling range": {
+			in: Count{
+				Autoscaling: Autoscaling{
+					Range: rangep("2-10"),
+				},
+			},
+			wanted: aws.Int(2),
+		},
+		"invalid range": {
+			in: Count{
+				Autoscaling: Autoscaling{
+					Range: rangep("10"),
+				},
+			},
+			wantedErr: fmt.Errorf("parse task count range 10: %w", errInvalidRangeFormat),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := tc.in.Desired()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func rangep(r string) *Range {
+	v := Range(r)
+	return &v
+}
+
 func TestBuildConfig(t *testing.T) {
 	mockWsRoot := "/root/dir"
 	testCases := map[string]struct {
@@ -340,3 +720,245 @@ func TestBuildConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestSidecar_SidecarsOpts(t *testing.T) {
+	testCases := map[string]struct {
+		inMain     MainContainer
+		inSidecars map[string]*SidecarConfig
+
+		wantedSidecars []*template.SidecarOpts
+		wantedErr      error
+	}{
+		"returns nil if there are no sidecars": {},
+		"errors if the condition is invalid": {
+			inSidecars: map[string]*SidecarConfig{
+				"envoy": {
+					Image: aws.String("envoy"),
+					DependsOn: map[string]string{
+						"frontend": "started",
+					},
+				},
+			},
+
+			wantedErr: errors.New("sidecar envoy: container dependency condition started for container frontend must be one of START, COMPLETE, SUCCESS, HEALTHY"),
+		},
+		"errors if the sidecar depends on itself": {
+			inSidecars: map[string]*SidecarConfig{
+				"envoy": {
+					DependsOn: map[string]string{
+						"envoy": "start",
+					},
+				},
+			},
+
+			wantedErr: errors.New("sidecar envoy: container envoy cannot depend on itself"),
+		},
+		"errors if the container does not exist": {
+			inSidecars: map[string]*SidecarConfig{
+				"envoy": {
+					DependsOn: map[string]string{
+						"xray": "start",
+					},
+				},
+			},
+
+			wantedErr: errors.New("sidecar envoy: container xray in depends_on does not exist"),
+		},
+		"errors if waiting for the service container to complete": {
+			inSidecars: map[string]*SidecarConfig{
+				"envoy": {
+					DependsOn: map[string]string{
+						"frontend": "complete",
+					},
+				},
+			},
+
+			wantedErr: errors.New("sidecar envoy: cannot depend on essential container frontend with condition COMPLETE"),
+		},
+		"errors if waiting for an essential sidecar to complete": {
+			inSidecars: map[string]*SidecarConfig{
+				"envoy": {
+					DependsOn: map[string]string{
+						"init": "success",
+					},
+				},
+				"init": {},
+			},
+
+			wantedErr: errors.New("sidecar envoy: cannot depend on essential container init with condition SUCCESS, set `essential` to false"),
+		},
+		"errors if waiting for a sidecar without a healthcheck to be healthy": {
+			inSidecars: map[string]*SidecarConfig{
+				"envoy": {
+					DependsOn: map[string]string{
+						"init": "healthy",
+					},
+				},
+				"init": {},
+			},
+
+			wantedErr: errors.New("sidecar envoy: cannot depend on container init with condition HEALTHY without a healthcheck"),
+		},
+		"errors if waiting for the service container without a healthcheck to be healthy": {
+			inSidecars: map[string]*SidecarConfig{
+				"envoy": {
+					DependsOn: map[string]string{
+						"frontend": "healthy",
+					},
+				},
+			},
+
+			wantedErr: errors.New("sidecar envoy: cannot depend on container frontend with condition HEALTHY without `image.healthcheck`"),
+		},
+		"errors if the containers depend on each other in a cycle": {
+			inMain: MainContainer{
+				Name: "frontend",
+				DependsOn: map[string]string{
+					"envoy": "start",
+				},
+			},
+			inSidecars: map[string]*SidecarConfig{
+				"envoy": {
+					DependsOn: map[string]string{
+						"xray": "start",
+					},
+				},
+				"xray": {
+					DependsOn: map[string]string{
+						"frontend": "start",
+					},
+				},
+			},
+
+			wantedErr: errors.New("containers can't depend on each other in a cycle: envoy -> xray -> frontend -> envoy"),
+		},
+		"errors if a secret is empty": {
+			inSidecars: map[string]*SidecarConfig{
+				"envoy": {
+					Secrets: map[string]Secret{
+						"TOKEN": {},
+					},
+				},
+			},
+
+			wantedErr: errors.New("sidecar envoy: secret TOKEN: secret must be the name or ARN of an SSM parameter or a Secrets Manager secret"),
+		},
+		"waits for the service container with a healthcheck to be healthy": {
+			inMain: MainContainer{
+				Name:           "frontend",
+				HasHealthCheck: true,
+			},
+			inSidecars: map[string]*SidecarConfig{
+				"envoy": {
+					Image: aws.String("envoy"),
+					DependsOn: map[string]string{
+						"frontend": "healthy",
+					},
+				},
+			},
+
+			wantedSidecars: []*template.SidecarOpts{
+				{
+					Name:  aws.String("envoy"),
+					Image: aws.String("envoy"),
+					Port:  aws.String("80"),
+					DependsOn: map[string]string{
+						"frontend": "HEALTHY",
+					},
+				},
+			},
+		},
+		"converts a sidecar with all fields set": {
+			inSidecars: map[string]*SidecarConfig{
+				"envoy": {
+					Port:       aws.String("9901/tcp"),
+					Image:      aws.String("envoyproxy/envoy"),
+					CredsParam: aws.String("some arn"),
+					Essential:  aws.Bool(false),
+					Variables: map[string]string{
+						"LOG_LEVEL": "info",
+					},
+					Secrets: map[string]Secret{
+						"TOKEN": {From: aws.String("ENVOY_TOKEN")},
+						"DB":    {From: aws.String("arn:aws:secretsmanager:us-west-2:111111111111:secret:db-AbCdEf")},
+					},
+					MountPoints: []SidecarMountPoint{
+						{
+							SourceVolume:  aws.String("config"),
+							ContainerPath: aws.String("/etc/envoy"),
+							ReadOnly:      aws.Bool(true),
+						},
+					},
+					DependsOn: map[string]string{
+						"frontend": "start",
+					},
+					HealthCheck: &ContainerHealthCheck{
+						Command: []string{"CMD-SHELL", "curl -f http://localhost:9901/ready || exit 1"},
+						Retries: aws.Int(3),
+					},
+				},
+			},
+
+			wantedSidecars: []*template.SidecarOpts{
+				{
+					Name:       aws.String("envoy"),
+					Image:      aws.String("envoyproxy/envoy"),
+					Port:       aws.String("9901"),
+					Protocol:   aws.String("tcp"),
+					CredsParam: aws.String("some arn"),
+					Essential:  aws.Bool(false),
+					Variables: map[string]string{
+						"LOG_LEVEL": "info",
+					},
+					Secrets: map[string]template.Secret{
+						"TOKEN": {ValueFrom: "ENVOY_TOKEN"},
+						"DB": {
+							ValueFrom: "arn:aws:secretsmanager:us-west-2:111111111111:secret:db-AbCdEf",
+							SecretsManager: &template.SecretsManagerOpts{
+								ARN: "arn:aws:secretsmanager:us-west-2:111111111111:secret:db-AbCdEf",
+							},
+						},
+					},
+					MountPoints: []*template.MountPointOpts{
+						{
+							SourceVolume:  aws.String("config"),
+							ContainerPath: aws.String("/etc/envoy"),
+							ReadOnly:      aws.Bool(true),
+						},
+					},
+					DependsOn: map[string]string{
+						"frontend": "START",
+					},
+					HealthCheck: &ecs.HealthCheck{
+						Command:     aws.StringSlice([]string{"CMD-SHELL", "curl -f http://localhost:9901/ready || exit 1"}),
+						Interval:    aws.Int64(10),
+						Retries:     aws.Int64(3),
+						StartPeriod: aws.Int64(0),
+						Timeout:     aws.Int64(5),
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			s := Sidecar{
+				Sidecars: tc.inSidecars,
+			}
+
+			main := tc.inMain
+			if main.Name == "" {
+				main.Name = "frontend"
+			}
+
+			got, err := s.SidecarsOpts(main)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSidecars, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// yamlMergeKey is the key that merges the fields of another mapping, usually an alias, into a mapping.
+const yamlMergeKey = "<<"
+
+var (
+	nodeType                = reflect.TypeOf(yaml.Node{})
+	unmarshalerType         = reflect.TypeOf((*yaml.Unmarshaler)(nil)).Elem()
+	obsoleteUnmarshalerType = reflect.TypeOf((*interface {
+		UnmarshalYAML(unmarshal func(interface{}) error) error
+	})(nil)).Elem()
+)
+
+// unmarshalStrict deserializes the YAML input stream into out like yaml.Unmarshal,
+// but returns an ErrUnknownField if the input contains a key that doesn't match any field of out.
+func unmarshalStrict(in []byte, out interface{}) error {
+	if err := yaml.Unmarshal(in, out); err != nil {
+		return err
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(in, &node); err != nil {
+		return err
+	}
+	return knownFields(&node, reflect.TypeOf(out), "")
+}
+
+// knownFields returns an ErrUnknownField for the first key in the node that doesn't match a field of typ.
+// The path is the dot separated list of keys that lead to the node.
+func knownFields(node *yaml.Node, typ reflect.Type, path string) error {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil
+		}
+		return knownFields(node.Content[0], typ, path)
+	case yaml.AliasNode:
+		return knownFields(node.Alias, typ, path)
+	}
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == nodeType {
+		return nil // A yaml.Node field accepts any value.
+	}
+	switch typ.Kind() {
+	case reflect.Struct:
+		if node.Kind != yaml.MappingNode {
+			return nil
+		}
+		if isUnion(typ) {
+			// A union is a scalar or a map, only the map needs to be checked.
+			for i := 0; i < typ.NumField(); i++ {
+				if field := typ.Field(i); field.Type.Kind() == reflect.Struct {
+					return knownFields(node, field.Type, path)
+				}
+			}
+			return nil
+		}
+		fields, anyKey := yamlFields(typ)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Value == yamlMergeKey {
+				if err := knownMergedFields(value, typ, path); err != nil {
+					return err
+				}
+				continue
+			}
+			fieldType, ok := fields[key.Value]
+			if !ok {
+				if anyKey {
+					continue
+				}
+				return &ErrUnknownField{
+					Field:  joinFieldPath(path, key.Value),
+					Line:   key.Line,
+					Column: key.Column,
+				}
+			}
+			if err := knownFields(value, fieldType, joinFieldPath(path, key.Value)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if node.Kind != yaml.MappingNode {
+			return nil
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if err := knownFields(node.Content[i+1], typ.Elem(), joinFieldPath(path, node.Content[i].Value)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if node.Kind != yaml.SequenceNode {
+			return nil
+		}
+		for _, item := range node.Content {
+			if err := knownFields(item, typ.Elem(), path); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// knownMergedFields checks the mappings merged with the "<<" key, which is either a mapping or a sequence of mappings.
+func knownMergedFields(node *yaml.Node, typ reflect.Type, path string) error {
+	if node.Kind != yaml.SequenceNode {
+		return knownFields(node, typ, path)
+	}
+	for _, item := range node.Content {
+		if err := knownFields(item, typ, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// yamlFields returns the types of the fields of a struct keyed by their YAML key, following the rules of the yaml package.
+// If the struct inlines a map, any key is valid and anyKey is true.
+func yamlFields(typ reflect.Type) (fields map[string]reflect.Type, anyKey bool) {
+	fields = make(map[string]reflect.Type)
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue // Unexported field.
+		}
+		tag := field.Tag.Get("yaml")
+		if tag == "-" {
+			continue
+		}
+		opts := strings.Split(tag, ",")
+		if hasYAMLOption(opts[1:], "inline") {
+			inlined := field.Type
+			for inlined.Kind() == reflect.Ptr {
+				inlined = inlined.Elem()
+			}
+			if inlined.Kind() == reflect.Map {
+				anyKey = true
+				continue
+			}
+			inlinedFields, inlinedAnyKey := yamlFields(inlined)
+			for name, t := range inlinedFields {
+				fields[name] = t
+			}
+			anyKey = anyKey || inlinedAnyKey
+			continue
+		}
+		name := opts[0]
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		fields[name] = field.Type
+	}
+	return fields, anyKey
+}
+
+// isUnion returns true if the struct implements its own unmarshaling logic to support either a scalar or a map,
+// such as Count which is either an integer or an autoscaling map.
+func isUnion(typ reflect.Type) bool {
+	ptr := reflect.PtrTo(typ)
+	return ptr.Implements(unmarshalerType) || ptr.Implements(obsoleteUnmarshalerType)
+}
+
+func hasYAMLOption(opts []string, want string) bool {
+	for _, opt := range opts {
+		if opt == want {
+			return true
+		}
+	}
+	return false
+}
+
+func joinFieldPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
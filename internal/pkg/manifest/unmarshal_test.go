@@ -0,0 +1,110 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalStrict(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedErr error
+	}{
+		"known fields of inlined and nested structs": {
+			inContent: `
+name: api
+type: Backend Service
+image:
+  build:
+    dockerfile: api/Dockerfile
+    args:
+      GO_VERSION: "1.16"
+  port: 8080
+  healthcheck:
+    command: ["CMD", "true"]
+count:
+  range: 1-10
+  cpu_percentage: 70
+secrets:
+  GITHUB_TOKEN: GH_TOKEN
+  DB:
+    secretsmanager: "prod/db:password::"
+platform:
+  osfamily: linux
+  architecture: arm64
+sidecars:
+  nginx:
+    image: nginx
+    depends_on:
+      api: START
+variables:
+  ANY_KEY: value
+`,
+		},
+		"unknown field in a union map": {
+			inContent: `
+image:
+  build:
+    dockerfile: api/Dockerfile
+    arg:
+      GO_VERSION: "1.16"
+`,
+			wantedErr: &ErrUnknownField{Field: "image.build.arg", Line: 5, Column: 5},
+		},
+		"unknown field in a list": {
+			inContent: `
+platform:
+  capacity:
+    - provider: FARGATE
+    - provder: FARGATE_SPOT
+`,
+			wantedErr: &ErrUnknownField{Field: "platform.capacity.provder", Line: 5, Column: 7},
+		},
+		"unknown field in a sidecar": {
+			inContent: `
+sidecars:
+  nginx:
+    image: nginx
+    ports: 80
+`,
+			wantedErr: &ErrUnknownField{Field: "sidecars.nginx.ports", Line: 5, Column: 5},
+		},
+		"known fields in a merged anchor": {
+			inContent: `
+environments:
+  test: &test
+    cpu: 256
+  prod:
+    <<: *test
+    memory: 1024
+`,
+		},
+		"unknown field from an alias": {
+			inContent: `
+environments:
+  test: &test
+    cpu: 256
+    memroy: 512
+  prod: *test
+`,
+			wantedErr: &ErrUnknownField{Field: "environments.test.memroy", Line: 5, Column: 5},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := unmarshalStrict([]byte(tc.inContent), newDefaultBackendService())
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,289 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+const (
+	minPort       = 1
+	maxPort       = 65535
+	maxPercentage = 100
+)
+
+var validSidecarProtocols = []string{"tcp", "udp"}
+
+var sidecarNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,255}$`)
+
+var (
+	errEmptyEnvFile      = errors.New(`"env_file" must not be empty`)
+	errNoImagePort       = errors.New(`"image.port" must be specified to route requests from the load balancer`)
+	errZeroImagePort     = errors.New(`"image.port" must be between 1 and 65535`)
+	errNoSchedule        = errors.New(`"schedule" must be specified`)
+	errNegativeRetries   = errors.New(`"retries" must not be negative`)
+	errNegativeCount     = errors.New(`"count" must not be negative`)
+	errHTTPWithoutPort   = errors.New(`"image.port" must be specified to receive requests on "http.path"`)
+	errNoNLBTargetPort   = errors.New(`"nlb.target_port" must be between 1 and 65535`)
+	errNoMessagesPerTask = errors.New(`"scaling.messages_per_task" must be greater than 0`)
+)
+
+// validate returns an error if a field of the service has a value that can't be deployed.
+func (s *LoadBalancedWebService) validate() error {
+	if err := s.Image.validate(); err != nil {
+		return err
+	}
+	if s.Image.Port == nil {
+		return errNoImagePort
+	}
+	if err := s.Image.validatePort(); err != nil {
+		return err
+	}
+	if err := s.TaskConfig.validate(); err != nil {
+		return err
+	}
+	if err := s.Sidecar.validate(s.MainContainer(), s.Storage); err != nil {
+		return err
+	}
+	if _, err := s.RoutingRule.ListenerRulesOpts(); err != nil {
+		return err
+	}
+	if err := s.validateContainer("http.targetContainer", s.RoutingRule.TargetContainer); err != nil {
+		return err
+	}
+	if err := s.validateNLB(); err != nil {
+		return err
+	}
+	return validateCFNOverrides(s.CFNOverrides)
+}
+
+func (s *LoadBalancedWebService) validateNLB() error {
+	if s.NLBConfig.IsEmpty() {
+		return nil
+	}
+	if _, _, err := s.NLBConfig.Port.Parse(); err != nil {
+		return err
+	}
+	if err := s.validateContainer("nlb.target_container", s.NLBConfig.TargetContainer); err != nil {
+		return err
+	}
+	if s.NLBConfig.TargetPort != nil && *s.NLBConfig.TargetPort == 0 {
+		return errNoNLBTargetPort
+	}
+	return nil
+}
+
+// validateContainer returns an error if the field references a container that is neither the main container nor a sidecar.
+func (s *LoadBalancedWebService) validateContainer(field string, container *string) error {
+	if container == nil || *container == aws.StringValue(s.Name) {
+		return nil
+	}
+	if _, ok := s.Sidecars[*container]; !ok {
+		return fmt.Errorf(`%q container %s is neither the main container nor a sidecar`, field, *container)
+	}
+	return nil
+}
+
+// validate returns an error if a field of the service has a value that can't be deployed.
+func (s *BackendService) validate() error {
+	if err := s.Image.validate(); err != nil {
+		return err
+	}
+	if err := s.Image.validatePort(); err != nil {
+		return err
+	}
+	if !s.HTTP.IsEmpty() && s.Image.Port == nil {
+		return errHTTPWithoutPort
+	}
+	if err := s.TaskConfig.validate(); err != nil {
+		return err
+	}
+	if err := s.Sidecar.validate(s.MainContainer(), s.Storage); err != nil {
+		return err
+	}
+	return validateCFNOverrides(s.CFNOverrides)
+}
+
+// validate returns an error if a field of the service has a value that can't be deployed.
+func (s *WorkerService) validate() error {
+	if err := s.Image.validate(); err != nil {
+		return err
+	}
+	if err := s.TaskConfig.validate(); err != nil {
+		return err
+	}
+	if err := s.Sidecar.validate(s.MainContainer(), s.Storage); err != nil {
+		return err
+	}
+	if err := validateCFNOverrides(s.CFNOverrides); err != nil {
+		return err
+	}
+	if s.Scaling == nil {
+		return nil
+	}
+	if s.Scaling.Range != nil {
+		if _, _, err := s.Scaling.Range.Parse(); err != nil {
+			return fmt.Errorf(`parse "scaling.range" %s: %w`, string(*s.Scaling.Range), err)
+		}
+	}
+	if s.Scaling.MessagesPerTask != nil && *s.Scaling.MessagesPerTask <= 0 {
+		return errNoMessagesPerTask
+	}
+	return nil
+}
+
+// validate returns an error if a field of the job has a value that can't be deployed.
+func (j *ScheduledJob) validate() error {
+	if err := j.Image.validate(); err != nil {
+		return err
+	}
+	if err := j.TaskConfig.validate(); err != nil {
+		return err
+	}
+	if err := j.Sidecar.validate(j.MainContainer(), j.Storage); err != nil {
+		return err
+	}
+	if aws.StringValue(j.Schedule) == "" {
+		return errNoSchedule
+	}
+	if j.Retries != nil && *j.Retries < 0 {
+		return errNegativeRetries
+	}
+	return validateCFNOverrides(j.CFNOverrides)
+}
+
+// validatePort returns an error if the port of the main container is set to 0.
+func (s *ServiceImageWithPort) validatePort() error {
+	if s.Port != nil && *s.Port == 0 {
+		return errZeroImagePort
+	}
+	return nil
+}
+
+// validate returns an error if the task size, count, environment file, secrets, storage or platform are invalid.
+func (tc TaskConfig) validate() error {
+	if tc.CPU != nil && tc.Memory != nil {
+		if err := ValidateFargateTaskSize(*tc.CPU, *tc.Memory); err != nil {
+			return err
+		}
+	}
+	if err := tc.Count.validate(); err != nil {
+		return err
+	}
+	if tc.EnvFile != nil && *tc.EnvFile == "" {
+		return errEmptyEnvFile
+	}
+	if _, err := tc.SecretOpts(); err != nil {
+		return err
+	}
+	if _, err := tc.Storage.StorageOpts(); err != nil {
+		return err
+	}
+	if _, err := tc.Permissions.PermissionsOpts(); err != nil {
+		return fmt.Errorf(`validate "permissions": %w`, err)
+	}
+	if _, err := tc.Platform.CapacityProviderOpts(); err != nil {
+		return err
+	}
+	if _, err := tc.RuntimePlatformOpts(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validate returns an error if the count is negative or the autoscaling configuration is out of bounds.
+func (c *Count) validate() error {
+	if c.Value != nil && *c.Value < 0 {
+		return errNegativeCount
+	}
+	if _, err := c.Desired(); err != nil {
+		return err
+	}
+	for field, percentage := range map[string]*int{
+		"count.cpu_percentage":    c.Autoscaling.CPU,
+		"count.memory_percentage": c.Autoscaling.Memory,
+	} {
+		if percentage != nil && (*percentage <= 0 || *percentage > maxPercentage) {
+			return fmt.Errorf(`%q must be between 1 and %d`, field, maxPercentage)
+		}
+	}
+	if c.Autoscaling.Requests != nil && *c.Autoscaling.Requests <= 0 {
+		return errors.New(`"count.requests_per_target" must be greater than 0`)
+	}
+	return nil
+}
+
+// validate returns an error if a sidecar has an invalid name, no image, an invalid port, mounts a volume
+// that isn't in the storage of the task, or if the containers have invalid dependencies.
+func (s *Sidecar) validate(main MainContainer, storage *Storage) error {
+	for name, config := range s.Sidecars {
+		if !sidecarNameRegexp.MatchString(name) {
+			return fmt.Errorf("sidecar name %s must contain only letters, numbers, hyphens and underscores", name)
+		}
+		if name == main.Name {
+			return fmt.Errorf("sidecar %s can't have the same name as the main container", name)
+		}
+		if config == nil || aws.StringValue(config.Image) == "" {
+			return fmt.Errorf(`sidecar %s: "image" must be specified`, name)
+		}
+		if err := validateSidecarPort(config.Port); err != nil {
+			return fmt.Errorf("sidecar %s: %w", name, err)
+		}
+		if err := validateSidecarMountPoints(config.MountPoints, storage); err != nil {
+			return fmt.Errorf("sidecar %s: %w", name, err)
+		}
+	}
+	if _, err := s.MainContainerDependsOnOpts(main); err != nil {
+		return err
+	}
+	_, err := s.SidecarsOpts(main)
+	return err
+}
+
+// validateSidecarMountPoints returns an error if a mount point of a sidecar doesn't have a source volume and path,
+// or if its source volume isn't one of the "storage.volumes" of the task.
+func validateSidecarMountPoints(mountPoints []SidecarMountPoint, storage *Storage) error {
+	var volumes map[string]Volume
+	if storage != nil {
+		volumes = storage.Volumes
+	}
+	for _, mp := range mountPoints {
+		if aws.StringValue(mp.SourceVolume) == "" {
+			return errors.New(`"source_volume" must be specified in "mount_points"`)
+		}
+		if aws.StringValue(mp.ContainerPath) == "" {
+			return errors.New(`"path" must be specified in "mount_points"`)
+		}
+		if _, ok := volumes[*mp.SourceVolume]; !ok {
+			return fmt.Errorf(`source volume %s in "mount_points" must be one of "storage.volumes"`, *mp.SourceVolume)
+		}
+	}
+	return nil
+}
+
+func validateSidecarPort(portMapping *string) error {
+	port, protocol, err := parsePortMapping(portMapping)
+	if err != nil {
+		return err
+	}
+	parsed, err := strconv.Atoi(aws.StringValue(port))
+	if err != nil || parsed < minPort || parsed > maxPort {
+		return fmt.Errorf("port %s must be between %d and %d", aws.StringValue(port), minPort, maxPort)
+	}
+	if protocol == nil {
+		return nil
+	}
+	for _, valid := range validSidecarProtocols {
+		if strings.ToLower(*protocol) == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf("protocol %s must be one of %s", *protocol, strings.Join(validSidecarProtocols, ", "))
+}
@@ -0,0 +1,321 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadBalancedWebService_validate(t *testing.T) {
+	testCases := map[string]struct {
+		inConfig func(svc *LoadBalancedWebService)
+
+		wantedErr error
+	}{
+		"valid": {
+			inConfig: func(svc *LoadBalancedWebService) {},
+		},
+		"error if the port is missing": {
+			inConfig: func(svc *LoadBalancedWebService) {
+				svc.Image.Port = nil
+			},
+			wantedErr: errNoImagePort,
+		},
+		"error if the port is 0": {
+			inConfig: func(svc *LoadBalancedWebService) {
+				svc.Image.Port = aws.Uint16(0)
+			},
+			wantedErr: errZeroImagePort,
+		},
+		"error if the target container does not exist": {
+			inConfig: func(svc *LoadBalancedWebService) {
+				svc.TargetContainer = aws.String("nginx")
+			},
+			wantedErr: errors.New(`"http.targetContainer" container nginx is neither the main container nor a sidecar`),
+		},
+		"target container is a sidecar": {
+			inConfig: func(svc *LoadBalancedWebService) {
+				svc.TargetContainer = aws.String("nginx")
+				svc.Sidecars = map[string]*SidecarConfig{
+					"nginx": {Image: aws.String("nginx")},
+				}
+			},
+		},
+		"error if the nlb port is invalid": {
+			inConfig: func(svc *LoadBalancedWebService) {
+				port := NLBPort("443/http")
+				svc.NLBConfig.Port = &port
+			},
+			wantedErr: errors.New("nlb protocol http must be one of tcp, tls"),
+		},
+		"error if the nlb target port is 0": {
+			inConfig: func(svc *LoadBalancedWebService) {
+				port := NLBPort("443")
+				svc.NLBConfig.Port = &port
+				svc.NLBConfig.TargetPort = aws.Uint16(0)
+			},
+			wantedErr: errNoNLBTargetPort,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			svc := NewLoadBalancedWebService(&LoadBalancedWebServiceProps{
+				ServiceProps: &ServiceProps{Name: "frontend", Dockerfile: "frontend/Dockerfile"},
+				Path:         "/",
+				Port:         80,
+			})
+			tc.inConfig(svc)
+
+			err := svc.validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTaskConfig_validate(t *testing.T) {
+	testCases := map[string]struct {
+		inConfig TaskConfig
+
+		wantedErr error
+	}{
+		"valid": {
+			inConfig: TaskConfig{
+				CPU:    aws.Int(512),
+				Memory: aws.Int(1024),
+				Count: Count{
+					Autoscaling: Autoscaling{
+						Range: rangep("1-10"),
+						CPU:   aws.Int(70),
+					},
+				},
+			},
+		},
+		"error if the task size is not supported by fargate": {
+			inConfig: TaskConfig{
+				CPU:    aws.Int(256),
+				Memory: aws.Int(4096),
+			},
+			wantedErr: errors.New("memory 4096 is not supported by Fargate for cpu 256"),
+		},
+		"error if the count is negative": {
+			inConfig: TaskConfig{
+				Count: Count{Value: aws.Int(-1)},
+			},
+			wantedErr: errNegativeCount,
+		},
+		"error if the range is invalid": {
+			inConfig: TaskConfig{
+				Count: Count{
+					Autoscaling: Autoscaling{Range: rangep("10-1")},
+				},
+			},
+			wantedErr: errors.New("parse task count range 10-1: minimum 10 can't be greater than maximum 1"),
+		},
+		"error if the cpu percentage is out of bounds": {
+			inConfig: TaskConfig{
+				Count: Count{
+					Autoscaling: Autoscaling{CPU: aws.Int(150)},
+				},
+			},
+			wantedErr: errors.New(`"count.cpu_percentage" must be between 1 and 100`),
+		},
+		"error if the env file is empty": {
+			inConfig: TaskConfig{
+				EnvFile: aws.String(""),
+			},
+			wantedErr: errEmptyEnvFile,
+		},
+		"error if a secret is empty": {
+			inConfig: TaskConfig{
+				Secrets: map[string]Secret{
+					"GITHUB_TOKEN": {From: aws.String("")},
+				},
+			},
+			wantedErr: errors.New("secret GITHUB_TOKEN: " + errEmptySecret.Error()),
+		},
+		"error if a volume has no path": {
+			inConfig: TaskConfig{
+				Storage: &Storage{
+					Volumes: map[string]Volume{"data": {}},
+				},
+			},
+			wantedErr: errors.New("validate volume data: " + errNoContainerPath.Error()),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.inConfig.validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSidecar_validate(t *testing.T) {
+	testCases := map[string]struct {
+		inMainDependsOn map[string]string
+		inSidecars      map[string]*SidecarConfig
+		inStorage       *Storage
+
+		wantedErr error
+	}{
+		"valid": {
+			inSidecars: map[string]*SidecarConfig{
+				"xray": {Image: aws.String("amazon/aws-xray-daemon"), Port: aws.String("2000/udp")},
+			},
+		},
+		"error if the name is invalid": {
+			inSidecars: map[string]*SidecarConfig{
+				"x ray": {Image: aws.String("amazon/aws-xray-daemon")},
+			},
+			wantedErr: errors.New("sidecar name x ray must contain only letters, numbers, hyphens and underscores"),
+		},
+		"error if the name is the main container's": {
+			inSidecars: map[string]*SidecarConfig{
+				"api": {Image: aws.String("nginx")},
+			},
+			wantedErr: errors.New("sidecar api can't have the same name as the main container"),
+		},
+		"error if the image is missing": {
+			inSidecars: map[string]*SidecarConfig{
+				"nginx": {},
+			},
+			wantedErr: errors.New(`sidecar nginx: "image" must be specified`),
+		},
+		"error if the config is missing": {
+			inSidecars: map[string]*SidecarConfig{
+				"nginx": nil,
+			},
+			wantedErr: errors.New(`sidecar nginx: "image" must be specified`),
+		},
+		"error if the port is out of range": {
+			inSidecars: map[string]*SidecarConfig{
+				"nginx": {Image: aws.String("nginx"), Port: aws.String("70000")},
+			},
+			wantedErr: errors.New("sidecar nginx: port 70000 must be between 1 and 65535"),
+		},
+		"error if the protocol is invalid": {
+			inSidecars: map[string]*SidecarConfig{
+				"nginx": {Image: aws.String("nginx"), Port: aws.String("80/http")},
+			},
+			wantedErr: errors.New("sidecar nginx: protocol http must be one of tcp, udp"),
+		},
+		"error if a dependency is invalid": {
+			inSidecars: map[string]*SidecarConfig{
+				"nginx": {Image: aws.String("nginx"), DependsOn: map[string]string{"nginx": "start"}},
+			},
+			wantedErr: errors.New("sidecar nginx: container nginx cannot depend on itself"),
+		},
+		"valid if the sidecar mounts a volume of the task": {
+			inSidecars: map[string]*SidecarConfig{
+				"nginx": {
+					Image:       aws.String("nginx"),
+					MountPoints: []SidecarMountPoint{{SourceVolume: aws.String("data"), ContainerPath: aws.String("/var/data")}},
+				},
+			},
+			inStorage: &Storage{
+				Volumes: map[string]Volume{"data": {ContainerPath: aws.String("/data")}},
+			},
+		},
+		"error if the sidecar mounts a volume without a path": {
+			inSidecars: map[string]*SidecarConfig{
+				"nginx": {
+					Image:       aws.String("nginx"),
+					MountPoints: []SidecarMountPoint{{SourceVolume: aws.String("data")}},
+				},
+			},
+			inStorage: &Storage{
+				Volumes: map[string]Volume{"data": {ContainerPath: aws.String("/data")}},
+			},
+			wantedErr: errors.New(`sidecar nginx: "path" must be specified in "mount_points"`),
+		},
+		"error if the sidecar mounts a volume that isn't in the storage of the task": {
+			inSidecars: map[string]*SidecarConfig{
+				"nginx": {
+					Image:       aws.String("nginx"),
+					MountPoints: []SidecarMountPoint{{SourceVolume: aws.String("data"), ContainerPath: aws.String("/var/data")}},
+				},
+			},
+			wantedErr: errors.New(`sidecar nginx: source volume data in "mount_points" must be one of "storage.volumes"`),
+		},
+		"valid if the main container depends on a sidecar": {
+			inMainDependsOn: map[string]string{"nginx": "start"},
+			inSidecars: map[string]*SidecarConfig{
+				"nginx": {Image: aws.String("nginx")},
+			},
+		},
+		"error if the main container depends on a sidecar that doesn't exist": {
+			inMainDependsOn: map[string]string{"nginx": "start"},
+			wantedErr:       errors.New(`validate "image.depends_on": container nginx in depends_on does not exist`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			sidecar := Sidecar{Sidecars: tc.inSidecars}
+
+			err := sidecar.validate(MainContainer{Name: "api", DependsOn: tc.inMainDependsOn}, tc.inStorage)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestScheduledJob_validate(t *testing.T) {
+	testCases := map[string]struct {
+		inSchedule *string
+		inRetries  *int
+
+		wantedErr error
+	}{
+		"valid": {
+			inSchedule: aws.String("@daily"),
+			inRetries:  aws.Int(3),
+		},
+		"error if the schedule is missing": {
+			wantedErr: errNoSchedule,
+		},
+		"error if retries is negative": {
+			inSchedule: aws.String("@daily"),
+			inRetries:  aws.Int(-1),
+			wantedErr:  errNegativeRetries,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			job := newDefaultScheduledJob()
+			job.Name = aws.String("report")
+			job.Schedule = tc.inSchedule
+			job.Retries = tc.inRetries
+
+			err := job.validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
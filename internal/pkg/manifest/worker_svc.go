@@ -0,0 +1,160 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/imdario/mergo"
+)
+
+const (
+	workerSvcManifestPath = "services/worker/manifest.yml"
+)
+
+// WorkerServiceProps contains properties for creating a new worker service manifest.
+type WorkerServiceProps struct {
+	*ServiceProps
+}
+
+// WorkerService holds the configuration to create a service that processes messages from an SQS queue.
+type WorkerService struct {
+	Service             `yaml:",inline"`
+	WorkerServiceConfig `yaml:",inline"`
+	// Use *WorkerServiceConfig because of https://github.com/imdario/mergo/issues/146
+	Environments map[string]*WorkerServiceConfig `yaml:",flow"` // Fields to override per environment.
+
+	parser template.Parser
+}
+
+// WorkerServiceConfig holds the configuration that can be overriden per environments.
+type WorkerServiceConfig struct {
+	Image      ServiceImage `yaml:",flow"`
+	TaskConfig `yaml:",inline"`
+	*LogConfig `yaml:"logging,flow"`
+	Sidecar    `yaml:",inline"`
+	Queue      SQSQueue         `yaml:"queue"`
+	Scaling    *QueueScaling    `yaml:"scaling"`
+	Deployment DeploymentConfig `yaml:"deployment"`
+	Exec       *bool            `yaml:"exec"` // Enables ECS Exec to run commands in the running containers.
+
+	CFNOverrides []CFNOverride `yaml:"cfn_overrides"`
+}
+
+// SQSQueue holds the configuration of the queue that the worker service consumes messages from.
+type SQSQueue struct {
+	Retention  *time.Duration   `yaml:"retention"` // How long the queue retains a message.
+	Timeout    *time.Duration   `yaml:"timeout"`   // How long a received message is hidden from other consumers.
+	DeadLetter *DeadLetterQueue `yaml:"dead_letter"`
+}
+
+// DeadLetterQueue holds the configuration of the queue that failed messages are moved to.
+type DeadLetterQueue struct {
+	Tries *int `yaml:"tries"` // Number of times a message is received before it's moved to the dead-letter queue.
+}
+
+// QueueScaling holds the configuration to scale the number of tasks based on the number of messages in the queue.
+type QueueScaling struct {
+	Range           *Range `yaml:"range"`             // Minimum and maximum number of tasks, such as "1-10".
+	MessagesPerTask *int   `yaml:"messages_per_task"` // Number of visible messages a single task can handle.
+}
+
+// LogConfigOpts converts the service's Firelens configuration into a format parsable by the templates pkg.
+func (wc *WorkerServiceConfig) LogConfigOpts() *template.LogConfigOpts {
+	if wc.LogConfig == nil {
+		return nil
+	}
+	return wc.logConfigOpts()
+}
+
+// NewWorkerService applies the props to a default worker service configuration with
+// minimal task sizes, single replica, and a queue with default settings, and then returns it.
+func NewWorkerService(props *WorkerServiceProps) *WorkerService {
+	svc := newDefaultWorkerService()
+	// Apply overrides.
+	svc.Name = aws.String(props.Name)
+	svc.WorkerServiceConfig.Image.Build.BuildArgs.Dockerfile = aws.String(props.Dockerfile)
+	svc.parser = template.New()
+	return svc
+}
+
+// newDefaultWorkerService returns a worker service with minimal task sizes and a single replica.
+func newDefaultWorkerService() *WorkerService {
+	return &WorkerService{
+		Service: Service{
+			Type: aws.String(WorkerServiceType),
+		},
+		WorkerServiceConfig: WorkerServiceConfig{
+			Image: ServiceImage{},
+			TaskConfig: TaskConfig{
+				CPU:    aws.Int(256),
+				Memory: aws.Int(512),
+				Count: Count{
+					Value: aws.Int(1),
+				},
+			},
+		},
+	}
+}
+
+// MarshalBinary serializes the manifest object into a binary YAML document.
+// Implements the encoding.BinaryMarshaler interface.
+func (s *WorkerService) MarshalBinary() ([]byte, error) {
+	content, err := s.parser.Parse(workerSvcManifestPath, *s, template.WithFuncs(map[string]interface{}{
+		"dirName": tplDirName,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// BuildArgs returns a docker.BuildArguments object for the service given a workspace root directory.
+func (s *WorkerService) BuildArgs(wsRoot string) *DockerBuildArgs {
+	return s.Image.BuildConfig(wsRoot)
+}
+
+// MainContainer returns the configuration of the main container that the sidecars of the service can depend on.
+func (s *WorkerService) MainContainer() MainContainer {
+	return MainContainer{
+		Name:      aws.StringValue(s.Name),
+		DependsOn: s.Image.DependsOn,
+	}
+}
+
+// ApplyEnv returns the service manifest with environment overrides.
+// If the environment passed in does not have any overrides then it returns itself.
+func (s WorkerService) ApplyEnv(envName string) (*WorkerService, error) {
+	overrideConfig, ok := s.Environments[envName]
+	if !ok {
+		return &s, nil
+	}
+	// Apply overrides to the original service s.
+	err := mergo.Merge(&s, WorkerService{
+		WorkerServiceConfig: *overrideConfig,
+	}, mergo.WithOverride, mergo.WithOverwriteWithEmptyValue, mergo.WithTransformers(sliceOverrideTransformer{}))
+	if err != nil {
+		return nil, err
+	}
+	s.Count.applyOverride(overrideConfig.Count)
+	s.Image.applyOverride(overrideConfig.Image)
+	s.Platform.applyOverride(overrideConfig.Platform)
+	s.Environments = nil
+	return &s, nil
+}
+
+// EnvWorkload returns the configuration of the service in the environment after applying the environment's overrides.
+func (s WorkerService) EnvWorkload(envName string) (*WorkloadConfig, error) {
+	svc, err := s.ApplyEnv(envName)
+	if err != nil {
+		return nil, err
+	}
+	return &WorkloadConfig{
+		Image:    svc.Image,
+		Task:     svc.TaskConfig,
+		Sidecars: svc.Sidecars,
+	}, nil
+}
@@ -0,0 +1,211 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWorkerService(t *testing.T) {
+	// WHEN
+	svc := NewWorkerService(&WorkerServiceProps{
+		ServiceProps: &ServiceProps{
+			Name:       "orders",
+			Dockerfile: "./orders/Dockerfile",
+		},
+	})
+
+	// THEN
+	require.Equal(t, Service{
+		Name: aws.String("orders"),
+		Type: aws.String(WorkerServiceType),
+	}, svc.Service)
+	require.Equal(t, WorkerServiceConfig{
+		Image: ServiceImage{
+			Build: BuildArgsOrString{
+				BuildArgs: DockerBuildArgs{
+					Dockerfile: aws.String("./orders/Dockerfile"),
+				},
+			},
+		},
+		TaskConfig: TaskConfig{
+			CPU:    aws.Int(256),
+			Memory: aws.Int(512),
+			Count:  Count{Value: aws.Int(1)},
+		},
+	}, svc.WorkerServiceConfig)
+}
+
+func TestWorkerService_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(ctrl *gomock.Controller, manifest *WorkerService)
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error parsing template": {
+			mockDependencies: func(ctrl *gomock.Controller, manifest *WorkerService) {
+				m := mocks.NewMockParser(ctrl)
+				manifest.parser = m
+				m.EXPECT().Parse(workerSvcManifestPath, *manifest, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns rendered content": {
+			mockDependencies: func(ctrl *gomock.Controller, manifest *WorkerService) {
+				m := mocks.NewMockParser(ctrl)
+				manifest.parser = m
+				m.EXPECT().Parse(workerSvcManifestPath, *manifest, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("hello")}, nil)
+			},
+
+			wantedBinary: []byte("hello"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			manifest := &WorkerService{}
+			tc.mockDependencies(ctrl, manifest)
+
+			// WHEN
+			b, err := manifest.MarshalBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedBinary, b)
+		})
+	}
+}
+
+func TestWorkerService_ApplyEnv(t *testing.T) {
+	testRange := Range("1-10")
+	prodRange := Range("5-50")
+	mft := &WorkerService{
+		Service: Service{
+			Name: aws.String("orders"),
+			Type: aws.String(WorkerServiceType),
+		},
+		WorkerServiceConfig: WorkerServiceConfig{
+			TaskConfig: TaskConfig{
+				CPU:    aws.Int(256),
+				Memory: aws.Int(512),
+				Count:  Count{Value: aws.Int(1)},
+			},
+			Queue: SQSQueue{
+				Retention: durationp(96 * time.Hour),
+			},
+			Scaling: &QueueScaling{
+				Range:           &testRange,
+				MessagesPerTask: aws.Int(100),
+			},
+		},
+		Environments: map[string]*WorkerServiceConfig{
+			"prod": {
+				Queue: SQSQueue{
+					Timeout: durationp(time.Minute),
+				},
+				Scaling: &QueueScaling{
+					Range: &prodRange,
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		envToApply string
+
+		wanted WorkerServiceConfig
+	}{
+		"without overrides": {
+			envToApply: "test",
+			wanted:     mft.WorkerServiceConfig,
+		},
+		"with overrides": {
+			envToApply: "prod",
+			wanted: WorkerServiceConfig{
+				TaskConfig: TaskConfig{
+					CPU:    aws.Int(256),
+					Memory: aws.Int(512),
+					Count:  Count{Value: aws.Int(1)},
+				},
+				Queue: SQSQueue{
+					Retention: durationp(96 * time.Hour),
+					Timeout:   durationp(time.Minute),
+				},
+				Scaling: &QueueScaling{
+					Range:           &prodRange,
+					MessagesPerTask: aws.Int(100),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := mft.ApplyEnv(tc.envToApply)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got.WorkerServiceConfig)
+		})
+	}
+}
+
+func TestRange_Parse(t *testing.T) {
+	testCases := map[string]struct {
+		in Range
+
+		wantedMin int
+		wantedMax int
+		wantedErr error
+	}{
+		"valid range": {
+			in:        "1-10",
+			wantedMin: 1,
+			wantedMax: 10,
+		},
+		"missing maximum": {
+			in:        "1",
+			wantedErr: errInvalidRangeFormat,
+		},
+		"not a number": {
+			in:        "one-10",
+			wantedErr: errInvalidRangeFormat,
+		},
+		"minimum greater than maximum": {
+			in:        "10-1",
+			wantedErr: errors.New("minimum 10 can't be greater than maximum 1"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			min, max, err := tc.in.Parse()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedMin, min)
+			require.Equal(t, tc.wantedMax, max)
+		})
+	}
+}
@@ -24,6 +24,11 @@ type NetworkConfigRunner struct {
 	Subnets        []string
 	SecurityGroups []string
 
+	// Whether the tasks allow running commands in their containers with ECS Exec.
+	EnableExec bool
+	// Whether the tasks run on Fargate Spot capacity.
+	Spot bool
+
 	// Interfaces to interact with dependencies. Must not be nil.
 	ClusterGetter DefaultClusterGetter
 	Starter       TaskRunner
@@ -63,8 +68,10 @@ func (r *NetworkConfigRunner) Run() ([]*Task, error) {
 		Count:          r.Count,
 		Subnets:        r.Subnets,
 		SecurityGroups: r.SecurityGroups,
-		TaskFamilyName: taskFamilyName(r.GroupName),
+		TaskFamilyName: FamilyName(r.GroupName),
 		StartedBy:      startedBy,
+		EnableExec:     r.EnableExec,
+		Spot:           r.Spot,
 	})
 	if err != nil {
 		return nil, &errRunTask{
@@ -88,7 +88,7 @@ func TestNetworkConfigRunner_Run(t *testing.T) {
 					Count:          1,
 					Subnets:        []string{"subnet-1", "subnet-2"},
 					SecurityGroups: []string{"sg-1", "sg-2"},
-					TaskFamilyName: taskFamilyName("my-task"),
+					TaskFamilyName: FamilyName("my-task"),
 					StartedBy:      startedBy,
 				}).Return([]*ecs.Task{
 					{
@@ -134,7 +134,7 @@ func TestNetworkConfigRunner_Run(t *testing.T) {
 					Count:          1,
 					Subnets:        []string{"default-subnet-1", "default-subnet-2"},
 					SecurityGroups: []string{"sg-1", "sg-2"},
-					TaskFamilyName: taskFamilyName("my-task"),
+					TaskFamilyName: FamilyName("my-task"),
 					StartedBy:      startedBy,
 				}).Return([]*ecs.Task{
 					{
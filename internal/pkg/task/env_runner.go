@@ -37,6 +37,11 @@ type EnvRunner struct {
 	App string
 	Env string
 
+	// Whether the tasks allow running commands in their containers with ECS Exec.
+	EnableExec bool
+	// Whether the tasks run on Fargate Spot capacity.
+	Spot bool
+
 	// Interfaces to interact with dependencies. Must not be nil.
 	VPCGetter     VPCGetter
 	ClusterGetter ResourceGetter
@@ -74,8 +79,10 @@ func (r *EnvRunner) Run() ([]*Task, error) {
 		Count:          r.Count,
 		Subnets:        subnets,
 		SecurityGroups: securityGroups,
-		TaskFamilyName: taskFamilyName(r.GroupName),
+		TaskFamilyName: FamilyName(r.GroupName),
 		StartedBy:      startedBy,
+		EnableExec:     r.EnableExec,
+		Spot:           r.Spot,
 	})
 	if err != nil {
 		return nil, &errRunTask{
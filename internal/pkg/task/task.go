@@ -50,7 +50,8 @@ var (
 	fmtTaskFamilyName = "copilot-%s"
 )
 
-func taskFamilyName(groupName string) string {
+// FamilyName returns the name of the task definition family of a group of tasks.
+func FamilyName(groupName string) string {
 	return fmt.Sprintf(fmtTaskFamilyName, groupName)
 }
 
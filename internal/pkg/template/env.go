@@ -38,6 +38,7 @@ type EnvOpts struct {
 // ImportVPCOpts holds the fields to import VPC resources.
 type ImportVPCOpts struct {
 	ID               string // ID for the VPC.
+	CIDR             string // CIDR range of the VPC.
 	PublicSubnetIDs  []string
 	PrivateSubnetIDs []string
 }
@@ -8,7 +8,9 @@ import (
 	"testing"
 
 	"github.com/gobuffalo/packd"
+	"github.com/gobuffalo/packr/v2"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestTemplate_ParseEnv(t *testing.T) {
@@ -61,3 +63,82 @@ func TestTemplate_ParseEnv(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplate_ParseEnv_EnvironmentManagerRole(t *testing.T) {
+	testCases := map[string]struct {
+		inSid         string
+		wantedActions []string
+	}{
+		"grants the actions to exec into tasks": {
+			inSid:         "ECS",
+			wantedActions: []string{"ecs:ExecuteCommand"},
+		},
+		"grants the actions to allow NFS traffic to EFS mount targets": {
+			inSid:         "EFS",
+			wantedActions: []string{"elasticfilesystem:DescribeMountTargets", "elasticfilesystem:DescribeMountTargetSecurityGroups"},
+		},
+		"grants the actions to check and add ingress rules": {
+			inSid:         "EC2",
+			wantedActions: []string{"ec2:DescribeSecurityGroups", "ec2:AuthorizeSecurityGroupIngress"},
+		},
+		"grants the actions to describe the VPC of the environment": {
+			inSid:         "EC2",
+			wantedActions: []string{"ec2:DescribeVpcs", "ec2:DescribeSubnets"},
+		},
+		"grants the actions to manage secrets": {
+			inSid:         "SSM",
+			wantedActions: []string{"ssm:PutParameter", "ssm:AddTagsToResource", "ssm:DeleteParameter", "ssm:GetParametersByPath"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := &Template{
+				box: packr.New("environment-templates", "../../../templates"),
+			}
+
+			// WHEN
+			c, err := tpl.ParseEnv(EnvOpts{
+				VPCConfig: &AdjustVPCOpts{
+					CIDR:               "10.0.0.0/16",
+					PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+					PrivateSubnetCIDRs: []string{"10.0.2.0/24", "10.0.3.0/24"},
+				},
+			}, WithFuncs(map[string]interface{}{
+				"inc": IncFunc,
+			}))
+
+			// THEN
+			require.NoError(t, err)
+			var tmpl struct {
+				Resources struct {
+					EnvironmentManagerRole struct {
+						Properties struct {
+							Policies []struct {
+								PolicyDocument struct {
+									Statement []struct {
+										Sid    string   `yaml:"Sid"`
+										Action []string `yaml:"Action"`
+									} `yaml:"Statement"`
+								} `yaml:"PolicyDocument"`
+							} `yaml:"Policies"`
+						} `yaml:"Properties"`
+					} `yaml:"EnvironmentManagerRole"`
+				} `yaml:"Resources"`
+			}
+			require.NoError(t, yaml.Unmarshal(c.Bytes(), &tmpl))
+			var actions []string
+			for _, policy := range tmpl.Resources.EnvironmentManagerRole.Properties.Policies {
+				for _, statement := range policy.PolicyDocument.Statement {
+					if statement.Sid == tc.inSid {
+						actions = append(actions, statement.Action...)
+					}
+				}
+			}
+			for _, action := range tc.wantedActions {
+				require.Contains(t, actions, action)
+			}
+		})
+	}
+}
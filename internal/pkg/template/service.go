@@ -6,6 +6,7 @@ package template
 import (
 	"bytes"
 	"fmt"
+	"sort"
 	"text/template"
 
 	"github.com/aws/aws-sdk-go/service/ecs"
@@ -30,13 +31,21 @@ var (
 		"addons",
 		"sidecars",
 		"logconfig",
+		"mountpoints",
+		"autoscaling",
+		"listener-rule-conditions",
+		"depends-on",
+		"secret-value-from",
+		"rule-priority-function",
 	}
 )
 
 // Names of service templates.
 const (
-	lbWebSvcTplName   = "lb-web"
-	backendSvcTplName = "backend"
+	lbWebSvcTplName     = "lb-web"
+	backendSvcTplName   = "backend"
+	scheduledJobTplName = "scheduled-job"
+	workerSvcTplName    = "worker"
 )
 
 // ServiceNestedStackOpts holds configuration that's needed if the service stack has a nested stack.
@@ -50,11 +59,66 @@ type ServiceNestedStackOpts struct {
 
 // SidecarOpts holds configuration that's needed if the service has sidecar containers.
 type SidecarOpts struct {
-	Name       *string
-	Image      *string
-	Port       *string
-	Protocol   *string
-	CredsParam *string
+	Name        *string
+	Image       *string
+	Port        *string
+	Protocol    *string
+	CredsParam  *string
+	Essential   *bool
+	Variables   map[string]string
+	Secrets     map[string]Secret
+	MountPoints []*MountPointOpts
+	DependsOn   map[string]string // Conditions keyed by the name of the container to depend on.
+	HealthCheck *ecs.HealthCheck
+}
+
+// MountPointOpts holds configuration for a volume mounted into a container.
+type MountPointOpts struct {
+	SourceVolume  *string
+	ContainerPath *string
+	ReadOnly      *bool
+}
+
+// StorageOpts holds configuration for the task's volumes and the service container's mount points.
+type StorageOpts struct {
+	Volumes     []*VolumeOpts
+	MountPoints []*MountPointOpts
+	EFSPerms    []*EFSPermission
+}
+
+// VolumeOpts holds configuration for a task volume.
+// Volumes without an EFS configuration are bind mounts that only last as long as the task.
+type VolumeOpts struct {
+	Name *string
+	EFS  *EFSVolumeConfiguration
+}
+
+// EFSVolumeConfiguration holds configuration to mount an EFS file system into the task.
+type EFSVolumeConfiguration struct {
+	Filesystem    *string
+	RootDirectory *string
+	AccessPointID *string
+	IAM           *string // Either "ENABLED" or "DISABLED".
+}
+
+// EFSPermission holds the IAM permissions that the task role needs to mount an EFS file system.
+type EFSPermission struct {
+	FilesystemID  *string
+	AccessPointID *string
+	Write         bool
+}
+
+// PermissionsOpts holds the IAM permissions of the task role that are specified in the manifest.
+type PermissionsOpts struct {
+	ManagedPolicies []string
+	Statements      []*PolicyStatementOpts
+}
+
+// PolicyStatementOpts holds a statement of the task role's inline policy.
+type PolicyStatementOpts struct {
+	Effect    string
+	Actions   []string
+	Resources []string
 }
 
 // LogConfigOpts holds configuration that's needed if the service is configured with Firelens to route
@@ -67,18 +131,171 @@ type LogConfigOpts struct {
 	ConfigFile     *string
 }
 
+// StateMachineOpts holds configuration needed for a State Machine that runs a task to completion.
+type StateMachineOpts struct {
+	Timeout *int // Timeout in seconds after which the task is stopped.
+	Retries *int // Number of times to retry the task if it fails.
+}
+
+// QueueOpts holds configuration for the SQS queue that a service consumes messages from.
+type QueueOpts struct {
+	Retention       *int // Number of seconds a message is retained in the queue.
+	Timeout         *int // Number of seconds a received message is hidden from other consumers.
+	DeadLetterTries int  // Number of receives before a message is moved to the dead-letter queue.
+}
+
+// AutoscalingOpts holds configuration that's needed to scale the number of tasks of a service.
+type AutoscalingOpts struct {
+	MinCapacity          int
+	MaxCapacity          int
+	CPU                  *int // Target average CPU utilization percentage.
+	Memory               *int // Target average memory utilization percentage.
+	Requests             *int // Target number of requests per task behind the load balancer.
+	QueueMessagesPerTask *int // Number of visible messages in the queue that a single task can process.
+}
+
+// DeploymentConfigurationOpts holds configuration that controls how new tasks of a service are rolled out.
+type DeploymentConfigurationOpts struct {
+	MinHealthyPercent int
+	MaxPercent        int
+	CircuitBreaker    bool     // Roll back to the last completed deployment if tasks keep failing to start.
+	RollbackAlarms    []string // Names of CloudWatch alarms that roll back the deployment when they go into alarm.
+}
+
+// TargetGroupOpts holds configuration for the target group that a load balanced web service registers its tasks with.
+type TargetGroupOpts struct {
+	HealthCheck         HTTPHealthCheckOpts
+	Stickiness          bool
+	DeregistrationDelay *int64 // Number of seconds to drain in-flight requests before a task is deregistered.
+}
+
+// HTTPHealthCheckOpts holds configuration for the load balancer's health checks of the service.
+// Numeric fields are in seconds, and the templates use Copilot's defaults for the ones that are not set.
+type HTTPHealthCheckOpts struct {
+	HealthCheckPath    string
+	SuccessCodes       *string
+	HealthyThreshold   *int64
+	UnhealthyThreshold *int64
+	Timeout            *int64
+	Interval           *int64
+	GracePeriod        *int64
+}
+
+// NetworkLoadBalancerOpts holds configuration for a network load balancer in front of a load balanced web service.
+type NetworkLoadBalancerOpts struct {
+	Port            string  // Port that the listener accepts connections on.
+	Protocol        string  // Protocol of the listener, either "TCP" or "TLS".
+	SSLPolicy       *string // Only set for TLS listeners.
+	TargetContainer string
+	TargetPort      string
+	// ExposeTargetPort is true if the target port isn't already mapped by the main container.
+	ExposeTargetPort bool
+	Alias            *string // Domain name in the environment's hosted zone that routes to the load balancer.
+}
+
+// AliasOpts holds configuration to route a custom domain name to a load balanced web service.
+type AliasOpts struct {
+	AppDomainName         string // Domain of the application's hosted zone, "<app>.<domain>".
+	AppAccountID          string // ID of the account that owns the application's hosted zone.
+	DNSDelegationRoleName string // Name of the role in the application's account that can update records in its hosted zone.
+	RequiresCert          bool   // Whether the alias needs a certificate in addition to the environment's certificate.
+}
+
+// ListenerRuleOpts holds the conditions of an additional listener rule that forwards requests to the service.
+type ListenerRuleOpts struct {
+	Path         *string  // Path relative to the root of the load balancer, empty for the root path.
+	PathPatterns []string // Patterns that match the path and everything under it.
+	Hosts        []string // Defaults to the service's domain names on HTTPS.
+	HTTPHeaders  []HTTPHeaderCondition
+	QueryStrings []QueryStringCondition
+}
+
+// HTTPHeaderCondition holds the values that a request header must match.
+type HTTPHeaderCondition struct {
+	Name   string
+	Values []string
+}
+
+// QueryStringCondition holds a key/value pair that a request's query string must contain.
+type QueryStringCondition struct {
+	Key   string
+	Value string
+}
+
+// CapacityProviderStrategy holds how tasks are distributed on a capacity provider.
+type CapacityProviderStrategy struct {
+	Provider string
+	Weight   int
+	Base     *int
+}
+
+// Operating system families and CPU architectures supported by the RuntimePlatform of a task definition.
+const (
+	OSLinux   = "LINUX"
+	ArchX86   = "X86_64"
+	ArchARM64 = "ARM64"
+)
+
+// Secret holds where the value of a secret environment variable of a container is retrieved from.
+type Secret struct {
+	// Name or ARN of an SSM parameter, or the name or ARN of a Secrets Manager secret
+	// optionally followed by ":json-key:version-stage:version-id".
+	ValueFrom string
+	// Set if the secret is stored in AWS Secrets Manager.
+	SecretsManager *SecretsManagerOpts
+}
+
+// SecretsManagerOpts holds the secret in AWS Secrets Manager that the execution role is allowed to read.
+// Only one of the name or the ARN of the secret is set.
+type SecretsManagerOpts struct {
+	Name string
+	ARN  string
+}
+
+// EnvFileOpts holds the S3 object of the environment file that the main container loads its variables from.
+type EnvFileOpts struct {
+	Bucket string
+	Key    string
+}
+
+// RuntimePlatformOpts holds the operating system family and CPU architecture that the tasks run on.
+type RuntimePlatformOpts struct {
+	OS   string
+	Arch string
+}
+
 // ServiceOpts holds optional data that can be provided to enable features in a service stack template.
 type ServiceOpts struct {
 	// Additional options that're common between **all** service templates.
 	Variables   map[string]string
-	Secrets     map[string]string
+	EnvFile     *EnvFileOpts // Optional. Environment file uploaded to the application's bucket.
+	Secrets     map[string]Secret
 	NestedStack *ServiceNestedStackOpts // Outputs from nested stacks such as the addons stack.
 	Sidecars    []*SidecarOpts
+	DependsOn   map[string]string // Conditions keyed by the name of the sidecar that the main container depends on.
 	LogConfig   *LogConfigOpts
+	Storage     *StorageOpts
+	Permissions *PermissionsOpts // Optional. Permissions of the task role specified in the manifest.
+	EnableExec  bool             // Allow running commands in the containers with ECS Exec.
+	// Capacity providers to launch the tasks on. If empty, the tasks are launched on Fargate.
+	CapacityProviders []*CapacityProviderStrategy
+	// Operating system and CPU architecture of the tasks. If nil, the tasks run on Linux x86_64.
+	RuntimePlatform *RuntimePlatformOpts
 
 	// Additional options that're not shared across all service templates.
-	HealthCheck        *ecs.HealthCheck
-	RulePriorityLambda string
+	HealthCheck            *ecs.HealthCheck
+	RulePriorityLambda     string
+	TargetGroup            TargetGroupOpts
+	Alias                  *AliasOpts
+	ListenerRules          []*ListenerRuleOpts
+	NLB                    *NetworkLoadBalancerOpts
+	InternalLoadBalancer   bool // Route requests from the environment's internal load balancer to the service.
+	DNSCertValidatorLambda string
+	CustomDomainLambda     string
+	StateMachine           *StateMachineOpts
+	Queue                  *QueueOpts
+	Autoscaling            *AutoscalingOpts
+	DeploymentConfig       *DeploymentConfigurationOpts
 }
 
 // ParseLoadBalancedWebService parses a load balanced web service's CloudFormation template
@@ -92,6 +309,16 @@ func (t *Template) ParseBackendService(data ServiceOpts) (*Content, error) {
 	return t.parseSvc(backendSvcTplName, data, withSvcParsingFuncs())
 }
 
+// ParseScheduledJob parses a scheduled job's CloudFormation template with the specified data object and returns its content.
+func (t *Template) ParseScheduledJob(data ServiceOpts) (*Content, error) {
+	return t.parseSvc(scheduledJobTplName, data, withSvcParsingFuncs())
+}
+
+// ParseWorkerService parses a worker service's CloudFormation template with the specified data object and returns its content.
+func (t *Template) ParseWorkerService(data ServiceOpts) (*Content, error) {
+	return t.parseSvc(workerSvcTplName, data, withSvcParsingFuncs())
+}
+
 // parseSvc parses a service's CloudFormation template with the specified data object and returns its content.
 func (t *Template) parseSvc(name string, data interface{}, options ...ParseOption) (*Content, error) {
 	tpl, err := t.parse("base", fmt.Sprintf(fmtSvcCFTemplatePath, name), options...)
@@ -118,14 +345,51 @@ func (t *Template) parseSvc(name string, data interface{}, options ...ParseOptio
 func withSvcParsingFuncs() ParseOption {
 	return func(t *template.Template) *template.Template {
 		return t.Funcs(map[string]interface{}{
-			"toSnakeCase": ToSnakeCaseFunc,
-			"hasSecrets":  hasSecrets,
-			"fmtSlice":    FmtSliceFunc,
-			"quoteSlice":  QuotePSliceFunc,
+			"toSnakeCase":              ToSnakeCaseFunc,
+			"hasSecrets":               hasSecrets,
+			"hasSecretsManagerSecrets": hasSecretsManagerSecrets,
+			"secretsManagerSecrets":    secretsManagerSecrets,
+			"hasManagedPolicies":       hasManagedPolicies,
+			"fmtSlice":                 FmtSliceFunc,
+			"quoteSlice":               QuotePSliceFunc,
+			"quote":                    QuoteFunc,
+			"policyResource":           PolicyResourceFunc,
+			"inc":                      IncFunc,
 		})
 	}
 }
 
+func hasSecretsManagerSecrets(opts ServiceOpts) bool {
+	return len(secretsManagerSecrets(opts)) > 0
+}
+
+// secretsManagerSecrets returns the Secrets Manager secrets of the main container and the sidecars
+// that the execution role must be allowed to read, without duplicates.
+func secretsManagerSecrets(opts ServiceOpts) []*SecretsManagerOpts {
+	var secrets []*SecretsManagerOpts
+	seen := make(map[SecretsManagerOpts]bool)
+	add := func(containerSecrets map[string]Secret) {
+		names := make([]string, 0, len(containerSecrets))
+		for name := range containerSecrets {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			sm := containerSecrets[name].SecretsManager
+			if sm == nil || seen[*sm] {
+				continue
+			}
+			seen[*sm] = true
+			secrets = append(secrets, sm)
+		}
+	}
+	add(opts.Secrets)
+	for _, sidecar := range opts.Sidecars {
+		add(sidecar.Secrets)
+	}
+	return secrets
+}
+
 func hasSecrets(opts ServiceOpts) bool {
 	if len(opts.Secrets) > 0 {
 		return true
@@ -135,3 +399,13 @@ func hasSecrets(opts ServiceOpts) bool {
 	}
 	return false
 }
+
+func hasManagedPolicies(opts ServiceOpts) bool {
+	if opts.NestedStack != nil && len(opts.NestedStack.PolicyOutputs) > 0 {
+		return true
+	}
+	if opts.Permissions != nil && len(opts.Permissions.ManagedPolicies) > 0 {
+		return true
+	}
+	return false
+}
@@ -38,6 +38,12 @@ func TestTemplate_ParseSvc(t *testing.T) {
 				mockBox.AddString("services/common/cf/addons.yml", "addons")
 				mockBox.AddString("services/common/cf/sidecars.yml", "sidecars")
 				mockBox.AddString("services/common/cf/logconfig.yml", "logconfig")
+				mockBox.AddString("services/common/cf/mountpoints.yml", "mountpoints")
+				mockBox.AddString("services/common/cf/autoscaling.yml", "autoscaling")
+				mockBox.AddString("services/common/cf/listener-rule-conditions.yml", "listener-rule-conditions")
+				mockBox.AddString("services/common/cf/depends-on.yml", "depends-on")
+				mockBox.AddString("services/common/cf/secret-value-from.yml", "secret-value-from")
+				mockBox.AddString("services/common/cf/rule-priority-function.yml", "rule-priority-function")
 
 				t.box = mockBox
 			},
@@ -51,6 +57,12 @@ func TestTemplate_ParseSvc(t *testing.T) {
   addons
   sidecars
   logconfig
+  mountpoints
+  autoscaling
+  listener-rule-conditions
+  depends-on
+  secret-value-from
+  rule-priority-function
 `,
 		},
 	}
@@ -73,6 +85,83 @@ func TestTemplate_ParseSvc(t *testing.T) {
 	}
 }
 
+func TestHasSecretsManagerSecrets(t *testing.T) {
+	testCases := map[string]struct {
+		in     ServiceOpts
+		wanted bool
+	}{
+		"nil secrets": {
+			in:     ServiceOpts{},
+			wanted: false,
+		},
+		"only SSM parameters": {
+			in: ServiceOpts{
+				Secrets: map[string]Secret{
+					"hello": {ValueFrom: "world"},
+				},
+			},
+			wanted: false,
+		},
+		"has Secrets Manager secrets": {
+			in: ServiceOpts{
+				Secrets: map[string]Secret{
+					"hello": {ValueFrom: "world"},
+					"db":    {ValueFrom: "db:password::", SecretsManager: &SecretsManagerOpts{Name: "db"}},
+				},
+			},
+			wanted: true,
+		},
+		"has Secrets Manager secrets in a sidecar": {
+			in: ServiceOpts{
+				Sidecars: []*SidecarOpts{
+					{
+						Secrets: map[string]Secret{
+							"db": {ValueFrom: "db", SecretsManager: &SecretsManagerOpts{Name: "db"}},
+						},
+					},
+				},
+			},
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, hasSecretsManagerSecrets(tc.in))
+		})
+	}
+}
+
+func TestSecretsManagerSecrets(t *testing.T) {
+	// GIVEN
+	opts := ServiceOpts{
+		Secrets: map[string]Secret{
+			"TOKEN": {ValueFrom: "token"},
+			"DB":    {ValueFrom: "db:password::", SecretsManager: &SecretsManagerOpts{Name: "db"}},
+		},
+		Sidecars: []*SidecarOpts{
+			{
+				Secrets: map[string]Secret{
+					"DB_PASSWORD": {ValueFrom: "db", SecretsManager: &SecretsManagerOpts{Name: "db"}},
+					"API_KEY": {
+						ValueFrom:      "arn:aws:secretsmanager:us-west-2:111111111111:secret:key-AbCdEf",
+						SecretsManager: &SecretsManagerOpts{ARN: "arn:aws:secretsmanager:us-west-2:111111111111:secret:key-AbCdEf"},
+					},
+				},
+			},
+		},
+	}
+
+	// WHEN
+	got := secretsManagerSecrets(opts)
+
+	// THEN
+	require.Equal(t, []*SecretsManagerOpts{
+		{Name: "db"},
+		{ARN: "arn:aws:secretsmanager:us-west-2:111111111111:secret:key-AbCdEf"},
+	}, got)
+}
+
 func TestHasSecrets(t *testing.T) {
 	testCases := map[string]struct {
 		in     ServiceOpts
@@ -84,14 +173,14 @@ func TestHasSecrets(t *testing.T) {
 		},
 		"no secrets": {
 			in: ServiceOpts{
-				Secrets: map[string]string{},
+				Secrets: map[string]Secret{},
 			},
 			wanted: false,
 		},
 		"service has secrets": {
 			in: ServiceOpts{
-				Secrets: map[string]string{
-					"hello": "world",
+				Secrets: map[string]Secret{
+					"hello": {ValueFrom: "world"},
 				},
 			},
 			wanted: true,
@@ -112,3 +201,42 @@ func TestHasSecrets(t *testing.T) {
 		})
 	}
 }
+
+func TestHasManagedPolicies(t *testing.T) {
+	testCases := map[string]struct {
+		in     ServiceOpts
+		wanted bool
+	}{
+		"no managed policies": {
+			in: ServiceOpts{
+				NestedStack: &ServiceNestedStackOpts{},
+				Permissions: &PermissionsOpts{
+					Statements: []*PolicyStatementOpts{{Effect: "Allow"}},
+				},
+			},
+			wanted: false,
+		},
+		"nested has managed policies": {
+			in: ServiceOpts{
+				NestedStack: &ServiceNestedStackOpts{
+					PolicyOutputs: []string{"MyTablePolicy"},
+				},
+			},
+			wanted: true,
+		},
+		"manifest has managed policies": {
+			in: ServiceOpts{
+				Permissions: &PermissionsOpts{
+					ManagedPolicies: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+				},
+			},
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, hasManagedPolicies(tc.in))
+		})
+	}
+}
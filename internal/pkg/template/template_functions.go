@@ -14,6 +14,11 @@ const (
 	dashReplacement = "DASH"
 )
 
+var (
+	pseudoParameterRegexp = regexp.MustCompile(`\$\{AWS::[a-zA-Z]+\}`) // Such as "${AWS::Region}".
+	subVariableRegexp     = regexp.MustCompile(`\$\{[^}]*\}`)          // Any "${...}" reference of a "!Sub" string.
+)
+
 // ReplaceDashesFunc takes a CloudFormation logical ID, and
 // sanitizes it by removing "-" characters (not allowed)
 // and replacing them with "DASH" (allowed by CloudFormation but
@@ -81,6 +86,28 @@ func QuoteSliceFunc(elems []string) []string {
 	return quotedElems
 }
 
+// QuoteFunc places quotation marks around a string, escaping its special characters, so that it's a valid YAML scalar.
+func QuoteFunc(s string) string {
+	return strconv.Quote(s)
+}
+
+// PolicyResourceFunc returns the YAML value of a resource of an IAM policy statement.
+// Resources that reference pseudo parameters, such as "${AWS::Region}", are substituted with "!Sub",
+// and any other reference, such as the IAM policy variable "${aws:username}", is escaped to be kept as is.
+// Resources without pseudo parameters are quoted strings.
+func PolicyResourceFunc(resource string) string {
+	if !pseudoParameterRegexp.MatchString(resource) {
+		return QuoteFunc(resource)
+	}
+	escaped := subVariableRegexp.ReplaceAllStringFunc(resource, func(ref string) string {
+		if pseudoParameterRegexp.MatchString(ref) || strings.HasPrefix(ref, "${!") {
+			return ref
+		}
+		return "${!" + strings.TrimPrefix(ref, "${")
+	})
+	return "!Sub " + QuoteFunc(escaped)
+}
+
 // QuotePSliceFunc places quotation marks around all
 // dereferenced elements of elems and returns a []string slice.
 func QuotePSliceFunc(elems []*string) []string {
@@ -231,3 +231,37 @@ func TestQuotePSliceFunc(t *testing.T) {
 	require.Equal(t, []string{`"a"`}, QuotePSliceFunc(aws.StringSlice([]string{"a"})))
 	require.Equal(t, []string{`"a"`, `"b"`, `"c"`}, QuotePSliceFunc(aws.StringSlice([]string{"a", "b", "c"})))
 }
+
+func TestPolicyResourceFunc(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		wanted string
+	}{
+		"quotes a resource without references": {
+			in:     "arn:aws:s3:::my-bucket/*",
+			wanted: `"arn:aws:s3:::my-bucket/*"`,
+		},
+		"escapes quotes": {
+			in:     `arn:aws:s3:::my-bucket/it's"here`,
+			wanted: `"arn:aws:s3:::my-bucket/it's\"here"`,
+		},
+		"keeps IAM policy variables without pseudo parameters": {
+			in:     "arn:aws:s3:::my-bucket/${aws:username}/*",
+			wanted: `"arn:aws:s3:::my-bucket/${aws:username}/*"`,
+		},
+		"substitutes pseudo parameters": {
+			in:     "arn:${AWS::Partition}:sqs:${AWS::Region}:${AWS::AccountId}:orders",
+			wanted: `!Sub "arn:${AWS::Partition}:sqs:${AWS::Region}:${AWS::AccountId}:orders"`,
+		},
+		"escapes IAM policy variables with pseudo parameters": {
+			in:     "arn:${AWS::Partition}:s3:::my-bucket/${aws:username}/${!already-escaped}",
+			wanted: `!Sub "arn:${AWS::Partition}:s3:::my-bucket/${!aws:username}/${!already-escaped}"`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, PolicyResourceFunc(tc.in))
+		})
+	}
+}
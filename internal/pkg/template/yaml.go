@@ -0,0 +1,35 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"bytes"
+
+	"gopkg.in/yaml.v3"
+)
+
+const yamlIndent = 2 // Indentation of the templates and the manifests.
+
+// EncodeYAML returns the document encoded with the same indentation as the templates.
+// The comments of the document are dropped, since the encoder can misplace the comments of keys whose values are tagged.
+func EncodeYAML(doc *yaml.Node) ([]byte, error) {
+	removeComments(doc)
+	buf := new(bytes.Buffer)
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(yamlIndent)
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func removeComments(node *yaml.Node) {
+	node.HeadComment, node.LineComment, node.FootComment = "", "", ""
+	for _, child := range node.Content {
+		removeComments(child)
+	}
+}
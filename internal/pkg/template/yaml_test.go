@@ -0,0 +1,36 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestEncodeYAML(t *testing.T) {
+	// GIVEN
+	in := `# The service.
+Service:
+    Type: AWS::ECS::Service # The type.
+    Properties:
+        SecurityGroups:
+            - !Ref SecurityGroup
+`
+	var doc yaml.Node
+	require.NoError(t, yaml.Unmarshal([]byte(in), &doc))
+
+	// WHEN
+	out, err := EncodeYAML(&doc)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, `Service:
+  Type: AWS::ECS::Service
+  Properties:
+    SecurityGroups:
+      - !Ref SecurityGroup
+`, string(out))
+}
@@ -34,6 +34,13 @@ func Stdout(writer io.Writer) Option {
 	}
 }
 
+// Env sets the internal *exec.Cmd's Env field.
+func Env(env []string) Option {
+	return func(c *exec.Cmd) {
+		c.Env = env
+	}
+}
+
 // Run runs the input command with input args with Stdout and Stderr defaulted to os.Stderr.
 // Input options will override these defaults.
 func (s Service) Run(name string, args []string, options ...Option) error {
@@ -48,3 +55,8 @@ func (s Service) Run(name string, args []string, options ...Option) error {
 
 	return cmd.Run()
 }
+
+// LookPath searches for an executable named file in the directories named by the PATH environment variable.
+func (s Service) LookPath(file string) (string, error) {
+	return exec.LookPath(file)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 )
@@ -68,6 +69,37 @@ func HumanizeResourceEvents(orderedTexts []Text, resourceEvents []deploy.Resourc
 	return rows
 }
 
+// Stopped tasks display settings.
+const (
+	maxStoppedTasks      = 3                               // maximum number of stopped tasks to display.
+	shortTaskIDLength    = 8                               // number of characters of a task ID to display.
+	deploymentStopReason = "Scaling activity initiated by" // prefix of the reason for tasks replaced by a deployment.
+)
+
+// HumanizeStoppedTasks returns rows for the most recently stopped tasks along with the reason they stopped,
+// so that tasks failing to start are surfaced while waiting for a deployment to complete.
+// Tasks that were stopped by ECS to be replaced with new ones are not considered failures and are skipped.
+func HumanizeStoppedTasks(tasks []ecs.TaskStatus) []TabRow {
+	var rows []TabRow
+	var count int
+	for _, task := range tasks {
+		if count == maxStoppedTasks {
+			break
+		}
+		if strings.HasPrefix(task.StoppedReason, deploymentStopReason) {
+			continue
+		}
+		id := task.ID
+		if len(id) > shortTaskIDLength {
+			id = id[:shortTaskIDLength]
+		}
+		rows = append(rows, TabRow(fmt.Sprintf("%s\t%s", color.Grey.Sprintf("Stopped task %s", id), color.Red.Sprintf("[%s]", StatusFailed))))
+		rows = append(rows, TabRow(fmt.Sprintf("  %s\t", task.StoppedReason)))
+		count++
+	}
+	return rows
+}
+
 func toStatus(s string) Status {
 	if strings.HasSuffix(s, "FAILED") {
 		return StatusFailed
@@ -6,6 +6,7 @@ package progress
 import (
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/stretchr/testify/require"
 )
@@ -56,3 +57,49 @@ func TestHumanizeResourceEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestHumanizeStoppedTasks(t *testing.T) {
+	testCases := map[string]struct {
+		inTasks []ecs.TaskStatus
+
+		wantedRows []TabRow
+	}{
+		"no stopped tasks": {},
+		"skips tasks replaced by a deployment": {
+			inTasks: []ecs.TaskStatus{
+				{
+					ID:            "4082490ee6c245e09d2145010aa1ba8d",
+					StoppedReason: "Essential container in task exited",
+				},
+				{
+					ID:            "5082490ee6c245e09d2145010aa1ba8d",
+					StoppedReason: "Scaling activity initiated by (deployment ecs-svc/1234)",
+				},
+			},
+
+			wantedRows: []TabRow{"Stopped task 4082490e\t[Failed]", "  Essential container in task exited\t"},
+		},
+		"displays at most three tasks": {
+			inTasks: []ecs.TaskStatus{
+				{ID: "1", StoppedReason: "first"},
+				{ID: "2", StoppedReason: "second"},
+				{ID: "3", StoppedReason: "third"},
+				{ID: "4", StoppedReason: "fourth"},
+			},
+
+			wantedRows: []TabRow{
+				"Stopped task 1\t[Failed]", "  first\t",
+				"Stopped task 2\t[Failed]", "  second\t",
+				"Stopped task 3\t[Failed]", "  third\t",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := HumanizeStoppedTasks(tc.inTasks)
+
+			require.Equal(t, tc.wantedRows, got)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package selector
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+var (
+	// ErrNoRunningTask is returned when there are no running tasks to select from.
+	ErrNoRunningTask = errors.New("no running tasks found")
+)
+
+// TaskSelect is a selector for running tasks and their containers.
+type TaskSelect struct {
+	prompt Prompter
+}
+
+// NewTaskSelect returns a new selector that chooses running tasks and containers.
+func NewTaskSelect(prompt Prompter) *TaskSelect {
+	return &TaskSelect{
+		prompt: prompt,
+	}
+}
+
+// RunningTask has the user select a task among the running tasks.
+// If taskID is not empty, the task with that ID, or ID prefix, is returned without prompting.
+func (s *TaskSelect) RunningTask(prompt, help string, tasks []*ecs.Task, taskID string) (*ecs.Task, error) {
+	var taskIDs []string
+	idToTask := make(map[string]*ecs.Task)
+	for _, task := range tasks {
+		if aws.StringValue(task.LastStatus) != ecs.DesiredStatusRunning {
+			continue
+		}
+		status, err := task.TaskStatus()
+		if err != nil {
+			return nil, err
+		}
+		if taskID != "" && !strings.HasPrefix(status.ID, taskID) {
+			continue
+		}
+		taskIDs = append(taskIDs, status.ID)
+		idToTask[status.ID] = task
+	}
+	if len(taskIDs) == 0 {
+		if taskID != "" {
+			return nil, fmt.Errorf("no running task found with ID %s", taskID)
+		}
+		return nil, ErrNoRunningTask
+	}
+	if len(taskIDs) == 1 {
+		if taskID == "" {
+			log.Infof("Only found one running task, defaulting to: %s\n", color.HighlightUserInput(taskIDs[0]))
+		}
+		return idToTask[taskIDs[0]], nil
+	}
+	if taskID != "" {
+		return nil, fmt.Errorf("more than one running task found with ID prefix %s", taskID)
+	}
+	selectedID, err := s.prompt.SelectOne(prompt, help, taskIDs)
+	if err != nil {
+		return nil, fmt.Errorf("select running task: %w", err)
+	}
+	return idToTask[selectedID], nil
+}
+
+// Container has the user select a container in the task.
+func (s *TaskSelect) Container(prompt, help string, task *ecs.Task) (string, error) {
+	containers := task.ContainerNames()
+	if len(containers) == 0 {
+		return "", fmt.Errorf("no containers found in task %s", aws.StringValue(task.TaskArn))
+	}
+	if len(containers) == 1 {
+		log.Infof("Only found one container, defaulting to: %s\n", color.HighlightUserInput(containers[0]))
+		return containers[0], nil
+	}
+	container, err := s.prompt.SelectOne(prompt, help, containers)
+	if err != nil {
+		return "", fmt.Errorf("select container: %w", err)
+	}
+	return container, nil
+}
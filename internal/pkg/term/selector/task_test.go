@@ -0,0 +1,171 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package selector
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskSelect_RunningTask(t *testing.T) {
+	const (
+		mockTaskARN1 = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/4082490ee6c245e09d2145010aa1ba8d"
+		mockTaskARN2 = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/4082490ee6c245e09d2145010aa1ba8e"
+		mockTaskARN3 = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/6082490ee6c245e09d2145010aa1ba8d"
+	)
+	mockTasks := []*ecs.Task{
+		{TaskArn: aws.String(mockTaskARN1), LastStatus: aws.String("RUNNING")},
+		{TaskArn: aws.String(mockTaskARN2), LastStatus: aws.String("RUNNING")},
+		{TaskArn: aws.String(mockTaskARN3), LastStatus: aws.String("PROVISIONING")},
+	}
+	testCases := map[string]struct {
+		inTasks    []*ecs.Task
+		inTaskID   string
+		setupMocks func(m *mocks.MockPrompter)
+
+		wantedErr     error
+		wantedTaskARN string
+	}{
+		"return error if there are no running tasks": {
+			inTasks:    mockTasks[2:],
+			setupMocks: func(m *mocks.MockPrompter) {},
+			wantedErr:  ErrNoRunningTask,
+		},
+		"return error if no running task has the ID": {
+			inTasks:    mockTasks,
+			inTaskID:   "6082",
+			setupMocks: func(m *mocks.MockPrompter) {},
+			wantedErr:  fmt.Errorf("no running task found with ID 6082"),
+		},
+		"return the running task with the ID prefix without prompting": {
+			inTasks:       mockTasks,
+			inTaskID:      "4082490ee6c245e09d2145010aa1ba8e",
+			setupMocks:    func(m *mocks.MockPrompter) {},
+			wantedTaskARN: mockTaskARN2,
+		},
+		"return error if more than one running task has the ID prefix": {
+			inTasks:    mockTasks,
+			inTaskID:   "4082",
+			setupMocks: func(m *mocks.MockPrompter) {},
+			wantedErr:  fmt.Errorf("more than one running task found with ID prefix 4082"),
+		},
+		"return error if fail to select a running task": {
+			inTasks: mockTasks,
+			setupMocks: func(m *mocks.MockPrompter) {
+				m.EXPECT().SelectOne("Which task?", "Help text", []string{"4082490ee6c245e09d2145010aa1ba8d", "4082490ee6c245e09d2145010aa1ba8e"}).
+					Return("", errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("select running task: some error"),
+		},
+		"return the only running task without prompting": {
+			inTasks:       mockTasks[1:],
+			setupMocks:    func(m *mocks.MockPrompter) {},
+			wantedTaskARN: mockTaskARN2,
+		},
+		"return the selected task": {
+			inTasks: mockTasks,
+			setupMocks: func(m *mocks.MockPrompter) {
+				m.EXPECT().SelectOne("Which task?", "Help text", []string{"4082490ee6c245e09d2145010aa1ba8d", "4082490ee6c245e09d2145010aa1ba8e"}).
+					Return("4082490ee6c245e09d2145010aa1ba8d", nil)
+			},
+			wantedTaskARN: mockTaskARN1,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPrompt := mocks.NewMockPrompter(ctrl)
+			tc.setupMocks(mockPrompt)
+			sel := NewTaskSelect(mockPrompt)
+
+			// WHEN
+			task, err := sel.RunningTask("Which task?", "Help text", tc.inTasks, tc.inTaskID)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTaskARN, aws.StringValue(task.TaskArn))
+			}
+		})
+	}
+}
+
+func TestTaskSelect_Container(t *testing.T) {
+	testCases := map[string]struct {
+		inContainers []string
+		setupMocks   func(m *mocks.MockPrompter)
+
+		wantedErr       error
+		wantedContainer string
+	}{
+		"return error if the task has no containers": {
+			setupMocks: func(m *mocks.MockPrompter) {},
+			wantedErr:  fmt.Errorf("no containers found in task mockTaskARN"),
+		},
+		"return the only container without prompting": {
+			inContainers:    []string{"frontend"},
+			setupMocks:      func(m *mocks.MockPrompter) {},
+			wantedContainer: "frontend",
+		},
+		"return error if fail to select a container": {
+			inContainers: []string{"frontend", "nginx"},
+			setupMocks: func(m *mocks.MockPrompter) {
+				m.EXPECT().SelectOne("Which container?", "Help text", []string{"frontend", "nginx"}).
+					Return("", errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("select container: some error"),
+		},
+		"return the selected container": {
+			inContainers: []string{"frontend", "nginx"},
+			setupMocks: func(m *mocks.MockPrompter) {
+				m.EXPECT().SelectOne("Which container?", "Help text", []string{"frontend", "nginx"}).
+					Return("nginx", nil)
+			},
+			wantedContainer: "nginx",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPrompt := mocks.NewMockPrompter(ctrl)
+			tc.setupMocks(mockPrompt)
+			sel := NewTaskSelect(mockPrompt)
+			task := &ecs.Task{
+				TaskArn: aws.String("mockTaskARN"),
+			}
+			for _, name := range tc.inContainers {
+				task.Containers = append(task.Containers, &awsecs.Container{
+					Name: aws.String(name),
+				})
+			}
+
+			// WHEN
+			container, err := sel.Container("Which container?", "Help text", task)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContainer, container)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@
 //  .
 //  ├── copilot                        (application directory)
 //  │   ├── .workspace                 (workspace summary)
+//  │   ├── defaults.yml               (manifest defaults shared by the services)
 //  │   └── my-service
 //  │   │   └── manifest.yml           (service manifest)
 //  │   ├── buildspec.yml              (buildspec for the pipeline's build stage)
@@ -35,6 +36,7 @@ const (
 	maximumParentDirsToSearch = 5
 	pipelineFileName          = "pipeline.yml"
 	manifestFileName          = "manifest.yml"
+	defaultsFileName          = "defaults.yml"
 	buildspecFileName         = "buildspec.yml"
 
 	ymlFileExtension = ".yml"
@@ -144,6 +146,23 @@ func (ws *Workspace) ReadServiceManifest(name string) ([]byte, error) {
 	return ws.read(name, manifestFileName)
 }
 
+// ReadServiceDefaults returns the contents of the manifest defaults shared by every service under copilot/defaults.yml.
+// If the file doesn't exist, then it returns nil.
+func (ws *Workspace) ReadServiceDefaults() ([]byte, error) {
+	copilotPath, err := ws.CopilotDirPath()
+	if err != nil {
+		return nil, err
+	}
+	exists, err := ws.fsUtils.Exists(filepath.Join(copilotPath, defaultsFileName))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+	return ws.read(defaultsFileName)
+}
+
 // ReadPipelineManifest returns the contents of the pipeline manifest under copilot/pipeline.yml.
 func (ws *Workspace) ReadPipelineManifest() ([]byte, error) {
 	pmPath, err := ws.pipelineManifestPath()
@@ -592,6 +592,48 @@ func TestWorkspace_ReadPipelineManifest(t *testing.T) {
 	}
 }
 
+func TestWorkspace_ReadServiceDefaults(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedContent []byte
+	}{
+		"reads existing defaults": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot", 0755)
+				afero.WriteFile(fs, "/copilot/defaults.yml", []byte("cpu: 512"), 0644)
+				return fs
+			},
+			wantedContent: []byte("cpu: 512"),
+		},
+		"returns nil when no defaults file exists": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot", 0755)
+				return fs
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ws := &Workspace{
+				copilotDir: "/copilot",
+				fsUtils:    &afero.Afero{Fs: tc.fs()},
+			}
+
+			// WHEN
+			got, err := ws.ReadServiceDefaults()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, got)
+		})
+	}
+}
+
 func TestWorkspace_DeleteWorkspaceFile(t *testing.T) {
 	testCases := map[string]struct {
 		copilotDir string